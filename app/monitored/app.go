@@ -509,8 +509,12 @@ func New(
 		keys[monitoredmoduletypes.MemStoreKey],
 		app.GetSubspace(monitoredmoduletypes.ModuleName),
 		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
+		app.IBCKeeper.ConnectionKeeper,
 		&app.IBCKeeper.PortKeeper,
 		scopedMonitoredKeeper,
+		app.StakingKeeper,
+		app.UpgradeKeeper,
 	)
 	monitoredModule := monitoredmodule.NewAppModule(appCodec, app.MonitoredKeeper, app.AccountKeeper, app.BankKeeper)
 
@@ -513,6 +513,7 @@ func New(
 		app.IBCKeeper.ClientKeeper,
 		app.IBCKeeper.ConnectionKeeper,
 		scopedHealthcheckKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	healthcheckModule := healthcheckmodule.NewAppModule(appCodec, app.HealthcheckKeeper, app.AccountKeeper, app.BankKeeper)
 
@@ -3,11 +3,21 @@ package testing
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 	"github.com/stretchr/testify/suite"
 
 	appmonitored "healthcheck/app/monitored"
+	appregistry "healthcheck/app/registry"
+	registrykeeper "healthcheck/x/healthcheck/keeper"
 	registrytypes "healthcheck/x/healthcheck/types"
+	"healthcheck/x/monitored"
+	monitoredtypes "healthcheck/x/monitored/types"
 	commontypes "healthcheck/x/types"
 )
 
@@ -35,6 +45,49 @@ func (s *HealthcheckTestSuite) TestHappyPath() {
 
 	monitoredChain1 = GetMonitoredChain(s, appmonitored.Name)
 	s.Require().Greater(monitoredChain1.Block, latestReportedBlock)
+
+	// both packets were delivered by the same relayer
+	relayer := s.registryChain.SenderAccount.GetAddress().String()
+	s.Require().Equal(relayer, monitoredChain1.LastRelayer)
+
+	relayers := s.registryApp.HealthcheckKeeper.GetRelayersForChain(s.registryContext(), appmonitored.Name)
+	s.Require().Len(relayers, 1)
+	s.Require().Equal(uint64(2), relayers[0].PacketsDelivered)
+
+	// monitored chain stores the registry view from the acknowledgement of the last update
+	healthcheckAck, found := s.monitoredApp.MonitoredKeeper.GetLastHealthcheckAck(s.monitoredContext(), s.path.EndpointA.ChannelID)
+	s.Require().True(found)
+	s.Require().Equal(commontypes.HealthcheckAck{
+		RegistryBlockHeight: monitoredChain1.RegistryBlockHeight,
+		Status:              uint64(registrytypes.Active),
+		NextUpdateDeadline:  monitoredChain1.RegistryBlockHeight + monitoredChain1.UpdateInterval,
+		UpdateInterval:      monitoredChain1.UpdateInterval,
+		TimeoutInterval:     monitoredChain1.TimeoutInterval,
+	}, healthcheckAck)
+
+	// only the first update carried the decentralization metrics
+	samples := s.registryApp.HealthcheckKeeper.GetAllDecentralizationSample(s.registryContext())
+	s.Require().Len(samples, 1)
+	s.Require().Equal(appmonitored.Name, samples[0].ChainId)
+	// validators of the test chain have equal voting power
+	s.Require().Equal(uint64(len(s.monitoredChain.Vals.Validators)), samples[0].ValidatorCount)
+	s.Require().Equal(uint64(2), samples[0].NakamotoCoefficient)
+	s.Require().Equal(commontypes.BasisPoints, samples[0].TopNConcentration)
+
+	// the first update also carried the connectivity of the monitored chain
+	connectivity, found := s.registryApp.HealthcheckKeeper.GetChainConnectivity(s.registryContext(), appmonitored.Name)
+	s.Require().True(found)
+	s.Require().Len(connectivity.Channels, 1)
+	s.Require().Equal(s.path.EndpointA.ChannelID, connectivity.Channels[0].ChannelId)
+	s.Require().Equal(s.registryChain.ChainID, connectivity.Channels[0].CounterpartyChainId)
+	s.Require().Equal(channeltypes.OPEN.String(), connectivity.Channels[0].State)
+	s.Require().Equal(ibcexported.Active.String(), connectivity.Channels[0].ClientStatus)
+
+	neighbors := s.registryApp.HealthcheckKeeper.GetChainNeighbors(s.registryContext(), s.registryChain.ChainID)
+	s.Require().Len(neighbors, 1)
+	s.Require().Equal(appmonitored.Name, neighbors[0].ChainId)
+	s.Require().True(neighbors[0].Registered)
+	s.Require().Equal(uint64(registrytypes.Active), neighbors[0].Status)
 }
 
 func GetMonitoredChain(s *HealthcheckTestSuite, chainID string) registrytypes.Chain {
@@ -43,3 +96,741 @@ func GetMonitoredChain(s *HealthcheckTestSuite, chainID string) registrytypes.Ch
 
 	return monitoredChain1
 }
+
+func (s *HealthcheckTestSuite) TestDiagnosisPacketsNotRelayed() {
+	s.relayCommittedPackets(s.monitoredChain, s.path, commontypes.MonitoredPortID, s.path.EndpointA.ChannelID, 1)
+
+	// monitored chain keeps producing blocks and its client on the registry is updated,
+	// but healthcheck packets are not relayed
+	monitoredChain := GetMonitoredChain(s, appmonitored.Name)
+	for i := uint64(0); i <= monitoredChain.UpdateInterval; i++ {
+		s.coordinator.CommitBlock(s.monitoredChain)
+		s.Require().NoError(s.path.EndpointB.UpdateClient())
+	}
+
+	monitoredChain = GetMonitoredChain(s, appmonitored.Name)
+	s.Require().Equal(uint64(registrytypes.Inactive), monitoredChain.Status)
+	s.Require().Equal(uint64(registrytypes.PacketsNotRelayed), monitoredChain.Diagnosis)
+}
+
+func (s *HealthcheckTestSuite) TestDiagnosisChainHalted() {
+	s.relayCommittedPackets(s.monitoredChain, s.path, commontypes.MonitoredPortID, s.path.EndpointA.ChannelID, 1)
+
+	// only the registry chain produces blocks
+	monitoredChain := GetMonitoredChain(s, appmonitored.Name)
+	s.coordinator.CommitNBlocks(s.registryChain, monitoredChain.UpdateInterval+1)
+
+	monitoredChain = GetMonitoredChain(s, appmonitored.Name)
+	s.Require().Equal(uint64(registrytypes.Inactive), monitoredChain.Status)
+	s.Require().Equal(uint64(registrytypes.ChainHalted), monitoredChain.Diagnosis)
+}
+
+func (s *HealthcheckTestSuite) TestPassiveMonitoring() {
+	// register the monitored chain again, this time to be monitored passively through its light client
+	s.registryApp.HealthcheckKeeper.RemoveChain(s.registryContext(), appmonitored.Name)
+	msgServer := registrykeeper.NewMsgServerImpl(s.registryApp.HealthcheckKeeper)
+	_, err := msgServer.CreateChain(sdk.WrapSDKContext(s.registryContext()), &registrytypes.MsgCreateChain{
+		Creator:      s.registryChain.SenderAccount.GetAddress().String(),
+		ChainId:      appmonitored.Name,
+		ConnectionId: s.path.EndpointB.ConnectionID,
+		Passive:      true,
+	})
+	s.Require().NoError(err)
+
+	// light client update is the evidence of new blocks
+	s.coordinator.CommitBlock(s.monitoredChain)
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+
+	monitoredChain := GetMonitoredChain(s, appmonitored.Name)
+	s.Require().Equal(uint64(registrytypes.Active), monitoredChain.Status)
+	s.Require().Equal(uint64(registrytypes.LightClient), monitoredChain.EvidenceSource)
+	s.Require().Equal(s.path.EndpointB.GetClientState().GetLatestHeight().GetRevisionHeight(), monitoredChain.Block)
+	s.Require().NotZero(monitoredChain.Timestamp)
+
+	// no client updates
+	s.coordinator.CommitNBlocks(s.registryChain, monitoredChain.UpdateInterval+1)
+
+	monitoredChain = GetMonitoredChain(s, appmonitored.Name)
+	s.Require().Equal(uint64(registrytypes.Inactive), monitoredChain.Status)
+	s.Require().Equal(uint64(registrytypes.ChainHalted), monitoredChain.Diagnosis)
+}
+
+func (s *HealthcheckTestSuite) TestVerifiedUpdate() {
+	s.relayCommittedPackets(s.monitoredChain, s.path, commontypes.MonitoredPortID, s.path.EndpointA.ChannelID, 1)
+
+	// commit monitored chain blocks until the current block is the one that sends the next healthcheck update
+	lastUpdateHeight := int64(s.monitoredApp.MonitoredKeeper.GetLastHealthcheckUpdateHeight(s.monitoredContext(), s.path.EndpointA.ChannelID))
+	for s.monitoredChain.CurrentHeader.Height-lastUpdateHeight < monitoredtypes.UpdateInterval {
+		s.coordinator.CommitBlock(s.monitoredChain)
+	}
+
+	pendingPackets := len(s.monitoredChain.App.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
+		s.monitoredContext(),
+		commontypes.MonitoredPortID,
+		s.path.EndpointA.ChannelID,
+	))
+
+	// updating the registry client commits the block with the healthcheck update,
+	// so the consensus state of the reported block is available
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	s.relayCommittedPackets(s.monitoredChain, s.path, commontypes.MonitoredPortID, s.path.EndpointA.ChannelID, pendingPackets+1)
+
+	monitoredChain := GetMonitoredChain(s, appmonitored.Name)
+	s.Require().True(monitoredChain.Verified)
+}
+
+func (s *HealthcheckTestSuite) queryMonitoredHealthcheckState() *monitoredtypes.QueryHealthcheckStateResponse {
+	state, err := s.monitoredApp.MonitoredKeeper.HealthcheckState(
+		sdk.WrapSDKContext(s.monitoredContext()),
+		&monitoredtypes.QueryHealthcheckStateRequest{ChannelId: s.path.EndpointA.ChannelID},
+	)
+	s.Require().NoError(err)
+
+	return state
+}
+
+func (s *HealthcheckTestSuite) TestMonitoredHealthcheckState() {
+	state := s.queryMonitoredHealthcheckState()
+	s.Require().Equal(s.path.EndpointA.ChannelID, state.RegistryChainChannelId)
+	s.Require().Equal(commontypes.SupportedFeatures(), state.Features)
+	s.Require().Equal(commontypes.SupportedFeatures(), GetMonitoredChain(s, appmonitored.Name).Features)
+	s.Require().Equal(channeltypes.OPEN.String(), state.ChannelState)
+	s.Require().Equal(state.LastUpdateHeight+monitoredtypes.UpdateInterval, state.NextUpdateHeight)
+	s.Require().Equal([]uint64{1}, state.PendingSequences)
+
+	s.relayCommittedPackets(s.monitoredChain, s.path, commontypes.MonitoredPortID, s.path.EndpointA.ChannelID, 1)
+
+	// relaying moves the monitored chain forward, so the next update may already be pending
+	state = s.queryMonitoredHealthcheckState()
+	s.Require().NotContains(state.PendingSequences, uint64(1))
+	s.Require().Equal(uint64(1), state.LastDeliveryResult.Sequence)
+	s.Require().Equal(uint64(monitoredtypes.AckSuccess), state.LastDeliveryResult.Outcome)
+	s.Require().Zero(state.ConsecutiveFailures)
+
+	// outcome of the relayed packet is recorded in the delivery log
+	deliveryRecord, found := s.monitoredApp.MonitoredKeeper.GetDeliveryRecordOfPacket(s.monitoredContext(), s.path.EndpointA.ChannelID, 1)
+	s.Require().True(found)
+	s.Require().Equal(uint64(monitoredtypes.AckSuccess), deliveryRecord.Outcome)
+	s.Require().NotZero(deliveryRecord.ResultHeight)
+}
+
+func (s *HealthcheckTestSuite) TestLatestOnlyBacklog() {
+	backlogThreshold := uint64(2)
+	params := monitoredtypes.DefaultParams()
+	params.LatestOnly = true
+	params.BacklogThreshold = backlogThreshold
+	// the params change would trigger a heartbeat that bypasses the backlog
+	params.HeartbeatOnParamsChange = false
+	s.monitoredApp.MonitoredKeeper.SetParams(s.monitoredContext(), params)
+
+	// the update sent during channel handshake is never relayed, so the following ones are skipped
+	lastUpdateHeight := s.monitoredApp.MonitoredKeeper.GetLastHealthcheckUpdateHeight(s.monitoredContext(), s.path.EndpointA.ChannelID)
+	for s.monitoredApp.MonitoredKeeper.GetLastHealthcheckUpdateHeight(s.monitoredContext(), s.path.EndpointA.ChannelID) == lastUpdateHeight {
+		s.Require().Equal(uint64(1), s.queryMonitoredHealthcheckState().BacklogSize)
+		s.coordinator.CommitBlock(s.monitoredChain)
+	}
+
+	// fresh update is sent once the backlog threshold is reached
+	s.Require().Equal(
+		lastUpdateHeight+(backlogThreshold+1)*monitoredtypes.UpdateInterval,
+		s.monitoredApp.MonitoredKeeper.GetLastHealthcheckUpdateHeight(s.monitoredContext(), s.path.EndpointA.ChannelID),
+	)
+
+	state := s.queryMonitoredHealthcheckState()
+	s.Require().Equal(uint64(2), state.BacklogSize)
+	s.Require().Zero(state.SkippedUpdates)
+}
+
+func (s *HealthcheckTestSuite) TestUnorderedChannel() {
+	// open an unordered healthcheck channel to another registry chain on a fresh connection,
+	// negotiated by a monitored chain using the legacy version
+	_, backupApp, backupPath := s.setupBackupRegistry(channeltypes.UNORDERED)
+	backupPath.EndpointA.ChannelConfig.Version = commontypes.Version
+	backupPath.EndpointB.ChannelConfig.Version = commontypes.Version
+	s.coordinator.CreateChannels(backupPath)
+
+	getBackupMonitoredChain := func() registrytypes.Chain {
+		monitoredChain, found := backupApp.HealthcheckKeeper.GetChain(backupPath.EndpointB.Chain.GetContext(), appmonitored.Name)
+		s.Require().True(found)
+		return monitoredChain
+	}
+
+	// legacy version doesn't negotiate any features
+	s.Require().Equal(channeltypes.UNORDERED, backupPath.EndpointA.GetChannel().Ordering)
+	s.Require().Equal(commontypes.Version, backupPath.EndpointB.GetChannel().Version)
+	s.Require().Empty(getBackupMonitoredChain().Features)
+
+	// wait for two healthcheck updates on the unordered channel
+	channelKeeper := s.monitoredChain.App.GetIBCKeeper().ChannelKeeper
+	for len(channelKeeper.GetAllPacketCommitmentsAtChannel(s.monitoredContext(), commontypes.MonitoredPortID, backupPath.EndpointA.ChannelID)) < 2 {
+		s.coordinator.CommitBlock(s.monitoredChain)
+	}
+
+	olderPacket, found := s.getSentPacket(s.monitoredChain, 1, backupPath.EndpointA.ChannelID)
+	s.Require().True(found)
+	newerPacket, found := s.getSentPacket(s.monitoredChain, 2, backupPath.EndpointA.ChannelID)
+	s.Require().True(found)
+
+	// the newer update is delivered first, the older one arrives late and is ignored
+	s.Require().NoError(backupPath.RelayPacket(newerPacket))
+	reportedBlock := getBackupMonitoredChain().Block
+	s.Require().NotZero(reportedBlock)
+
+	s.Require().NoError(backupPath.RelayPacket(olderPacket))
+	s.Require().Equal(reportedBlock, getBackupMonitoredChain().Block)
+
+	deliveryResult := s.monitoredApp.MonitoredKeeper.GetLastDeliveryResult(s.monitoredContext(), backupPath.EndpointA.ChannelID)
+	s.Require().Equal(olderPacket.Sequence, deliveryResult.Sequence)
+	s.Require().Equal(uint64(monitoredtypes.AckSuccess), deliveryResult.Outcome)
+}
+
+func (s *HealthcheckTestSuite) TestLegacyPayload() {
+	// registry chains negotiating the legacy version don't know the fields of the extended payload
+	_, backupApp, backupPath := s.setupBackupRegistry(channeltypes.ORDERED)
+	backupPath.EndpointA.ChannelConfig.Version = commontypes.Version
+	backupPath.EndpointB.ChannelConfig.Version = commontypes.Version
+	s.coordinator.CreateChannels(backupPath)
+
+	channelKeeper := s.monitoredChain.App.GetIBCKeeper().ChannelKeeper
+	for len(channelKeeper.GetAllPacketCommitmentsAtChannel(s.monitoredContext(), commontypes.MonitoredPortID, backupPath.EndpointA.ChannelID)) < 1 {
+		s.coordinator.CommitBlock(s.monitoredChain)
+	}
+
+	packet, found := s.getSentPacket(s.monitoredChain, 1, backupPath.EndpointA.ChannelID)
+	s.Require().True(found)
+
+	var packetData commontypes.HealthcheckPacketData
+	s.Require().NoError(monitoredtypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+	update := packetData.GetData()
+	s.Require().NotNil(update)
+	s.Require().JSONEq(
+		fmt.Sprintf(`{"data":{"timestamp":"%d","block":"%d"}}`, update.Timestamp, update.Block),
+		string(packet.GetData()),
+	)
+
+	s.Require().NoError(backupPath.RelayPacket(packet))
+	monitoredChain, found := backupApp.HealthcheckKeeper.GetChain(backupPath.EndpointB.Chain.GetContext(), appmonitored.Name)
+	s.Require().True(found)
+	s.Require().Equal(update.Block, monitoredChain.Block)
+}
+
+func (s *HealthcheckTestSuite) relayAllCommittedPackets() {
+	commitments := s.monitoredChain.App.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
+		s.monitoredContext(),
+		commontypes.MonitoredPortID,
+		s.path.EndpointA.ChannelID,
+	)
+	s.relayCommittedPackets(s.monitoredChain, s.path, commontypes.MonitoredPortID, s.path.EndpointA.ChannelID, len(commitments))
+}
+
+func (s *HealthcheckTestSuite) TestIntervalChange() {
+	setIntervals := func(updateInterval, timeoutInterval uint64) {
+		params := monitoredtypes.DefaultParams()
+		params.UpdateInterval = updateInterval
+		params.TimeoutInterval = timeoutInterval
+		s.monitoredApp.MonitoredKeeper.SetParams(s.monitoredContext(), params)
+		s.coordinator.CommitBlock(s.monitoredChain)
+		s.relayAllCommittedPackets()
+	}
+
+	monitoredChain := GetMonitoredChain(s, appmonitored.Name)
+	s.Require().Equal(monitoredtypes.DefaultUpdateInterval, monitoredChain.UpdateInterval)
+	s.Require().Equal(monitoredtypes.DefaultTimeoutInterval, monitoredChain.TimeoutInterval)
+
+	// registry chain applies the requested intervals and confirms them in the acknowledgement
+	setIntervals(15, 40)
+
+	monitoredChain = GetMonitoredChain(s, appmonitored.Name)
+	s.Require().Equal(uint64(15), monitoredChain.UpdateInterval)
+	s.Require().Equal(uint64(40), monitoredChain.TimeoutInterval)
+
+	metadata := s.monitoredApp.MonitoredKeeper.GetHandshakeMetadata(s.monitoredContext(), s.path.EndpointA.ChannelID)
+	s.Require().Equal(uint64(15), metadata.UpdateInterval)
+	s.Require().Equal(uint64(40), metadata.TimeoutInterval)
+
+	_, found := s.monitoredApp.MonitoredKeeper.GetIntervalChangeRequest(s.monitoredContext(), s.path.EndpointA.ChannelID)
+	s.Require().False(found)
+
+	// intervals out of the registry bounds are rejected and not requested again
+	setIntervals(registrytypes.DefaultMaxUpdateInterval+1, registrytypes.DefaultMaxTimeoutInterval)
+
+	monitoredChain = GetMonitoredChain(s, appmonitored.Name)
+	s.Require().Equal(uint64(15), monitoredChain.UpdateInterval)
+	s.Require().Equal(uint64(40), monitoredChain.TimeoutInterval)
+
+	metadata = s.monitoredApp.MonitoredKeeper.GetHandshakeMetadata(s.monitoredContext(), s.path.EndpointA.ChannelID)
+	s.Require().Equal(uint64(15), metadata.UpdateInterval)
+
+	_, found = s.monitoredApp.MonitoredKeeper.GetIntervalChangeRequest(s.monitoredContext(), s.path.EndpointA.ChannelID)
+	s.Require().False(found)
+}
+
+type testHealthReporter struct{}
+
+func (testHealthReporter) Name() string {
+	return "test"
+}
+
+func (testHealthReporter) ReportHealth(ctx sdk.Context) []commontypes.Indicator {
+	return []commontypes.Indicator{
+		{Key: "height", Value: &commontypes.Indicator_IntValue{IntValue: ctx.BlockHeight()}},
+	}
+}
+
+func (s *HealthcheckTestSuite) TestHealthIndicators() {
+	s.relayAllCommittedPackets()
+	s.monitoredApp.MonitoredKeeper.RegisterHealthReporter(testHealthReporter{})
+
+	s.coordinator.CommitNBlocks(s.monitoredChain, monitoredtypes.UpdateInterval)
+	s.relayAllCommittedPackets()
+
+	monitoredChain := GetMonitoredChain(s, appmonitored.Name)
+	chainIndicator, found := s.registryApp.HealthcheckKeeper.GetChainIndicator(s.registryContext(), appmonitored.Name, "test", "height")
+	s.Require().True(found)
+	s.Require().Equal(monitoredChain.Block, chainIndicator.Block)
+	s.Require().Equal(int64(monitoredChain.Block), chainIndicator.Indicator.GetIntValue())
+}
+
+func (s *HealthcheckTestSuite) TestParamsChangeHeartbeat() {
+	s.coordinator.CommitNBlocks(s.monitoredChain, monitoredtypes.UpdateInterval)
+	s.relayAllCommittedPackets()
+	s.Require().Equal(uint64(commontypes.ScheduledHeartbeat), GetMonitoredChain(s, appmonitored.Name).LastHeartbeatReason)
+
+	// the params change is reported in the following block, without waiting for the next scheduled update
+	params := s.monitoredApp.MonitoredKeeper.GetParams(s.monitoredContext())
+	params.ConcentrationTopN++
+	s.monitoredApp.MonitoredKeeper.SetParams(s.monitoredContext(), params)
+	s.coordinator.CommitBlock(s.monitoredChain)
+	s.relayAllCommittedPackets()
+
+	monitoredChain := GetMonitoredChain(s, appmonitored.Name)
+	s.Require().Equal(uint64(commontypes.ParamsChange), monitoredChain.LastHeartbeatReason)
+
+	heartbeats := s.registryApp.HealthcheckKeeper.GetAllHeartbeat(s.registryContext())
+	s.Require().Len(heartbeats, 1)
+	s.Require().Equal(monitoredChain.Block, heartbeats[0].Block)
+	s.Require().Equal(uint64(commontypes.ParamsChange), heartbeats[0].Reason)
+}
+
+// setupBackupRegistry creates another registry chain, connected to the monitored chain through a new connection.
+// The monitored chain is registered on it, but the registry channel is left to be opened by the caller.
+func (s *HealthcheckTestSuite) setupBackupRegistry(order channeltypes.Order) (*ibctesting.TestChain, *appregistry.App, *ibctesting.Path) {
+	ibctesting.DefaultTestingAppInit = SetupRegistryTestingApp
+	backupChain := ibctesting.NewTestChain(s.T(), s.coordinator, "backup")
+	s.coordinator.Chains[backupChain.ChainID] = backupChain
+	backupApp := backupChain.App.(*appregistry.App)
+
+	backupPath := ibctesting.NewPath(s.monitoredChain, backupChain)
+	s.coordinator.SetupClients(backupPath)
+	s.coordinator.CreateConnections(backupPath)
+	backupPath.EndpointA.ChannelConfig.PortID = commontypes.MonitoredPortID
+	backupPath.EndpointA.ChannelConfig.Order = order
+	backupPath.EndpointA.ChannelConfig.Version = ""
+	backupPath.EndpointB.ChannelConfig.PortID = commontypes.HealthcheckPortID
+	backupPath.EndpointB.ChannelConfig.Order = order
+	backupPath.EndpointB.ChannelConfig.Version = ""
+
+	backupApp.HealthcheckKeeper.SetChain(backupChain.GetContext(), registrytypes.Chain{
+		ChainId:      appmonitored.Name,
+		ConnectionId: backupPath.EndpointB.ConnectionID,
+	})
+
+	return backupChain, backupApp, backupPath
+}
+
+func (s *HealthcheckTestSuite) TestMultipleRegistryChannels() {
+	// the monitored chain reports to a backup registry chain as well
+	backupChain, backupApp, backupPath := s.setupBackupRegistry(channeltypes.ORDERED)
+	s.coordinator.CreateChannels(backupPath)
+
+	registryChannels := s.monitoredApp.MonitoredKeeper.GetAllRegistryChannel(s.monitoredContext())
+	s.Require().Len(registryChannels, 2)
+
+	// a registry chain is reached through a single registry channel
+	_, err := monitored.NewIBCModule(s.monitoredApp.MonitoredKeeper).OnChanOpenInit(
+		s.monitoredContext(),
+		channeltypes.ORDERED,
+		[]string{backupPath.EndpointA.ConnectionID},
+		commontypes.MonitoredPortID,
+		"channel-100",
+		nil,
+		channeltypes.NewCounterparty(commontypes.HealthcheckPortID, ""),
+		"",
+	)
+	s.Require().ErrorIs(err, monitoredtypes.ErrHealthcheckChannelAlreadySet)
+
+	relayBackupPackets := func() {
+		commitments := s.monitoredChain.App.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
+			s.monitoredContext(),
+			commontypes.MonitoredPortID,
+			backupPath.EndpointA.ChannelID,
+		)
+		s.relayCommittedPackets(s.monitoredChain, backupPath, commontypes.MonitoredPortID, backupPath.EndpointA.ChannelID, len(commitments))
+	}
+
+	// the backup registry channel is asked to apply its own intervals
+	params := monitoredtypes.DefaultParams()
+	params.ChannelIntervals = []monitoredtypes.ChannelIntervals{
+		{ChannelId: backupPath.EndpointA.ChannelID, UpdateInterval: 30, TimeoutInterval: 60},
+	}
+	s.monitoredApp.MonitoredKeeper.SetParams(s.monitoredContext(), params)
+	s.coordinator.CommitBlock(s.monitoredChain)
+	s.relayAllCommittedPackets()
+	relayBackupPackets()
+
+	backupMonitoredChain, found := backupApp.HealthcheckKeeper.GetChain(backupChain.GetContext(), appmonitored.Name)
+	s.Require().True(found)
+	s.Require().Equal(uint64(30), backupMonitoredChain.UpdateInterval)
+	s.Require().Equal(uint64(60), backupMonitoredChain.TimeoutInterval)
+	s.Require().Equal(uint64(registrytypes.Active), backupMonitoredChain.Status)
+	s.Require().Equal(monitoredtypes.DefaultUpdateInterval, GetMonitoredChain(s, appmonitored.Name).UpdateInterval)
+
+	// each registry channel is sent updates on its own schedule
+	keeper := s.monitoredApp.MonitoredKeeper
+	primaryPeriod := keeper.GetUpdatePeriod(s.monitoredContext(), s.path.EndpointA.ChannelID)
+	backupPeriod := keeper.GetUpdatePeriod(s.monitoredContext(), backupPath.EndpointA.ChannelID)
+	s.Require().Equal(uint64(5), primaryPeriod)
+	s.Require().Equal(uint64(15), backupPeriod)
+
+	updates := make(map[string]int)
+	lastUpdateHeights := make(map[string]uint64)
+	for _, registryChannel := range registryChannels {
+		lastUpdateHeights[registryChannel.ChannelId] = keeper.GetLastHealthcheckUpdateHeight(s.monitoredContext(), registryChannel.ChannelId)
+	}
+	for i := uint64(0); i < 3*backupPeriod; i++ {
+		s.coordinator.CommitBlock(s.monitoredChain)
+
+		for _, registryChannel := range registryChannels {
+			lastUpdateHeight := keeper.GetLastHealthcheckUpdateHeight(s.monitoredContext(), registryChannel.ChannelId)
+			if lastUpdateHeight != lastUpdateHeights[registryChannel.ChannelId] {
+				updates[registryChannel.ChannelId]++
+				lastUpdateHeights[registryChannel.ChannelId] = lastUpdateHeight
+			}
+		}
+	}
+	s.Require().Equal(9, updates[s.path.EndpointA.ChannelID])
+	s.Require().Equal(3, updates[backupPath.EndpointA.ChannelID])
+
+	state, err := keeper.HealthcheckState(
+		sdk.WrapSDKContext(s.monitoredContext()),
+		&monitoredtypes.QueryHealthcheckStateRequest{ChannelId: backupPath.EndpointA.ChannelID},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(30), state.UpdateInterval)
+	s.Require().Equal(lastUpdateHeights[backupPath.EndpointA.ChannelID]+backupPeriod, state.NextUpdateHeight)
+}
+
+func (s *HealthcheckTestSuite) TestPendingRegistryChannel() {
+	_, _, backupPath := s.setupBackupRegistry(channeltypes.ORDERED)
+	s.Require().NoError(backupPath.EndpointA.ChanOpenInit())
+
+	// the connection is taken by the registry channel whose handshake is in progress
+	_, err := monitored.NewIBCModule(s.monitoredApp.MonitoredKeeper).OnChanOpenInit(
+		s.monitoredContext(),
+		channeltypes.ORDERED,
+		[]string{backupPath.EndpointA.ConnectionID},
+		commontypes.MonitoredPortID,
+		"channel-100",
+		nil,
+		channeltypes.NewCounterparty(commontypes.HealthcheckPortID, ""),
+		"",
+	)
+	s.Require().ErrorIs(err, monitoredtypes.ErrHealthcheckChannelAlreadySet)
+
+	// the pending channel becomes a registry channel once the handshake is completed
+	s.Require().NoError(backupPath.EndpointB.ChanOpenTry())
+	s.Require().NoError(backupPath.EndpointA.ChanOpenAck())
+
+	keeper := s.monitoredApp.MonitoredKeeper
+	_, found := keeper.GetPendingRegistryChannel(s.monitoredContext(), backupPath.EndpointA.ChannelID)
+	s.Require().False(found)
+	_, found = keeper.GetRegistryChannel(s.monitoredContext(), backupPath.EndpointA.ChannelID)
+	s.Require().True(found)
+}
+
+func (s *HealthcheckTestSuite) TestAckedFeaturesProposed() {
+	_, _, backupPath := s.setupBackupRegistry(channeltypes.ORDERED)
+	proposedVersion, err := commontypes.NewHandshakeMetadata(0, 0, []string{commontypes.FeatureStructuredAck}).Encode()
+	s.Require().NoError(err)
+	backupPath.EndpointA.ChannelConfig.Version = proposedVersion
+	s.Require().NoError(backupPath.EndpointA.ChanOpenInit())
+
+	ibcModule := monitored.NewIBCModule(s.monitoredApp.MonitoredKeeper)
+	chanOpenAck := func(features []string) error {
+		counterpartyVersion, err := commontypes.NewHandshakeMetadata(0, 0, features).Encode()
+		s.Require().NoError(err)
+		return ibcModule.OnChanOpenAck(s.monitoredContext(), commontypes.MonitoredPortID, backupPath.EndpointA.ChannelID, "", counterpartyVersion)
+	}
+
+	// the probe feature is supported, but it wasn't proposed
+	s.Require().ErrorIs(chanOpenAck(commontypes.SupportedFeatures()), monitoredtypes.ErrInvalidVersion)
+	s.Require().NoError(chanOpenAck([]string{commontypes.FeatureStructuredAck}))
+}
+
+func (s *HealthcheckTestSuite) TestConnectivitySummaryLimit() {
+	_, _, backupPath := s.setupBackupRegistry(channeltypes.ORDERED)
+	s.coordinator.CreateChannels(backupPath)
+
+	keeper := s.monitoredApp.MonitoredKeeper
+	summary := keeper.GetConnectivitySummary(s.monitoredContext())
+	s.Require().Len(summary.Channels, 2)
+	for _, channel := range summary.Channels {
+		// the updates sent through the registry channels weren't relayed
+		s.Require().NotZero(channel.PendingPackets)
+	}
+
+	params := monitoredtypes.DefaultParams()
+	params.MaxConnectivityChannels = 1
+	keeper.SetParams(s.monitoredContext(), params)
+	s.Require().Len(keeper.GetConnectivitySummary(s.monitoredContext()).Channels, 1)
+}
+
+func (s *HealthcheckTestSuite) TestRegistryAllowlist() {
+	keeper := s.monitoredApp.MonitoredKeeper
+	ibcModule := monitored.NewIBCModule(keeper)
+	connectionID := s.path.EndpointA.ConnectionID
+	keeper.RemoveRegistryChannel(s.monitoredContext(), s.path.EndpointA.ChannelID)
+
+	chanOpenInit := func() error {
+		_, err := ibcModule.OnChanOpenInit(
+			s.monitoredContext(),
+			channeltypes.ORDERED,
+			[]string{connectionID},
+			commontypes.MonitoredPortID,
+			"channel-100",
+			nil,
+			channeltypes.NewCounterparty(commontypes.HealthcheckPortID, ""),
+			"",
+		)
+		return err
+	}
+
+	// the registry chain isn't allowed
+	params := monitoredtypes.DefaultParams()
+	params.AllowedRegistryChains = []string{"other-registry"}
+	keeper.SetParams(s.monitoredContext(), params)
+	s.Require().ErrorIs(chanOpenInit(), monitoredtypes.ErrRegistryNotAllowed)
+	s.Require().ErrorIs(
+		ibcModule.OnChanOpenAck(s.monitoredContext(), commontypes.MonitoredPortID, s.path.EndpointA.ChannelID, "", commontypes.Version),
+		monitoredtypes.ErrRegistryNotAllowed,
+	)
+
+	// the registry chain is allowed, but the connection isn't
+	params.AllowedRegistryChains = []string{s.registryChain.ChainID}
+	params.AllowedConnections = []string{"connection-100"}
+	keeper.SetParams(s.monitoredContext(), params)
+	s.Require().ErrorIs(chanOpenInit(), monitoredtypes.ErrRegistryNotAllowed)
+
+	// both the registry chain and the connection are allowed
+	params.AllowedConnections = []string{connectionID}
+	keeper.SetParams(s.monitoredContext(), params)
+	s.Require().NoError(keeper.ValidateRegistryConnection(s.monitoredContext(), connectionID))
+	s.Require().NoError(
+		ibcModule.OnChanOpenAck(s.monitoredContext(), commontypes.MonitoredPortID, s.path.EndpointA.ChannelID, "", commontypes.Version),
+	)
+	_, found := keeper.GetRegistryChannel(s.monitoredContext(), s.path.EndpointA.ChannelID)
+	s.Require().True(found)
+}
+
+type fixedChainIDResolver string
+
+func (r fixedChainIDResolver) ResolveChainID(ibcexported.ClientState) (string, error) {
+	return string(r), nil
+}
+
+func (s *HealthcheckTestSuite) TestRegistryAllowlistChainIDResolver() {
+	keeper := s.monitoredApp.MonitoredKeeper
+	connectionID := s.path.EndpointA.ConnectionID
+
+	params := monitoredtypes.DefaultParams()
+	params.AllowedRegistryChains = []string{"resolved-registry"}
+	keeper.SetParams(s.monitoredContext(), params)
+	s.Require().ErrorIs(keeper.ValidateRegistryConnection(s.monitoredContext(), connectionID), monitoredtypes.ErrRegistryNotAllowed)
+
+	// the registry chain is resolved with the resolver registered for its client type
+	keeper.SetChainIDResolver(ibcexported.Tendermint, fixedChainIDResolver("resolved-registry"))
+	s.Require().NoError(keeper.ValidateRegistryConnection(s.monitoredContext(), connectionID))
+
+	for _, channel := range keeper.GetConnectivitySummary(s.monitoredContext()).Channels {
+		s.Require().Equal("resolved-registry", channel.CounterpartyChainId)
+	}
+}
+
+func (s *HealthcheckTestSuite) TestRegistryRemovedFromAllowlist() {
+	keeper := s.monitoredApp.MonitoredKeeper
+	channelID := s.path.EndpointA.ChannelID
+
+	// governance removes the registry chain of the open registry channel from the allowlist
+	params := monitoredtypes.DefaultParams()
+	params.AllowedRegistryChains = []string{"other-registry"}
+	keeper.SetParams(s.monitoredContext(), params)
+
+	lastUpdateHeight := keeper.GetLastHealthcheckUpdateHeight(s.monitoredContext(), channelID)
+	for i := uint64(0); i < 2*keeper.GetUpdatePeriod(s.monitoredContext(), channelID); i++ {
+		s.coordinator.CommitBlock(s.monitoredChain)
+	}
+	s.Require().Equal(lastUpdateHeight, keeper.GetLastHealthcheckUpdateHeight(s.monitoredContext(), channelID))
+
+	// updates are resumed once the registry chain is allowed again
+	params.AllowedRegistryChains = append(params.AllowedRegistryChains, s.registryChain.ChainID)
+	keeper.SetParams(s.monitoredContext(), params)
+	s.coordinator.CommitBlock(s.monitoredChain)
+	s.Require().Less(lastUpdateHeight, keeper.GetLastHealthcheckUpdateHeight(s.monitoredContext(), channelID))
+}
+
+func (s *HealthcheckTestSuite) TestProbe() {
+	params := registrytypes.DefaultParams()
+	params.ProbeInterval = 100
+	s.registryApp.HealthcheckKeeper.SetParams(s.registryContext(), params)
+
+	// the registry chain sends the challenge to the monitored chain
+	s.coordinator.CommitBlock(s.registryChain)
+	probe, found := s.registryApp.HealthcheckKeeper.GetProbe(s.registryContext(), appmonitored.Name)
+	s.Require().True(found)
+	s.Require().NotZero(probe.Nonce)
+	s.relayCommittedPackets(s.registryChain, s.path, commontypes.HealthcheckPortID, s.path.EndpointB.ChannelID, 1)
+
+	// the monitored chain echoes the nonce in its next update
+	s.coordinator.CommitNBlocks(s.monitoredChain, monitoredtypes.UpdateInterval)
+	s.Require().Zero(s.monitoredApp.MonitoredKeeper.GetPendingProbe(s.monitoredContext(), s.path.EndpointA.ChannelID))
+	s.relayAllCommittedPackets()
+
+	answeredProbe, found := s.registryApp.HealthcheckKeeper.GetProbe(s.registryContext(), appmonitored.Name)
+	s.Require().True(found)
+	s.Require().Zero(answeredProbe.Nonce)
+	s.Require().Equal(uint64(1), answeredProbe.Answered)
+	s.Require().Zero(answeredProbe.Failed)
+	s.Require().Equal(answeredProbe.AnsweredRegistryBlockHeight-probe.SentRegistryBlockHeight, answeredProbe.RttBlocks)
+	s.Require().NotZero(answeredProbe.RttBlocks)
+	s.Require().NotZero(answeredProbe.RttSeconds)
+}
+
+func (s *HealthcheckTestSuite) TestRegistryStatus() {
+	keeper := s.monitoredApp.MonitoredKeeper
+	channelID := s.path.EndpointA.ChannelID
+
+	registryStatus, found := keeper.GetRegistryStatus(s.monitoredContext(), channelID)
+	s.Require().True(found)
+	s.Require().Equal(uint64(monitoredtypes.RegistryActive), registryStatus.Status)
+	s.Require().NotZero(registryStatus.ClientLatestHeight)
+
+	// registry chain stops responding, since nothing is relayed
+	inactivityInterval := keeper.GetRegistryInactivityInterval(s.monitoredContext(), channelID)
+	s.coordinator.CommitNBlocks(s.monitoredChain, inactivityInterval+1)
+	registryStatus, _ = keeper.GetRegistryStatus(s.monitoredContext(), channelID)
+	s.Require().Equal(uint64(monitoredtypes.RegistryInactive), registryStatus.Status)
+
+	// acknowledgements of the pending updates bring it back
+	s.relayAllCommittedPackets()
+	registryStatus, _ = keeper.GetRegistryStatus(s.monitoredContext(), channelID)
+	s.Require().Equal(uint64(monitoredtypes.RegistryActive), registryStatus.Status)
+	s.Require().Equal(GetMonitoredChain(s, appmonitored.Name).RegistryBlockHeight, registryStatus.RegistryBlockHeight)
+
+	state := s.queryMonitoredHealthcheckState()
+	s.Require().Equal(registryStatus, state.RegistryStatus)
+}
+
+func (s *HealthcheckTestSuite) TestRegistryClientFreshness() {
+	keeper := s.monitoredApp.MonitoredKeeper
+	channelID := s.path.EndpointA.ChannelID
+	params := monitoredtypes.DefaultParams()
+	params.RegistryClientFreshness = 60
+	keeper.SetParams(s.monitoredContext(), params)
+
+	inactivityInterval := keeper.GetRegistryInactivityInterval(s.monitoredContext(), channelID)
+	s.coordinator.CommitNBlocks(s.monitoredChain, inactivityInterval+1)
+	registryStatus, _ := keeper.GetRegistryStatus(s.monitoredContext(), channelID)
+	s.Require().Equal(uint64(monitoredtypes.RegistryInactive), registryStatus.Status)
+
+	// a light client updated with an old registry header doesn't prove the registry chain liveness
+	s.coordinator.CommitBlock(s.registryChain)
+	header, err := s.monitoredChain.ConstructUpdateTMClientHeader(s.registryChain, s.path.EndpointA.ClientID)
+	s.Require().NoError(err)
+	s.coordinator.IncrementTimeBy(2 * time.Minute)
+	s.coordinator.CommitBlock(s.monitoredChain)
+	msg, err := clienttypes.NewMsgUpdateClient(
+		s.path.EndpointA.ClientID, header,
+		s.monitoredChain.SenderAccount.GetAddress().String(),
+	)
+	s.Require().NoError(err)
+	_, err = s.monitoredChain.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	registryStatus, _ = keeper.GetRegistryStatus(s.monitoredContext(), channelID)
+	s.Require().Equal(uint64(monitoredtypes.RegistryInactive), registryStatus.Status)
+	s.Require().Equal(header.GetHeight(), clienttypes.NewHeight(
+		registryStatus.ClientRevisionNumber,
+		registryStatus.ClientLatestHeight,
+	))
+
+	// a recent one does
+	s.coordinator.CommitBlock(s.registryChain)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	registryStatus, _ = keeper.GetRegistryStatus(s.monitoredContext(), channelID)
+	s.Require().Equal(uint64(monitoredtypes.RegistryActive), registryStatus.Status)
+	s.Require().Equal(uint64(monitoredtypes.RegistryLightClient), registryStatus.EvidenceSource)
+}
+
+func (s *HealthcheckTestSuite) TestRelayLatency() {
+	s.coordinator.CommitNBlocks(s.monitoredChain, monitoredtypes.UpdateInterval)
+	s.relayAllCommittedPackets()
+
+	res, err := s.registryApp.HealthcheckKeeper.RelayLatency(
+		sdk.WrapSDKContext(s.registryContext()),
+		&registrytypes.QueryRelayLatencyRequest{ChainId: appmonitored.Name},
+	)
+	s.Require().NoError(err)
+	s.Require().NotZero(res.Measured)
+	s.Require().Greater(res.Last, uint64(time.Second/time.Millisecond))
+	s.Require().Equal(uint64(registrytypes.Active), GetMonitoredChain(s, appmonitored.Name).Status)
+
+	// the chain is degraded once the relay latency exceeds the threshold
+	params := registrytypes.DefaultParams()
+	params.DegradedLatency = res.Last/1000 - 1
+	s.registryApp.HealthcheckKeeper.SetParams(s.registryContext(), params)
+	s.coordinator.CommitNBlocks(s.monitoredChain, monitoredtypes.UpdateInterval)
+	s.relayAllCommittedPackets()
+	s.Require().Equal(uint64(registrytypes.Degraded), GetMonitoredChain(s, appmonitored.Name).Status)
+}
+
+func (s *HealthcheckTestSuite) TestStatusHysteresis() {
+	params := registrytypes.DefaultParams()
+	params.ActivationThreshold = 2
+	params.InactivationThreshold = 2
+	s.registryApp.HealthcheckKeeper.SetParams(s.registryContext(), params)
+	s.relayCommittedPackets(s.monitoredChain, s.path, commontypes.MonitoredPortID, s.path.EndpointA.ChannelID, 1)
+
+	// a single missed update interval doesn't deactivate the chain
+	monitoredChain := GetMonitoredChain(s, appmonitored.Name)
+	s.coordinator.CommitNBlocks(s.registryChain, monitoredChain.UpdateInterval+1)
+	s.Require().Equal(uint64(registrytypes.Active), GetMonitoredChain(s, appmonitored.Name).Status)
+
+	s.coordinator.CommitNBlocks(s.registryChain, monitoredChain.UpdateInterval)
+	s.Require().Equal(uint64(registrytypes.Inactive), GetMonitoredChain(s, appmonitored.Name).Status)
+
+	// the first update delivered again isn't enough to reactivate the chain
+	s.coordinator.CommitNBlocks(s.monitoredChain, monitoredtypes.UpdateInterval)
+	commitments := s.monitoredChain.App.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
+		s.monitoredContext(),
+		commontypes.MonitoredPortID,
+		s.path.EndpointA.ChannelID,
+	)
+	packet, found := s.getSentPacket(s.monitoredChain, commitments[0].Sequence, commitments[0].ChannelId)
+	s.Require().True(found)
+	s.Require().NoError(s.path.RelayPacket(packet))
+
+	monitoredChain = GetMonitoredChain(s, appmonitored.Name)
+	s.Require().Equal(uint64(registrytypes.Inactive), monitoredChain.Status)
+	s.Require().Equal(uint64(registrytypes.Recovering), monitoredChain.Diagnosis)
+
+	// the next on-time update does
+	s.relayAllCommittedPackets()
+	monitoredChain = GetMonitoredChain(s, appmonitored.Name)
+	s.Require().Equal(uint64(registrytypes.Active), monitoredChain.Status)
+	s.Require().Equal(uint64(registrytypes.NoDiagnosis), monitoredChain.Diagnosis)
+
+	// the acknowledged deadline accounts for the missed update intervals tolerated before the inactivation
+	healthcheckAck, found := s.monitoredApp.MonitoredKeeper.GetLastHealthcheckAck(s.monitoredContext(), s.path.EndpointA.ChannelID)
+	s.Require().True(found)
+	s.Require().Equal(monitoredChain.RegistryBlockHeight+2*monitoredChain.UpdateInterval, healthcheckAck.NextUpdateDeadline)
+}
@@ -96,11 +96,12 @@ func (suite *HealthcheckTestSuite) SetupTest() {
 
 	suite.path.EndpointA.ChannelConfig.PortID = commontypes.MonitoredPortID
 	suite.path.EndpointA.ChannelConfig.Order = types.ORDERED
-	suite.path.EndpointA.ChannelConfig.Version = commontypes.Version
+	// empty version makes the monitored chain propose all supported features
+	suite.path.EndpointA.ChannelConfig.Version = ""
 
 	suite.path.EndpointB.ChannelConfig.PortID = commontypes.HealthcheckPortID
 	suite.path.EndpointB.ChannelConfig.Order = types.ORDERED
-	suite.path.EndpointB.ChannelConfig.Version = commontypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = ""
 
 	suite.registryApp.HealthcheckKeeper.SetChain(suite.registryContext(),
 		registrytypes.Chain{
@@ -136,7 +137,7 @@ func (suite *HealthcheckTestSuite) relayCommittedPackets(
 	expectedPackets int,
 ) {
 	commitments := srcChain.App.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
-		suite.monitoredContext(),
+		srcChain.GetContext(),
 		portID,
 		channelID,
 	)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"healthcheck/x/healthcheck/keeper"
 	"healthcheck/x/healthcheck/types"
@@ -11,8 +12,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
@@ -69,6 +72,10 @@ func (healthcheckClientKeeper) GetClientState(ctx sdk.Context, clientID string)
 	return &tendermintClient.ClientState{}, true
 }
 
+func (healthcheckClientKeeper) GetClientConsensusState(ctx sdk.Context, clientID string, height ibcexported.Height) (ibcexported.ConsensusState, bool) {
+	return &tendermintClient.ConsensusState{Timestamp: time.Unix(0, 0)}, true
+}
+
 type healthcheckConnectionKeeper struct{}
 
 func (healthcheckConnectionKeeper) GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, bool) {
@@ -107,6 +114,7 @@ func HealthcheckKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		healthcheckClientKeeper{},
 		healthcheckConnectionKeeper{},
 		capabilityKeeper.ScopeToModule("HealthcheckScopedKeeper"),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, logger)
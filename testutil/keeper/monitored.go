@@ -14,8 +14,11 @@ import (
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -49,6 +52,38 @@ func (monitoredChannelKeeper) ChanCloseInit(ctx sdk.Context, portID, channelID s
 	return nil
 }
 
+func (monitoredChannelKeeper) IteratePacketCommitmentAtChannel(ctx sdk.Context, portID, channelID string, cb func(_, _ string, sequence uint64, hash []byte) bool) {
+}
+
+func (monitoredChannelKeeper) IterateChannels(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool) {
+}
+
+func (monitoredChannelKeeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error) {
+	return "", nil, channeltypes.ErrChannelNotFound
+}
+
+// monitoredClientKeeper is a stub of cosmosibckeeper.ClientKeeper
+type monitoredClientKeeper struct{}
+
+func (monitoredClientKeeper) GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool) {
+	return nil, false
+}
+
+func (monitoredClientKeeper) GetClientConsensusState(ctx sdk.Context, clientID string, height ibcexported.Height) (ibcexported.ConsensusState, bool) {
+	return nil, false
+}
+
+func (monitoredClientKeeper) ClientStore(ctx sdk.Context, clientID string) sdk.KVStore {
+	return nil
+}
+
+// monitoredConnectionKeeper is a stub of cosmosibckeeper.ConnectionKeeper
+type monitoredConnectionKeeper struct{}
+
+func (monitoredConnectionKeeper) GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, bool) {
+	return connectiontypes.ConnectionEnd{}, false
+}
+
 // monitoredportKeeper is a stub of cosmosibckeeper.PortKeeper
 type monitoredPortKeeper struct{}
 
@@ -56,6 +91,19 @@ func (monitoredPortKeeper) BindPort(ctx sdk.Context, portID string) *capabilityt
 	return &capabilitytypes.Capability{}
 }
 
+// monitoredStakingKeeper is a stub of stakingkeeper.Keeper
+type monitoredStakingKeeper struct{}
+
+func (monitoredStakingKeeper) IterateLastValidatorPowers(ctx sdk.Context, handler func(operator sdk.ValAddress, power int64) (stop bool)) {
+}
+
+// monitoredUpgradeKeeper is a stub of upgradekeeper.Keeper
+type monitoredUpgradeKeeper struct{}
+
+func (monitoredUpgradeKeeper) GetUpgradePlan(ctx sdk.Context) (upgradetypes.Plan, bool) {
+	return upgradetypes.Plan{}, false
+}
+
 func MonitoredKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	logger := log.NewNopLogger()
 
@@ -84,8 +132,12 @@ func MonitoredKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		memStoreKey,
 		paramsSubspace,
 		monitoredChannelKeeper{},
+		monitoredClientKeeper{},
+		monitoredConnectionKeeper{},
 		monitoredPortKeeper{},
 		capabilityKeeper.ScopeToModule("MonitoredScopedKeeper"),
+		monitoredStakingKeeper{},
+		monitoredUpgradeKeeper{},
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, logger)
@@ -27,6 +27,15 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListChain())
 	cmd.AddCommand(CmdShowChain())
+	cmd.AddCommand(CmdShowRelayerStats())
+	cmd.AddCommand(CmdRelayersForChain())
+	cmd.AddCommand(CmdDecentralizationMetrics())
+	cmd.AddCommand(CmdChainIndicators())
+	cmd.AddCommand(CmdTopology())
+	cmd.AddCommand(CmdChainNeighbors())
+	cmd.AddCommand(CmdHeartbeats())
+	cmd.AddCommand(CmdShowProbe())
+	cmd.AddCommand(CmdRelayLatency())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
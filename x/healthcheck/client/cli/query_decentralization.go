@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"healthcheck/x/healthcheck/types"
+)
+
+func CmdDecentralizationMetrics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decentralization-metrics [chain-id]",
+		Short: "lists the decentralization metrics reported by a chain over time",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argChainId := args[0]
+
+			params := &types.QueryDecentralizationMetricsRequest{
+				ChainId:    argChainId,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DecentralizationMetrics(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
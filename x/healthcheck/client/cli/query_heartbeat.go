@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"healthcheck/x/healthcheck/types"
+)
+
+func CmdHeartbeats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "heartbeats [chain-id]",
+		Short: "lists the out-of-band heartbeats sent by a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argChainId := args[0]
+
+			params := &types.QueryHeartbeatsRequest{
+				ChainId:    argChainId,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Heartbeats(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
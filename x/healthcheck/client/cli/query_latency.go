@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"healthcheck/x/healthcheck/types"
+)
+
+func CmdRelayLatency() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay-latency [chain-id]",
+		Short: "shows the relay latency percentiles of the healthcheck updates of a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argChainId := args[0]
+
+			params := &types.QueryRelayLatencyRequest{
+				ChainId: argChainId,
+			}
+
+			res, err := queryClient.RelayLatency(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
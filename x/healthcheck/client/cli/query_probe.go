@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"healthcheck/x/healthcheck/types"
+)
+
+func CmdShowProbe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-probe [chain-id]",
+		Short: "shows the liveness probes sent to a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argChainId := args[0]
+
+			params := &types.QueryProbeRequest{
+				ChainId: argChainId,
+			}
+
+			res, err := queryClient.Probe(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"healthcheck/x/healthcheck/types"
+)
+
+func CmdShowRelayerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-relayer-stats [relayer]",
+		Short: "shows the delivery statistics of a relayer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argRelayer := args[0]
+
+			params := &types.QueryRelayerStatsRequest{
+				Relayer: argRelayer,
+			}
+
+			res, err := queryClient.RelayerStats(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRelayersForChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayers-for-chain [chain-id]",
+		Short: "lists the relayers serving a chain, ordered by delivered packets",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argChainId := args[0]
+
+			params := &types.QueryRelayersForChainRequest{
+				ChainId: argChainId,
+			}
+
+			res, err := queryClient.RelayersForChain(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -33,6 +33,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateChain())
 	cmd.AddCommand(CmdUpdateChain())
 	cmd.AddCommand(CmdDeleteChain())
+	cmd.AddCommand(CmdResetChain())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -8,6 +8,11 @@ import (
 	"healthcheck/x/healthcheck/types"
 )
 
+const (
+	FlagPassive    = "passive"
+	FlagNewChainID = "new-chain-id"
+)
+
 func CmdCreateChain() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-chain [chain-id] [connection-id]",
@@ -25,10 +30,16 @@ func CmdCreateChain() *cobra.Command {
 				return err
 			}
 
+			argPassive, err := cmd.Flags().GetBool(FlagPassive)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateChain(
 				clientCtx.GetFromAddress().String(),
 				indexChainId,
 				argConnectionId,
+				argPassive,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -37,6 +48,7 @@ func CmdCreateChain() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagPassive, false, "monitor the chain passively through its light client, without the monitored module")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -59,10 +71,16 @@ func CmdUpdateChain() *cobra.Command {
 				return err
 			}
 
+			argPassive, err := cmd.Flags().GetBool(FlagPassive)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateChain(
 				clientCtx.GetFromAddress().String(),
 				indexChainId,
 				argConnectionId,
+				argPassive,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -71,6 +89,7 @@ func CmdUpdateChain() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagPassive, false, "monitor the chain passively through its light client, without the monitored module")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -104,3 +123,39 @@ func CmdDeleteChain() *cobra.Command {
 
 	return cmd
 }
+
+func CmdResetChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-chain [chain-id]",
+		Short: "Acknowledge a restart or a revision bump of a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			indexChainId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			argNewChainId, err := cmd.Flags().GetString(FlagNewChainID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetChain(
+				clientCtx.GetFromAddress().String(),
+				indexChainId,
+				argNewChainId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagNewChainID, "", "chain ID of the new revision, if the chain bumped its revision")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -12,6 +12,38 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.ChainList {
 		k.SetChain(ctx, elem)
 	}
+	// Set all the relayerStats
+	for _, elem := range genState.RelayerStatsList {
+		k.SetRelayerStats(ctx, elem)
+	}
+	// Set all the chainRelayer
+	for _, elem := range genState.ChainRelayerList {
+		k.SetChainRelayer(ctx, elem)
+	}
+	// Set all the decentralizationSample
+	for _, elem := range genState.DecentralizationSampleList {
+		k.SetDecentralizationSample(ctx, elem)
+	}
+	// Set all the chainIndicator
+	for _, elem := range genState.ChainIndicatorList {
+		k.SetChainIndicator(ctx, elem)
+	}
+	// Set all the chainConnectivity
+	for _, elem := range genState.ChainConnectivityList {
+		k.SetChainConnectivity(ctx, elem)
+	}
+	// Set all the heartbeat
+	for _, elem := range genState.HeartbeatList {
+		k.SetHeartbeat(ctx, elem)
+	}
+	// Set all the probe
+	for _, elem := range genState.ProbeList {
+		k.SetProbe(ctx, elem)
+	}
+	// Set all the relayLatency
+	for _, elem := range genState.RelayLatencyList {
+		k.SetRelayLatency(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetPort(ctx, genState.PortId)
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -34,6 +66,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.PortId = k.GetPort(ctx)
 	genesis.ChainList = k.GetAllChain(ctx)
+	genesis.RelayerStatsList = k.GetAllRelayerStats(ctx)
+	genesis.ChainRelayerList = k.GetAllChainRelayer(ctx)
+	genesis.DecentralizationSampleList = k.GetAllDecentralizationSample(ctx)
+	genesis.ChainIndicatorList = k.GetAllChainIndicator(ctx)
+	genesis.ChainConnectivityList = k.GetAllChainConnectivity(ctx)
+	genesis.HeartbeatList = k.GetAllHeartbeat(ctx)
+	genesis.ProbeList = k.GetAllProbe(ctx)
+	genesis.RelayLatencyList = k.GetAllRelayLatency(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
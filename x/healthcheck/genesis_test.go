@@ -8,6 +8,7 @@ import (
 	"healthcheck/testutil/nullify"
 	"healthcheck/x/healthcheck"
 	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
 )
 
 func TestGenesis(t *testing.T) {
@@ -22,6 +23,78 @@ func TestGenesis(t *testing.T) {
 				ChainId: "1",
 			},
 		},
+		RelayerStatsList: []types.RelayerStats{
+			{
+				Relayer: "0",
+			},
+			{
+				Relayer: "1",
+			},
+		},
+		ChainRelayerList: []types.ChainRelayer{
+			{
+				ChainId: "0",
+				Relayer: "0",
+			},
+			{
+				ChainId: "1",
+				Relayer: "0",
+			},
+		},
+		DecentralizationSampleList: []types.DecentralizationSample{
+			{
+				ChainId:             "0",
+				RegistryBlockHeight: 0,
+			},
+			{
+				ChainId:             "0",
+				RegistryBlockHeight: 1,
+			},
+		},
+		ChainIndicatorList: []types.ChainIndicator{
+			{
+				ChainId:   "0",
+				Indicator: commontypes.Indicator{Reporter: "0", Key: "0"},
+			},
+			{
+				ChainId:   "0",
+				Indicator: commontypes.Indicator{Reporter: "0", Key: "1"},
+			},
+		},
+		ChainConnectivityList: []types.ChainConnectivity{
+			{
+				ChainId: "0",
+			},
+			{
+				ChainId: "1",
+			},
+		},
+		HeartbeatList: []types.Heartbeat{
+			{
+				ChainId:             "0",
+				RegistryBlockHeight: 0,
+			},
+			{
+				ChainId:             "0",
+				RegistryBlockHeight: 1,
+			},
+		},
+		ProbeList: []types.Probe{
+			{
+				ChainId: "0",
+			},
+			{
+				ChainId: "1",
+			},
+		},
+		RelayLatencyList: []types.RelayLatency{
+			{
+				ChainId: "0",
+			},
+			{
+				ChainId: "1",
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -36,5 +109,13 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.PortId, got.PortId)
 
 	require.ElementsMatch(t, genesisState.ChainList, got.ChainList)
+	require.ElementsMatch(t, genesisState.RelayerStatsList, got.RelayerStatsList)
+	require.ElementsMatch(t, genesisState.ChainRelayerList, got.ChainRelayerList)
+	require.ElementsMatch(t, genesisState.DecentralizationSampleList, got.DecentralizationSampleList)
+	require.ElementsMatch(t, genesisState.ChainIndicatorList, got.ChainIndicatorList)
+	require.ElementsMatch(t, genesisState.ChainConnectivityList, got.ChainConnectivityList)
+	require.ElementsMatch(t, genesisState.HeartbeatList, got.HeartbeatList)
+	require.ElementsMatch(t, genesisState.ProbeList, got.ProbeList)
+	require.ElementsMatch(t, genesisState.RelayLatencyList, got.RelayLatencyList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+// SetDecentralizationSample set a specific decentralizationSample in the store from its index
+func (k Keeper) SetDecentralizationSample(ctx sdk.Context, sample types.DecentralizationSample) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DecentralizationSampleKeyPrefix))
+	b := k.cdc.MustMarshal(&sample)
+	store.Set(types.DecentralizationSampleKey(
+		sample.ChainId,
+		sample.RegistryBlockHeight,
+	), b)
+}
+
+// GetAllDecentralizationSample returns all decentralizationSample
+func (k Keeper) GetAllDecentralizationSample(ctx sdk.Context) (list []types.DecentralizationSample) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DecentralizationSampleKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DecentralizationSample
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RecordDecentralizationMetrics adds the decentralization metrics reported in the healthcheck update
+// to the time series of the monitored chain. Metrics reported before the decentralization sample interval
+// elapsed are ignored, and samples older than the decentralization retention are pruned.
+func (k Keeper) RecordDecentralizationMetrics(ctx sdk.Context, chainID string, update commontypes.HealthcheckUpdateData) {
+	metrics := update.Decentralization
+	if metrics == nil {
+		return
+	}
+
+	chainPrefix := types.DecentralizationSamplePrefix(chainID)
+	lastHeight, found := k.getLastChainHistoryHeight(ctx, types.DecentralizationSampleKeyPrefix, chainPrefix)
+	if found && uint64(ctx.BlockHeight()) < lastHeight+k.DecentralizationSampleInterval(ctx) {
+		return
+	}
+
+	k.SetDecentralizationSample(ctx, types.DecentralizationSample{
+		ChainId:             chainID,
+		RegistryBlockHeight: uint64(ctx.BlockHeight()),
+		Block:               update.Block,
+		Timestamp:           update.Timestamp,
+		ValidatorCount:      metrics.ValidatorCount,
+		NakamotoCoefficient: metrics.NakamotoCoefficient,
+		TopN:                metrics.TopN,
+		TopNConcentration:   metrics.TopNConcentration,
+	})
+
+	k.pruneChainHistory(ctx, types.DecentralizationSampleKeyPrefix, chainPrefix, k.DecentralizationRetention(ctx))
+}
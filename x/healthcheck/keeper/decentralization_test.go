@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestRecordDecentralizationMetrics(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	params := types.DefaultParams()
+	params.DecentralizationSampleInterval = 10
+	params.DecentralizationRetention = 25
+	keeper.SetParams(ctx, params)
+
+	recordAt := func(registryBlockHeight int64) {
+		ctx = ctx.WithBlockHeight(registryBlockHeight)
+		keeper.RecordDecentralizationMetrics(ctx, "chain-0", commontypes.HealthcheckUpdateData{
+			Decentralization: &commontypes.DecentralizationMetrics{ValidatorCount: 4},
+		})
+	}
+	sampleHeights := func() (heights []uint64) {
+		for _, sample := range keeper.GetAllDecentralizationSample(ctx) {
+			heights = append(heights, sample.RegistryBlockHeight)
+		}
+		return heights
+	}
+
+	recordAt(10)
+	recordAt(15)
+	require.Equal(t, []uint64{10}, sampleHeights())
+
+	// samples are accepted once the sample interval elapsed
+	recordAt(20)
+	recordAt(30)
+	require.Equal(t, []uint64{10, 20, 30}, sampleHeights())
+
+	// samples older than the retention are pruned
+	recordAt(40)
+	require.Equal(t, []uint64{20, 30, 40}, sampleHeights())
+
+	// updates without the metrics don't add samples
+	ctx = ctx.WithBlockHeight(60)
+	keeper.RecordDecentralizationMetrics(ctx, "chain-0", commontypes.HealthcheckUpdateData{})
+	require.Equal(t, []uint64{20, 30, 40}, sampleHeights())
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/healthcheck/types"
+)
+
+// TrackClientProgress samples the latest height of the light client of the monitored chain
+// and records the registry block height at which the client height last advanced.
+// It returns true if the chain was modified.
+func (k Keeper) TrackClientProgress(ctx sdk.Context, monitoredChain *types.Chain) bool {
+	if monitoredChain.ConnectionId == "" {
+		return false
+	}
+
+	latestHeight, err := k.GetCounterpartyClientLatestHeight(ctx, monitoredChain.ConnectionId)
+	if err != nil {
+		k.Logger(ctx).Debug("failed to get client latest height", "chain-id", monitoredChain.ChainId, "error", err.Error())
+		return false
+	}
+
+	if latestHeight.GetRevisionHeight() == monitoredChain.ClientLatestHeight {
+		return false
+	}
+
+	monitoredChain.ClientLatestHeight = latestHeight.GetRevisionHeight()
+	monitoredChain.ClientUpdateRegistryHeight = uint64(ctx.BlockHeight())
+
+	return true
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+// SetHeartbeat set a specific heartbeat in the store from its index
+func (k Keeper) SetHeartbeat(ctx sdk.Context, heartbeat types.Heartbeat) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HeartbeatKeyPrefix))
+	b := k.cdc.MustMarshal(&heartbeat)
+	store.Set(types.HeartbeatKey(
+		heartbeat.ChainId,
+		heartbeat.RegistryBlockHeight,
+	), b)
+}
+
+// GetAllHeartbeat returns all heartbeat
+func (k Keeper) GetAllHeartbeat(ctx sdk.Context) (list []types.Heartbeat) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HeartbeatKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Heartbeat
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RecordHeartbeat keeps the history of the out-of-band heartbeats sent by the monitored chain.
+// Scheduled healthcheck updates aren't recorded, since they are already reflected in the chain status.
+// Heartbeats older than the heartbeat retention are pruned.
+func (k Keeper) RecordHeartbeat(ctx sdk.Context, chainID string, update commontypes.HealthcheckUpdateData) {
+	if commontypes.HeartbeatReason(update.Reason) == commontypes.ScheduledHeartbeat {
+		return
+	}
+
+	k.SetHeartbeat(ctx, types.Heartbeat{
+		ChainId:             chainID,
+		RegistryBlockHeight: uint64(ctx.BlockHeight()),
+		Block:               update.Block,
+		Timestamp:           update.Timestamp,
+		Reason:              update.Reason,
+	})
+	k.pruneChainHistory(ctx, types.HeartbeatKeyPrefix, types.HeartbeatPrefix(chainID), k.HeartbeatRetention(ctx))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHeartbeat,
+			sdk.NewAttribute(types.AttributeKeyChainID, chainID),
+			sdk.NewAttribute(types.AttributeKeyBlock, strconv.FormatUint(update.Block, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, strconv.FormatUint(update.Reason, 10)),
+		),
+	)
+}
@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestRecordHeartbeat(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	params := types.DefaultParams()
+	params.HeartbeatRetention = 15
+	keeper.SetParams(ctx, params)
+
+	recordAt := func(registryBlockHeight int64, reason commontypes.HeartbeatReason) {
+		ctx = ctx.WithBlockHeight(registryBlockHeight)
+		keeper.RecordHeartbeat(ctx, "chain-0", commontypes.HealthcheckUpdateData{Reason: uint64(reason)})
+	}
+	heartbeatHeights := func() (heights []uint64) {
+		for _, heartbeat := range keeper.GetAllHeartbeat(ctx) {
+			heights = append(heights, heartbeat.RegistryBlockHeight)
+		}
+		return heights
+	}
+
+	recordAt(10, commontypes.ParamsChange)
+	recordAt(20, commontypes.ScheduledHeartbeat)
+	recordAt(20, commontypes.ParamsChange)
+	require.Equal(t, []uint64{10, 20}, heartbeatHeights())
+
+	// heartbeats older than the retention are pruned
+	recordAt(30, commontypes.ParamsChange)
+	require.Equal(t, []uint64{20, 30}, heartbeatHeights())
+}
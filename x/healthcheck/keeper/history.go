@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/healthcheck/types"
+)
+
+// pruneChainHistory removes the records of the chain history stored under the key prefix that were recorded more
+// than the retention number of registry blocks ago. Records of the history are indexed by the chain prefix followed
+// by the registry block height they were recorded at.
+func (k Keeper) pruneChainHistory(ctx sdk.Context, keyPrefix string, chainPrefix []byte, retention uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, chainPrefix)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		// records are iterated from the oldest one
+		registryBlockHeight := binary.BigEndian.Uint64(iterator.Key()[len(chainPrefix):])
+		if registryBlockHeight+retention >= uint64(ctx.BlockHeight()) {
+			break
+		}
+
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getLastChainHistoryHeight returns the registry block height of the latest record of the chain history stored
+// under the key prefix
+func (k Keeper) getLastChainHistoryHeight(ctx sdk.Context, keyPrefix string, chainPrefix []byte) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	iterator := sdk.KVStoreReversePrefixIterator(store, chainPrefix)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint64(iterator.Key()[len(chainPrefix):]), true
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+// SetChainIndicator set a specific chainIndicator in the store from its index
+func (k Keeper) SetChainIndicator(ctx sdk.Context, chainIndicator types.ChainIndicator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainIndicatorKeyPrefix))
+	b := k.cdc.MustMarshal(&chainIndicator)
+	store.Set(types.ChainIndicatorKey(
+		chainIndicator.ChainId,
+		chainIndicator.Indicator.Reporter,
+		chainIndicator.Indicator.Key,
+	), b)
+}
+
+// GetChainIndicator returns a chainIndicator from its index
+func (k Keeper) GetChainIndicator(
+	ctx sdk.Context,
+	chainId string,
+	reporter string,
+	key string,
+) (val types.ChainIndicator, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainIndicatorKeyPrefix))
+
+	b := store.Get(types.ChainIndicatorKey(
+		chainId,
+		reporter,
+		key,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllChainIndicator returns all chainIndicator
+func (k Keeper) GetAllChainIndicator(ctx sdk.Context) (list []types.ChainIndicator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainIndicatorKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ChainIndicator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveChainIndicators removes all indicators of the monitored chain from the store
+func (k Keeper) RemoveChainIndicators(ctx sdk.Context, chainId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainIndicatorKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIndicatorPrefix(chainId))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RecordIndicators replaces the indicators of the monitored chain with the ones reported in the healthcheck update,
+// so that the indicators of the reporters that were removed from the monitored chain don't linger. At most
+// MaxChainIndicators indicators are stored.
+func (k Keeper) RecordIndicators(ctx sdk.Context, chainID string, update commontypes.HealthcheckUpdateData) {
+	k.RemoveChainIndicators(ctx, chainID)
+
+	maxIndicators := k.MaxChainIndicators(ctx)
+	for i, indicator := range update.Indicators {
+		if uint64(i) >= maxIndicators {
+			break
+		}
+
+		k.SetChainIndicator(ctx, types.ChainIndicator{
+			ChainId:             chainID,
+			RegistryBlockHeight: uint64(ctx.BlockHeight()),
+			Block:               update.Block,
+			Indicator:           indicator,
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestRecordIndicators(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	params := types.DefaultParams()
+	params.MaxChainIndicators = 2
+	keeper.SetParams(ctx, params)
+
+	keeper.RecordIndicators(ctx, "chain-0", commontypes.HealthcheckUpdateData{
+		Block: 1,
+		Indicators: []commontypes.Indicator{
+			{Reporter: "bridge", Key: "paused", Value: &commontypes.Indicator_BoolValue{BoolValue: false}},
+			{Reporter: "dex", Key: "pools", Value: &commontypes.Indicator_UintValue{UintValue: 3}},
+			{Reporter: "dex", Key: "volume", Value: &commontypes.Indicator_UintValue{UintValue: 100}},
+		},
+	})
+	keeper.RecordIndicators(ctx, "chain-1", commontypes.HealthcheckUpdateData{
+		Block: 1,
+		Indicators: []commontypes.Indicator{
+			{Reporter: "dex", Key: "pools", Value: &commontypes.Indicator_UintValue{UintValue: 5}},
+		},
+	})
+	require.Len(t, keeper.GetAllChainIndicator(ctx), 3)
+
+	// the bridge reporter was removed from the monitored chain
+	keeper.RecordIndicators(ctx, "chain-0", commontypes.HealthcheckUpdateData{
+		Block: 2,
+		Indicators: []commontypes.Indicator{
+			{Reporter: "dex", Key: "pools", Value: &commontypes.Indicator_UintValue{UintValue: 4}},
+		},
+	})
+
+	_, found := keeper.GetChainIndicator(ctx, "chain-0", "bridge", "paused")
+	require.False(t, found)
+	indicator, found := keeper.GetChainIndicator(ctx, "chain-0", "dex", "pools")
+	require.True(t, found)
+	require.Equal(t, uint64(2), indicator.Block)
+	require.Equal(t, uint64(4), indicator.Indicator.GetUintValue())
+
+	_, found = keeper.GetChainIndicator(ctx, "chain-1", "dex", "pools")
+	require.True(t, found)
+}
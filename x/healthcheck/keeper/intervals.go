@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"healthcheck/x/healthcheck/types"
+)
+
+// ValidateIntervals checks that the update and timeout intervals requested by a monitored chain are within
+// the bounds set by the params
+func (k Keeper) ValidateIntervals(ctx sdk.Context, updateInterval uint64, timeoutInterval uint64) error {
+	if updateInterval == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidIntervals, "update interval must be positive")
+	}
+
+	if timeoutInterval <= updateInterval {
+		return sdkerrors.Wrapf(types.ErrInvalidIntervals, "timeout interval %d must be greater than the update interval %d", timeoutInterval, updateInterval)
+	}
+
+	if maxUpdateInterval := k.MaxUpdateInterval(ctx); updateInterval > maxUpdateInterval {
+		return sdkerrors.Wrapf(types.ErrInvalidIntervals, "update interval %d exceeds the maximum of %d", updateInterval, maxUpdateInterval)
+	}
+
+	if maxTimeoutInterval := k.MaxTimeoutInterval(ctx); timeoutInterval > maxTimeoutInterval {
+		return sdkerrors.Wrapf(types.ErrInvalidIntervals, "timeout interval %d exceeds the maximum of %d", timeoutInterval, maxTimeoutInterval)
+	}
+
+	return nil
+}
+
+// ChangeIntervals validates and applies the intervals requested by a monitored chain over an open channel
+func (k Keeper) ChangeIntervals(ctx sdk.Context, monitoredChain *types.Chain, updateInterval uint64, timeoutInterval uint64) error {
+	if err := k.ValidateIntervals(ctx, updateInterval, timeoutInterval); err != nil {
+		return err
+	}
+
+	monitoredChain.UpdateInterval = updateInterval
+	monitoredChain.TimeoutInterval = timeoutInterval
+	k.SetChain(ctx, *monitoredChain)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIntervalChange,
+			sdk.NewAttribute(types.AttributeKeyChainID, monitoredChain.ChainId),
+			sdk.NewAttribute(types.AttributeKeyUpdateInterval, strconv.FormatUint(updateInterval, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimeoutInterval, strconv.FormatUint(timeoutInterval, 10)),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/x/healthcheck/types"
+)
+
+func TestValidateIntervals(t *testing.T) {
+	for _, tc := range []struct {
+		desc            string
+		updateInterval  uint64
+		timeoutInterval uint64
+		valid           bool
+	}{
+		{
+			desc:            "Valid",
+			updateInterval:  types.DefaultMaxUpdateInterval,
+			timeoutInterval: types.DefaultMaxTimeoutInterval,
+			valid:           true,
+		},
+		{
+			desc:            "ZeroUpdateInterval",
+			updateInterval:  0,
+			timeoutInterval: 10,
+		},
+		{
+			desc:            "TimeoutNotAfterUpdate",
+			updateInterval:  10,
+			timeoutInterval: 10,
+		},
+		{
+			desc:            "UpdateIntervalTooLong",
+			updateInterval:  types.DefaultMaxUpdateInterval + 1,
+			timeoutInterval: types.DefaultMaxTimeoutInterval,
+		},
+		{
+			desc:            "TimeoutIntervalTooLong",
+			updateInterval:  10,
+			timeoutInterval: types.DefaultMaxTimeoutInterval + 1,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.HealthcheckKeeper(t)
+
+			err := k.ValidateIntervals(ctx, tc.updateInterval, tc.timeoutInterval)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidIntervals)
+			}
+		})
+	}
+}
@@ -14,10 +14,10 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
-	ibctmtypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
 )
 
 type (
@@ -32,6 +32,12 @@ type (
 		clientKeeper     types.ClientKeeper
 		connectionKeeper types.ConnectionKeeper
 		scopedKeeper     exported.ScopedKeeper
+
+		chainIDResolvers commontypes.ChainIDResolvers
+
+		// the address capable of acknowledging chain resets in addition to the chain owners,
+		// usually the x/gov module account
+		authority string
 	}
 )
 
@@ -45,6 +51,7 @@ func NewKeeper(
 	clientKeeper types.ClientKeeper,
 	connectionKeeper types.ConnectionKeeper,
 	scopedKeeper exported.ScopedKeeper,
+	authority string,
 
 ) *Keeper {
 	// set KeyTable if it has not already been set
@@ -63,9 +70,17 @@ func NewKeeper(
 		clientKeeper:     clientKeeper,
 		connectionKeeper: connectionKeeper,
 		scopedKeeper:     scopedKeeper,
+		chainIDResolvers: commontypes.DefaultChainIDResolvers(),
+
+		authority: authority,
 	}
 }
 
+// GetAuthority returns the address capable of acknowledging chain resets in addition to the chain owners
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // ----------------------------------------------------------------------------
 // IBC Keeper Logic
 // ----------------------------------------------------------------------------
@@ -120,23 +135,62 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// SetChainIDResolver registers the resolver of counterparty chain IDs for the given light client type,
+// replacing the existing one
+func (k Keeper) SetChainIDResolver(clientType string, resolver commontypes.ChainIDResolver) {
+	k.chainIDResolvers[clientType] = resolver
+}
+
 func (k Keeper) GetCounterpartyChainIDFromConnection(ctx sdk.Context, connectionID string) (string, error) {
-	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	_, clientState, err := k.getCounterpartyClient(ctx, connectionID)
+	if err != nil {
+		return "", err
+	}
+
+	return k.chainIDResolvers.ResolveChainID(clientState)
+}
+
+// GetCounterpartyClientLatestHeight returns the latest height of the light client
+// that tracks the counterparty chain of the given connection
+func (k Keeper) GetCounterpartyClientLatestHeight(ctx sdk.Context, connectionID string) (exported.Height, error) {
+	_, clientState, err := k.getCounterpartyClient(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientState.GetLatestHeight(), nil
+}
+
+// GetCounterpartyConsensusTimestamp returns the timestamp of the consensus state stored by the light client
+// of the given connection for the given block of the counterparty chain. The block is interpreted
+// within the revision of the client's latest height.
+func (k Keeper) GetCounterpartyConsensusTimestamp(ctx sdk.Context, connectionID string, block uint64) (uint64, error) {
+	clientID, clientState, err := k.getCounterpartyClient(ctx, connectionID)
+	if err != nil {
+		return 0, err
+	}
+
+	height := clienttypes.NewHeight(clientState.GetLatestHeight().GetRevisionNumber(), block)
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, height)
 	if !found {
-		return "", sdkerrors.Wrapf(connectiontypes.ErrConnectionNotFound, "connection-id: %s", connectionID)
+		return 0, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "client-id: %s, height: %s", clientID, height)
 	}
 
-	clientState, found := k.clientKeeper.GetClientState(ctx, connection.ClientId)
+	return consensusState.GetTimestamp(), nil
+}
+
+func (k Keeper) getCounterpartyClient(ctx sdk.Context, connectionID string) (string, exported.ClientState, error) {
+	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
 	if !found {
-		return "", sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "client-id: %s", connection.ClientId)
+		return "", nil, sdkerrors.Wrapf(connectiontypes.ErrConnectionNotFound, "connection-id: %s", connectionID)
 	}
 
-	tendermintClient, ok := clientState.(*ibctmtypes.ClientState)
-	if !ok {
-		return "", sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "invalid client type. expected: %s, got %s", exported.Tendermint, clientState.ClientType())
+	clientState, found := k.clientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return "", nil, sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "client-id: %s", connection.ClientId)
 	}
 
-	return tendermintClient.ChainId, nil
+	return connection.ClientId, clientState, nil
 }
 
 func (k Keeper) GetCounterpartyChainIDFromChannel(ctx sdk.Context, portID, channelID string) (string, error) {
@@ -145,12 +199,13 @@ func (k Keeper) GetCounterpartyChainIDFromChannel(ctx sdk.Context, portID, chann
 		return "", err
 	}
 
-	tendermintClient, ok := clientState.(*ibctmtypes.ClientState)
-	if !ok {
-		return "", sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "invalid client type. expected: %s, got %s", exported.Tendermint, clientState.ClientType())
-	}
+	return k.chainIDResolvers.ResolveChainID(clientState)
+}
 
-	return tendermintClient.ChainId, nil
+// IsUnorderedChannel returns true if the given healthcheck channel was negotiated as UNORDERED
+func (k Keeper) IsUnorderedChannel(ctx sdk.Context, portID, channelID string) bool {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	return found && channel.Ordering == channeltypes.UNORDERED
 }
 
 func (k Keeper) IterateMonitoredChains(ctx sdk.Context, fn func(chain types.Chain) (stop bool)) {
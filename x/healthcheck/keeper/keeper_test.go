@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"testing"
+
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcheck/testutil/keeper"
+)
+
+type fixedChainIDResolver string
+
+func (r fixedChainIDResolver) ResolveChainID(ibcexported.ClientState) (string, error) {
+	return string(r), nil
+}
+
+func TestSetChainIDResolver(t *testing.T) {
+	k, ctx := keepertest.HealthcheckKeeper(t)
+
+	// the stubbed client keeper returns Tendermint clients
+	k.SetChainIDResolver(ibcexported.Tendermint, fixedChainIDResolver("foo-1"))
+
+	chainID, err := k.GetCounterpartyChainIDFromConnection(ctx, "connection-0")
+	require.NoError(t, err)
+	require.Equal(t, "foo-1", chainID)
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/healthcheck/types"
+)
+
+// SetRelayLatency set a specific relayLatency in the store from its index
+func (k Keeper) SetRelayLatency(ctx sdk.Context, relayLatency types.RelayLatency) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RelayLatencyKeyPrefix))
+	b := k.cdc.MustMarshal(&relayLatency)
+	store.Set(types.RelayLatencyKey(
+		relayLatency.ChainId,
+	), b)
+}
+
+// GetRelayLatency returns a relayLatency from its index
+func (k Keeper) GetRelayLatency(
+	ctx sdk.Context,
+	chainId string,
+) (val types.RelayLatency, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RelayLatencyKeyPrefix))
+
+	b := store.Get(types.RelayLatencyKey(
+		chainId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRelayLatency returns all relayLatency
+func (k Keeper) GetAllRelayLatency(ctx sdk.Context) (list []types.RelayLatency) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RelayLatencyKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RelayLatency
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RecordRelayLatency keeps the relay latency of an accepted healthcheck update, derived from its clock skew.
+// Updates with timestamps ahead of the registry block time are recorded with zero latency.
+func (k Keeper) RecordRelayLatency(ctx sdk.Context, chainID string, clockSkew int64) types.LatencyPercentiles {
+	relayLatency, found := k.GetRelayLatency(ctx, chainID)
+	if !found {
+		relayLatency = types.RelayLatency{ChainId: chainID}
+	}
+
+	var latency uint64
+	if clockSkew < 0 {
+		latency = uint64(-clockSkew) / uint64(time.Millisecond)
+	}
+
+	relayLatency.AddSample(latency)
+	k.SetRelayLatency(ctx, relayLatency)
+
+	return relayLatency.Percentiles()
+}
+
+// GetLiveStatus returns the status of a monitored chain that delivered a healthcheck update. The chain is degraded
+// if the 95th percentile of its relay latency exceeds the DegradedLatency.
+func (k Keeper) GetLiveStatus(ctx sdk.Context, percentiles types.LatencyPercentiles) types.MonitoredChainStatus {
+	degradedLatency := k.DegradedLatency(ctx)
+	if degradedLatency == 0 || percentiles.P95 <= degradedLatency*uint64(time.Second/time.Millisecond) {
+		return types.Active
+	}
+
+	return types.Degraded
+}
+
+// EmitChainDegraded emits the degraded event once the status applied to the monitored chain changed to degraded
+func (k Keeper) EmitChainDegraded(ctx sdk.Context, monitoredChain types.Chain, previousStatus uint64, percentiles types.LatencyPercentiles) {
+	if monitoredChain.Status != uint64(types.Degraded) || previousStatus == uint64(types.Degraded) {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChainDegraded,
+			sdk.NewAttribute(types.AttributeKeyChainID, monitoredChain.ChainId),
+			sdk.NewAttribute(types.AttributeKeyLatencyP95, strconv.FormatUint(percentiles.P95, 10)),
+		),
+	)
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/x/healthcheck/types"
+)
+
+func TestRecordRelayLatency(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+
+	keeper.RecordRelayLatency(ctx, "a", -int64(2*time.Second))
+	// timestamps ahead of the registry block time don't make the latency negative
+	percentiles := keeper.RecordRelayLatency(ctx, "a", int64(time.Second))
+	require.Equal(t, types.LatencyPercentiles{Samples: 2, P50: 0, P95: 2000, Max: 2000}, percentiles)
+
+	relayLatency, found := keeper.GetRelayLatency(ctx, "a")
+	require.True(t, found)
+	require.Equal(t, []uint64{2000, 0}, relayLatency.Samples)
+}
+
+func TestGetLiveStatus(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	percentiles := types.LatencyPercentiles{Samples: 1, P50: 5000, P95: 5000, Max: 5000}
+
+	// degraded status is disabled by default
+	require.Equal(t, types.Active, keeper.GetLiveStatus(ctx, percentiles))
+
+	params := types.DefaultParams()
+	params.DegradedLatency = 5
+	keeper.SetParams(ctx, params)
+	require.Equal(t, types.Active, keeper.GetLiveStatus(ctx, percentiles))
+
+	params.DegradedLatency = 4
+	keeper.SetParams(ctx, params)
+	require.Equal(t, types.Degraded, keeper.GetLiveStatus(ctx, percentiles))
+}
+
+func TestEmitChainDegraded(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	percentiles := types.LatencyPercentiles{Samples: 1, P50: 5000, P95: 5000, Max: 5000}
+	degradedEvents := func() (count int) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeChainDegraded {
+				count++
+			}
+		}
+		return count
+	}
+
+	// an inactive chain kept inactive by the activation threshold isn't degraded
+	keeper.EmitChainDegraded(ctx, types.Chain{ChainId: "a", Status: uint64(types.Inactive)}, uint64(types.Inactive), percentiles)
+	require.Zero(t, degradedEvents())
+
+	keeper.EmitChainDegraded(ctx, types.Chain{ChainId: "a", Status: uint64(types.Degraded)}, uint64(types.Active), percentiles)
+	require.Equal(t, 1, degradedEvents())
+
+	// chains that stay degraded don't emit the event again
+	keeper.EmitChainDegraded(ctx, types.Chain{ChainId: "a", Status: uint64(types.Degraded)}, uint64(types.Degraded), percentiles)
+	require.Equal(t, 1, degradedEvents())
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/healthcheck/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The params added since version 1 are set to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	m.keeper.paramstore.GetParamSetIfExists(ctx, &params)
+	m.keeper.SetParams(ctx, params)
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/x/healthcheck/keeper"
+	"healthcheck/x/healthcheck/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := keepertest.HealthcheckKeeper(t)
+	params := types.DefaultParams()
+	params.MaxClockDrift = 5
+	k.SetParams(ctx, params)
+
+	// params already set are kept
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+	require.Equal(t, params, k.GetParams(ctx))
+}
@@ -27,6 +27,12 @@ func (k msgServer) CreateChain(goCtx context.Context, msg *types.MsgCreateChain)
 		ConnectionId: msg.ConnectionId,
 	}
 
+	if msg.Passive {
+		if err := k.setPassiveMonitoring(ctx, &chain); err != nil {
+			return nil, err
+		}
+	}
+
 	k.SetChain(
 		ctx,
 		chain,
@@ -57,6 +63,12 @@ func (k msgServer) UpdateChain(goCtx context.Context, msg *types.MsgUpdateChain)
 		ConnectionId: msg.ConnectionId,
 	}
 
+	if msg.Passive {
+		if err := k.setPassiveMonitoring(ctx, &chain); err != nil {
+			return nil, err
+		}
+	}
+
 	k.SetChain(ctx, chain)
 
 	return &types.MsgUpdateChainResponse{}, nil
@@ -86,3 +98,45 @@ func (k msgServer) DeleteChain(goCtx context.Context, msg *types.MsgDeleteChain)
 
 	return &types.MsgDeleteChainResponse{}, nil
 }
+
+func (k msgServer) ResetChain(goCtx context.Context, msg *types.MsgResetChain) (*types.MsgResetChainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	valFound, isFound := k.GetChain(
+		ctx,
+		msg.ChainId,
+	)
+	if !isFound {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+	}
+
+	// Resets can be acknowledged by the current owner or through governance
+	if msg.Creator != valFound.Creator && msg.Creator != k.GetAuthority() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	newChainID := msg.NewChainId
+	if newChainID == "" {
+		newChainID = msg.ChainId
+	}
+
+	if newChainID != msg.ChainId {
+		if _, isFound := k.GetChain(ctx, newChainID); isFound {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
+		}
+	}
+
+	k.resetChain(ctx, &valFound, newChainID)
+
+	if valFound.Passive {
+		if err := k.setPassiveMonitoring(ctx, &valFound); err != nil {
+			return nil, err
+		}
+	}
+
+	k.RemoveChain(ctx, msg.ChainId)
+	k.SetChain(ctx, valFound)
+
+	return &types.MsgResetChainResponse{}, nil
+}
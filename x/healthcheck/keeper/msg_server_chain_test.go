@@ -6,11 +6,14 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
 	keepertest "healthcheck/testutil/keeper"
 	"healthcheck/x/healthcheck/keeper"
 	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
 )
 
 // Prevent strconv unused error
@@ -140,3 +143,165 @@ func TestChainMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestChainMsgServerCreatePassive(t *testing.T) {
+	creator := "A"
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgCreateChain
+		err     error
+	}{
+		{
+			// the stubbed client of every connection tracks a chain with an empty chain ID
+			desc: "Completed",
+			request: &types.MsgCreateChain{Creator: creator,
+				ChainId: "",
+				Passive: true,
+			},
+		},
+		{
+			desc: "UnexpectedConnection",
+			request: &types.MsgCreateChain{Creator: creator,
+				ChainId: strconv.Itoa(0),
+				Passive: true,
+			},
+			err: types.ErrUnexpectedConnectionID,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.HealthcheckKeeper(t)
+			srv := keeper.NewMsgServerImpl(*k)
+			wctx := sdk.WrapSDKContext(ctx)
+
+			_, err := srv.CreateChain(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				rst, found := k.GetChain(ctx,
+					tc.request.ChainId,
+				)
+				require.True(t, found)
+				require.True(t, rst.Passive)
+				require.Equal(t, uint64(types.DefaultUpdateInterval), rst.UpdateInterval)
+				require.Equal(t, uint64(types.DefaultTimeoutInterval), rst.TimeoutInterval)
+			}
+		})
+	}
+}
+
+func TestChainMsgServerReset(t *testing.T) {
+	creator := "A"
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgResetChain
+		chainID string
+		err     error
+	}{
+		{
+			desc: "Restart",
+			request: &types.MsgResetChain{Creator: creator,
+				ChainId: "foo-1",
+			},
+			chainID: "foo-1",
+		},
+		{
+			desc: "RevisionBump",
+			request: &types.MsgResetChain{Creator: creator,
+				ChainId:    "foo-1",
+				NewChainId: "foo-2",
+			},
+			chainID: "foo-2",
+		},
+		{
+			desc: "Governance",
+			request: &types.MsgResetChain{Creator: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ChainId: "foo-1",
+			},
+			chainID: "foo-1",
+		},
+		{
+			desc: "Unauthorized",
+			request: &types.MsgResetChain{Creator: "B",
+				ChainId: "foo-1",
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.MsgResetChain{Creator: creator,
+				ChainId: "bar-1",
+			},
+			err: sdkerrors.ErrKeyNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.HealthcheckKeeper(t)
+			srv := keeper.NewMsgServerImpl(*k)
+			wctx := sdk.WrapSDKContext(ctx)
+
+			k.SetChain(ctx, types.Chain{
+				Creator:   creator,
+				ChainId:   "foo-1",
+				Block:     100,
+				Timestamp: 1000,
+			})
+			_, err := srv.ResetChain(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			chain, found := k.GetChain(ctx, tc.chainID)
+			require.True(t, found)
+			require.Zero(t, chain.Block)
+			require.Zero(t, chain.Timestamp)
+			require.Equal(t, []types.ChainReset{{
+				ChainId:   "foo-1",
+				Block:     100,
+				Timestamp: 1000,
+			}}, chain.Resets)
+
+			_, found = k.GetChainByIdentity(ctx, "foo-3")
+			require.True(t, found)
+			if tc.chainID != "foo-1" {
+				_, found = k.GetChain(ctx, "foo-1")
+				require.False(t, found)
+			}
+		})
+	}
+}
+
+func TestChainMsgServerResetRecords(t *testing.T) {
+	k, ctx := keepertest.HealthcheckKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	k.SetChain(ctx, types.Chain{Creator: "A", ChainId: "foo-1"})
+	k.SetChainRelayer(ctx, types.ChainRelayer{ChainId: "foo-1", Relayer: "relayer", PacketsDelivered: 3})
+	k.SetRelayLatency(ctx, types.RelayLatency{ChainId: "foo-1", Measured: 2})
+	k.SetChainIndicator(ctx, types.ChainIndicator{ChainId: "foo-1", Indicator: commontypes.Indicator{Reporter: "r", Key: "k"}})
+	k.SetDecentralizationSample(ctx, types.DecentralizationSample{ChainId: "foo-1", RegistryBlockHeight: 5})
+	k.SetHeartbeat(ctx, types.Heartbeat{ChainId: "foo-1", RegistryBlockHeight: 6})
+	k.SetChainConnectivity(ctx, types.ChainConnectivity{ChainId: "foo-1", Block: 7})
+	k.SetProbe(ctx, types.Probe{ChainId: "foo-1", Answered: 1})
+	// records left behind by a removed chain registered under the new chain ID
+	k.SetChainRelayer(ctx, types.ChainRelayer{ChainId: "foo-2", Relayer: "other"})
+
+	_, err := srv.ResetChain(wctx, &types.MsgResetChain{Creator: "A", ChainId: "foo-1", NewChainId: "foo-2"})
+	require.NoError(t, err)
+
+	require.Equal(t, []types.ChainRelayer{{ChainId: "foo-2", Relayer: "relayer", PacketsDelivered: 3}}, k.GetAllChainRelayer(ctx))
+	require.Equal(t, []types.RelayLatency{{ChainId: "foo-2", Measured: 2}}, k.GetAllRelayLatency(ctx))
+	indicator, found := k.GetChainIndicator(ctx, "foo-2", "r", "k")
+	require.True(t, found)
+	require.Equal(t, "foo-2", indicator.ChainId)
+	require.Len(t, k.GetAllChainIndicator(ctx), 1)
+	require.Equal(t, []types.DecentralizationSample{{ChainId: "foo-2", RegistryBlockHeight: 5}}, k.GetAllDecentralizationSample(ctx))
+	require.Equal(t, []types.Heartbeat{{ChainId: "foo-2", RegistryBlockHeight: 6}}, k.GetAllHeartbeat(ctx))
+	require.Equal(t, []types.ChainConnectivity{{ChainId: "foo-2", Block: 7}}, k.GetAllChainConnectivity(ctx))
+	require.Equal(t, []types.Probe{{ChainId: "foo-2", Answered: 1}}, k.GetAllProbe(ctx))
+}
@@ -7,10 +7,116 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.TimestampVerificationTolerance(ctx),
+		k.MaxClockDrift(ctx),
+		k.MaxUpdateInterval(ctx),
+		k.MaxTimeoutInterval(ctx),
+		k.AtRiskParticipation(ctx),
+		k.ProbeInterval(ctx),
+		k.DegradedLatency(ctx),
+		k.ActivationThreshold(ctx),
+		k.InactivationThreshold(ctx),
+		k.FlapWindow(ctx),
+		k.FlapThreshold(ctx),
+		k.MaxChainIndicators(ctx),
+		k.DecentralizationRetention(ctx),
+		k.DecentralizationSampleInterval(ctx),
+		k.HeartbeatRetention(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// TimestampVerificationTolerance returns the TimestampVerificationTolerance param
+func (k Keeper) TimestampVerificationTolerance(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyTimestampVerificationTolerance, &res)
+	return
+}
+
+// MaxClockDrift returns the MaxClockDrift param
+func (k Keeper) MaxClockDrift(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxClockDrift, &res)
+	return
+}
+
+// MaxUpdateInterval returns the MaxUpdateInterval param
+func (k Keeper) MaxUpdateInterval(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxUpdateInterval, &res)
+	return
+}
+
+// MaxTimeoutInterval returns the MaxTimeoutInterval param
+func (k Keeper) MaxTimeoutInterval(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxTimeoutInterval, &res)
+	return
+}
+
+// AtRiskParticipation returns the AtRiskParticipation param
+func (k Keeper) AtRiskParticipation(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyAtRiskParticipation, &res)
+	return
+}
+
+// ProbeInterval returns the ProbeInterval param
+func (k Keeper) ProbeInterval(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyProbeInterval, &res)
+	return
+}
+
+// DegradedLatency returns the DegradedLatency param
+func (k Keeper) DegradedLatency(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyDegradedLatency, &res)
+	return
+}
+
+// ActivationThreshold returns the ActivationThreshold param
+func (k Keeper) ActivationThreshold(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyActivationThreshold, &res)
+	return
+}
+
+// InactivationThreshold returns the InactivationThreshold param
+func (k Keeper) InactivationThreshold(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyInactivationThreshold, &res)
+	return
+}
+
+// FlapWindow returns the FlapWindow param
+func (k Keeper) FlapWindow(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyFlapWindow, &res)
+	return
+}
+
+// FlapThreshold returns the FlapThreshold param
+func (k Keeper) FlapThreshold(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyFlapThreshold, &res)
+	return
+}
+
+// MaxChainIndicators returns the MaxChainIndicators param
+func (k Keeper) MaxChainIndicators(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxChainIndicators, &res)
+	return
+}
+
+// DecentralizationRetention returns the DecentralizationRetention param
+func (k Keeper) DecentralizationRetention(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyDecentralizationRetention, &res)
+	return
+}
+
+// DecentralizationSampleInterval returns the DecentralizationSampleInterval param
+func (k Keeper) DecentralizationSampleInterval(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyDecentralizationSampleInterval, &res)
+	return
+}
+
+// HeartbeatRetention returns the HeartbeatRetention param
+func (k Keeper) HeartbeatRetention(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyHeartbeatRetention, &res)
+	return
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+// RecordSigningParticipation stores the signing participation reported in the healthcheck update and flags
+// the monitored chain as at risk when the participation gets close to the 2/3 threshold
+func (k Keeper) RecordSigningParticipation(ctx sdk.Context, monitoredChain *types.Chain, update commontypes.HealthcheckUpdateData) {
+	wasAtRisk := monitoredChain.AtRisk
+
+	monitoredChain.SignedVotingPower = update.SignedVotingPower
+	monitoredChain.TotalVotingPower = update.TotalVotingPower
+	monitoredChain.ValidatorCount = update.ValidatorCount
+	monitoredChain.AtRisk = types.IsParticipationAtRisk(update.SignedVotingPower, update.TotalVotingPower, k.AtRiskParticipation(ctx))
+
+	if monitoredChain.AtRisk && !wasAtRisk {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeChainAtRisk,
+				sdk.NewAttribute(types.AttributeKeyChainID, monitoredChain.ChainId),
+				sdk.NewAttribute(types.AttributeKeySignedVotingPower, strconv.FormatUint(update.SignedVotingPower, 10)),
+				sdk.NewAttribute(types.AttributeKeyTotalVotingPower, strconv.FormatUint(update.TotalVotingPower, 10)),
+			),
+		)
+	}
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"healthcheck/x/healthcheck/types"
+)
+
+// setPassiveMonitoring marks the chain as passively monitored. Since there is no channel handshake
+// for such chains, the connection is validated here and the default intervals are used.
+func (k Keeper) setPassiveMonitoring(ctx sdk.Context, monitoredChain *types.Chain) error {
+	counterpartyChainID, err := k.GetCounterpartyChainIDFromConnection(ctx, monitoredChain.ConnectionId)
+	if err != nil {
+		return err
+	}
+
+	if counterpartyChainID != monitoredChain.ChainId {
+		return sdkerrors.Wrapf(types.ErrUnexpectedConnectionID, "connection %s belongs to chain with chain ID %s, expected: %s", monitoredChain.ConnectionId, counterpartyChainID, monitoredChain.ChainId)
+	}
+
+	monitoredChain.Passive = true
+	monitoredChain.UpdateInterval = types.DefaultUpdateInterval
+	monitoredChain.TimeoutInterval = types.DefaultTimeoutInterval
+
+	return nil
+}
+
+// ApplyLightClientEvidence updates a passively monitored chain from its light client. If the client
+// height advanced past the last recorded block, the chain is considered active at the client's latest
+// height and the timestamp of the matching consensus state. It returns true if the chain was modified.
+func (k Keeper) ApplyLightClientEvidence(ctx sdk.Context, monitoredChain *types.Chain) bool {
+	if !monitoredChain.Passive || monitoredChain.ClientLatestHeight <= monitoredChain.Block {
+		return false
+	}
+
+	timestamp, err := k.GetCounterpartyConsensusTimestamp(ctx, monitoredChain.ConnectionId, monitoredChain.ClientLatestHeight)
+	if err != nil {
+		k.Logger(ctx).Debug("failed to get client consensus state", "chain-id", monitoredChain.ChainId, "error", err.Error())
+		return false
+	}
+
+	k.ApplyDeliveredUpdate(ctx, monitoredChain, types.Active)
+	monitoredChain.Block = monitoredChain.ClientLatestHeight
+	monitoredChain.Timestamp = timestamp
+	monitoredChain.RegistryBlockHeight = uint64(ctx.BlockHeight())
+	monitoredChain.EvidenceSource = uint64(types.LightClient)
+
+	return true
+}
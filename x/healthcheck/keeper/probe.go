@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+// SetProbe set a specific probe in the store from its index
+func (k Keeper) SetProbe(ctx sdk.Context, probe types.Probe) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProbeKeyPrefix))
+	b := k.cdc.MustMarshal(&probe)
+	store.Set(types.ProbeKey(
+		probe.ChainId,
+	), b)
+}
+
+// GetProbe returns a probe from its index
+func (k Keeper) GetProbe(
+	ctx sdk.Context,
+	chainId string,
+) (val types.Probe, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProbeKeyPrefix))
+
+	b := store.Get(types.ProbeKey(
+		chainId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllProbe returns all probe
+func (k Keeper) GetAllProbe(ctx sdk.Context) (list []types.Probe) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProbeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Probe
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ShouldSendProbe returns true if a new probe challenge is due for the monitored chain. Only the chains
+// tracked through a healthcheck channel that negotiated the probe feature are probed. An outstanding challenge
+// is replaced only once the monitored chain had two update intervals to echo it, so that probe intervals shorter
+// than the round trip don't fail every challenge.
+func (k Keeper) ShouldSendProbe(ctx sdk.Context, monitoredChain types.Chain) bool {
+	probeInterval := k.ProbeInterval(ctx)
+	if probeInterval == 0 ||
+		monitoredChain.ChannelId == "" ||
+		!commontypes.HasFeature(monitoredChain.Features, commontypes.FeatureProbe) {
+		return false
+	}
+
+	probe, found := k.GetProbe(ctx, monitoredChain.ChainId)
+	if !found {
+		return true
+	}
+
+	if roundTrip := 2 * monitoredChain.UpdateInterval; probe.Nonce != 0 && probeInterval < roundTrip {
+		probeInterval = roundTrip
+	}
+
+	return uint64(ctx.BlockHeight()) >= probe.SentRegistryBlockHeight+probeInterval
+}
+
+// SendProbe sends a new probe challenge to the monitored chain. A challenge that is still outstanding
+// is replaced and counted as failed.
+func (k Keeper) SendProbe(ctx sdk.Context, monitoredChain types.Chain) error {
+	nonce := newProbeNonce(ctx, monitoredChain.ChainId)
+	packet := commontypes.HealthcheckPacketData{
+		Packet: &commontypes.HealthcheckPacketData_ProbeChallenge{
+			ProbeChallenge: &commontypes.ProbeChallenge{Nonce: nonce},
+		},
+	}
+
+	packetData, err := types.ModuleCdc.MarshalJSON(&packet)
+	if err != nil {
+		return err
+	}
+
+	portID := k.GetPort(ctx)
+	capName := host.ChannelCapabilityPath(portID, monitoredChain.ChannelId)
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, capName)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "could not retrieve channel capability at: %s", capName)
+	}
+
+	if _, err := k.channelKeeper.SendPacket(
+		ctx,
+		chanCap,
+		portID,
+		monitoredChain.ChannelId,
+		clienttypes.Height{},
+		uint64(ctx.BlockTime().Add(types.ProbeTimeoutPeriod).UnixNano()),
+		packetData,
+	); err != nil {
+		return err
+	}
+
+	probe, found := k.GetProbe(ctx, monitoredChain.ChainId)
+	if !found {
+		probe = types.Probe{ChainId: monitoredChain.ChainId}
+	}
+
+	if probe.Nonce != 0 {
+		probe.Failed++
+	}
+
+	probe.Nonce = nonce
+	probe.SentRegistryBlockHeight = uint64(ctx.BlockHeight())
+	probe.SentTimestamp = uint64(ctx.BlockTime().UnixNano())
+	k.SetProbe(ctx, probe)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProbeSent,
+			sdk.NewAttribute(types.AttributeKeyChainID, monitoredChain.ChainId),
+			sdk.NewAttribute(types.AttributeKeyNonce, strconv.FormatUint(nonce, 10)),
+		),
+	)
+
+	return nil
+}
+
+// RecordProbeResponse records the round-trip time of the outstanding probe challenge once its nonce is echoed
+// in a healthcheck update. Nonces of the replaced challenges are ignored.
+func (k Keeper) RecordProbeResponse(ctx sdk.Context, chainID string, update commontypes.HealthcheckUpdateData) {
+	probe, found := k.GetProbe(ctx, chainID)
+	if !found || update.ProbeNonce == 0 || update.ProbeNonce != probe.Nonce {
+		return
+	}
+
+	probe.Nonce = 0
+	probe.Answered++
+	probe.AnsweredRegistryBlockHeight = uint64(ctx.BlockHeight())
+	probe.RttBlocks = uint64(ctx.BlockHeight()) - probe.SentRegistryBlockHeight
+	probe.RttSeconds = 0
+	if timestamp := uint64(ctx.BlockTime().UnixNano()); timestamp > probe.SentTimestamp {
+		probe.RttSeconds = (timestamp - probe.SentTimestamp) / uint64(time.Second)
+	}
+	k.SetProbe(ctx, probe)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProbeAnswered,
+			sdk.NewAttribute(types.AttributeKeyChainID, chainID),
+			sdk.NewAttribute(types.AttributeKeyNonce, strconv.FormatUint(update.ProbeNonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyRttBlocks, strconv.FormatUint(probe.RttBlocks, 10)),
+			sdk.NewAttribute(types.AttributeKeyRttSeconds, strconv.FormatUint(probe.RttSeconds, 10)),
+		),
+	)
+}
+
+// RecordProbeFailure counts the outstanding probe challenge as failed, after it was rejected by the monitored chain
+// or timed out
+func (k Keeper) RecordProbeFailure(ctx sdk.Context, chainID string, nonce uint64) {
+	probe, found := k.GetProbe(ctx, chainID)
+	if !found || nonce != probe.Nonce {
+		return
+	}
+
+	probe.Nonce = 0
+	probe.Failed++
+	k.SetProbe(ctx, probe)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProbeFailed,
+			sdk.NewAttribute(types.AttributeKeyChainID, chainID),
+			sdk.NewAttribute(types.AttributeKeyNonce, strconv.FormatUint(nonce, 10)),
+		),
+	)
+}
+
+// newProbeNonce derives an unpredictable non-zero nonce from the registry chain block the challenge is sent in
+func newProbeNonce(ctx sdk.Context, chainID string) uint64 {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(ctx.BlockHeight()))
+
+	hash := sha256.New()
+	hash.Write(ctx.HeaderHash())
+	hash.Write(heightBytes)
+	hash.Write([]byte(chainID))
+
+	nonce := binary.BigEndian.Uint64(hash.Sum(nil))
+	if nonce == 0 {
+		return 1
+	}
+
+	return nonce
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestRecordProbeResponse(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	sentTime := time.Unix(1000, 0)
+	keeper.SetProbe(ctx, types.Probe{
+		ChainId:                 "a",
+		Nonce:                   7,
+		SentRegistryBlockHeight: 10,
+		SentTimestamp:           uint64(sentTime.UnixNano()),
+	})
+
+	ctx = ctx.WithBlockHeight(14).WithBlockTime(sentTime.Add(20 * time.Second))
+
+	// nonces of the replaced challenges are ignored
+	keeper.RecordProbeResponse(ctx, "a", commontypes.HealthcheckUpdateData{ProbeNonce: 6})
+	probe, found := keeper.GetProbe(ctx, "a")
+	require.True(t, found)
+	require.Equal(t, uint64(7), probe.Nonce)
+	require.Zero(t, probe.Answered)
+
+	keeper.RecordProbeResponse(ctx, "a", commontypes.HealthcheckUpdateData{ProbeNonce: 7})
+	probe, _ = keeper.GetProbe(ctx, "a")
+	require.Zero(t, probe.Nonce)
+	require.Equal(t, uint64(1), probe.Answered)
+	require.Equal(t, uint64(4), probe.RttBlocks)
+	require.Equal(t, uint64(20), probe.RttSeconds)
+	require.Equal(t, uint64(14), probe.AnsweredRegistryBlockHeight)
+
+	// the answered challenge can't fail anymore
+	keeper.RecordProbeFailure(ctx, "a", 7)
+	probe, _ = keeper.GetProbe(ctx, "a")
+	require.Zero(t, probe.Failed)
+}
+
+func TestRecordProbeFailure(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	keeper.SetProbe(ctx, types.Probe{ChainId: "a", Nonce: 7})
+
+	keeper.RecordProbeFailure(ctx, "a", 7)
+	probe, _ := keeper.GetProbe(ctx, "a")
+	require.Zero(t, probe.Nonce)
+	require.Equal(t, uint64(1), probe.Failed)
+
+	// late echo of the failed challenge isn't counted
+	keeper.RecordProbeResponse(ctx, "a", commontypes.HealthcheckUpdateData{ProbeNonce: 7})
+	probe, _ = keeper.GetProbe(ctx, "a")
+	require.Zero(t, probe.Answered)
+}
+
+func TestShouldSendProbe(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	params := types.DefaultParams()
+	params.ProbeInterval = 5
+	keeper.SetParams(ctx, params)
+	monitoredChain := types.Chain{
+		ChainId:        "a",
+		ChannelId:      "channel-0",
+		UpdateInterval: 10,
+		Features:       []string{commontypes.FeatureProbe},
+	}
+
+	ctx = ctx.WithBlockHeight(10)
+	require.True(t, keeper.ShouldSendProbe(ctx, monitoredChain))
+
+	// the outstanding challenge isn't replaced before the monitored chain could echo it
+	keeper.SetProbe(ctx, types.Probe{ChainId: "a", Nonce: 7, SentRegistryBlockHeight: 10})
+	require.False(t, keeper.ShouldSendProbe(ctx.WithBlockHeight(15), monitoredChain))
+	require.True(t, keeper.ShouldSendProbe(ctx.WithBlockHeight(30), monitoredChain))
+
+	// answered challenges are followed by the next one after the probe interval
+	keeper.SetProbe(ctx, types.Probe{ChainId: "a", SentRegistryBlockHeight: 10})
+	require.True(t, keeper.ShouldSendProbe(ctx.WithBlockHeight(15), monitoredChain))
+
+	// chains that didn't negotiate the probe feature aren't probed
+	monitoredChain.Features = nil
+	require.False(t, keeper.ShouldSendProbe(ctx.WithBlockHeight(15), monitoredChain))
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"healthcheck/x/healthcheck/types"
+)
+
+func (k Keeper) DecentralizationMetrics(goCtx context.Context, req *types.QueryDecentralizationMetricsRequest) (*types.QueryDecentralizationMetricsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var samples []types.DecentralizationSample
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetChain(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	sampleStore := prefix.NewStore(
+		prefix.NewStore(store, types.KeyPrefix(types.DecentralizationSampleKeyPrefix)),
+		types.DecentralizationSamplePrefix(req.ChainId),
+	)
+
+	pageRes, err := query.Paginate(sampleStore, req.Pagination, func(key []byte, value []byte) error {
+		var sample types.DecentralizationSample
+		if err := k.cdc.Unmarshal(value, &sample); err != nil {
+			return err
+		}
+
+		samples = append(samples, sample)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDecentralizationMetricsResponse{Samples: samples, Pagination: pageRes}, nil
+}
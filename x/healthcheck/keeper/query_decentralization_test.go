@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestDecentralizationMetricsQuery(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	chains := createNChain(keeper, ctx, 2)
+
+	// samples are ordered by the registry block height
+	for _, height := range []uint64{300, 2, 10} {
+		keeper.SetDecentralizationSample(ctx, types.DecentralizationSample{
+			ChainId:             chains[0].ChainId,
+			RegistryBlockHeight: height,
+			Block:               height,
+			ValidatorCount:      height,
+		})
+	}
+	// updates without metrics are not sampled
+	keeper.RecordDecentralizationMetrics(ctx.WithBlockHeight(20), chains[1].ChainId, commontypes.HealthcheckUpdateData{Block: 20})
+
+	response, err := keeper.DecentralizationMetrics(wctx, &types.QueryDecentralizationMetricsRequest{
+		ChainId:    chains[0].ChainId,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), response.Pagination.Total)
+	for i, height := range []uint64{2, 10, 300} {
+		require.Equal(t, types.DecentralizationSample{
+			ChainId:             chains[0].ChainId,
+			RegistryBlockHeight: height,
+			Block:               height,
+			ValidatorCount:      height,
+		}, response.Samples[i])
+	}
+
+	response, err = keeper.DecentralizationMetrics(wctx, &types.QueryDecentralizationMetricsRequest{ChainId: chains[1].ChainId})
+	require.NoError(t, err)
+	require.Empty(t, response.Samples)
+
+	_, err = keeper.DecentralizationMetrics(wctx, &types.QueryDecentralizationMetricsRequest{ChainId: "unknown"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = keeper.DecentralizationMetrics(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
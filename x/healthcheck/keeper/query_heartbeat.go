@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"healthcheck/x/healthcheck/types"
+)
+
+func (k Keeper) Heartbeats(goCtx context.Context, req *types.QueryHeartbeatsRequest) (*types.QueryHeartbeatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var heartbeats []types.Heartbeat
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetChain(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	heartbeatStore := prefix.NewStore(
+		prefix.NewStore(store, types.KeyPrefix(types.HeartbeatKeyPrefix)),
+		types.HeartbeatPrefix(req.ChainId),
+	)
+
+	pageRes, err := query.Paginate(heartbeatStore, req.Pagination, func(key []byte, value []byte) error {
+		var heartbeat types.Heartbeat
+		if err := k.cdc.Unmarshal(value, &heartbeat); err != nil {
+			return err
+		}
+
+		heartbeats = append(heartbeats, heartbeat)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHeartbeatsResponse{Heartbeats: heartbeats, Pagination: pageRes}, nil
+}
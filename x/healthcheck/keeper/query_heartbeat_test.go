@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestHeartbeatsQuery(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	chains := createNChain(keeper, ctx, 2)
+
+	// heartbeats are ordered by the registry block height
+	for _, height := range []int64{300, 2, 10} {
+		keeper.RecordHeartbeat(ctx.WithBlockHeight(height), chains[0].ChainId, commontypes.HealthcheckUpdateData{
+			Block:  uint64(height),
+			Reason: uint64(commontypes.ValidatorSetChange),
+		})
+	}
+	// scheduled updates are not recorded
+	keeper.RecordHeartbeat(ctx.WithBlockHeight(20), chains[1].ChainId, commontypes.HealthcheckUpdateData{Block: 20})
+
+	response, err := keeper.Heartbeats(wctx, &types.QueryHeartbeatsRequest{
+		ChainId:    chains[0].ChainId,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), response.Pagination.Total)
+	for i, height := range []uint64{2, 10, 300} {
+		require.Equal(t, types.Heartbeat{
+			ChainId:             chains[0].ChainId,
+			RegistryBlockHeight: height,
+			Block:               height,
+			Reason:              uint64(commontypes.ValidatorSetChange),
+		}, response.Heartbeats[i])
+	}
+
+	response, err = keeper.Heartbeats(wctx, &types.QueryHeartbeatsRequest{ChainId: chains[1].ChainId})
+	require.NoError(t, err)
+	require.Empty(t, response.Heartbeats)
+
+	_, err = keeper.Heartbeats(wctx, &types.QueryHeartbeatsRequest{ChainId: "unknown"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = keeper.Heartbeats(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"healthcheck/x/healthcheck/types"
+)
+
+func (k Keeper) ChainIndicators(goCtx context.Context, req *types.QueryChainIndicatorsRequest) (*types.QueryChainIndicatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var indicators []types.ChainIndicator
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetChain(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	indicatorStore := prefix.NewStore(
+		prefix.NewStore(store, types.KeyPrefix(types.ChainIndicatorKeyPrefix)),
+		types.ChainIndicatorPrefix(req.ChainId),
+	)
+
+	pageRes, err := query.Paginate(indicatorStore, req.Pagination, func(key []byte, value []byte) error {
+		var indicator types.ChainIndicator
+		if err := k.cdc.Unmarshal(value, &indicator); err != nil {
+			return err
+		}
+
+		indicators = append(indicators, indicator)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryChainIndicatorsResponse{Indicators: indicators, Pagination: pageRes}, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"healthcheck/x/healthcheck/types"
+)
+
+func (k Keeper) RelayLatency(goCtx context.Context, req *types.QueryRelayLatencyRequest) (*types.QueryRelayLatencyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayLatency, found := k.GetRelayLatency(ctx, req.ChainId)
+	if !found || len(relayLatency.Samples) == 0 {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryRelayLatencyResponse{
+		Percentiles: relayLatency.Percentiles(),
+		Last:        relayLatency.Samples[len(relayLatency.Samples)-1],
+		Measured:    relayLatency.Measured,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"healthcheck/x/healthcheck/types"
+)
+
+func (k Keeper) Probe(goCtx context.Context, req *types.QueryProbeRequest) (*types.QueryProbeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	probe, found := k.GetProbe(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryProbeResponse{Probe: probe}, nil
+}
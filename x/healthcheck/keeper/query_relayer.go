@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"healthcheck/x/healthcheck/types"
+)
+
+func (k Keeper) RelayerStats(goCtx context.Context, req *types.QueryRelayerStatsRequest) (*types.QueryRelayerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetRelayerStats(
+		ctx,
+		req.Relayer,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryRelayerStatsResponse{RelayerStats: val}, nil
+}
+
+func (k Keeper) RelayersForChain(goCtx context.Context, req *types.QueryRelayersForChainRequest) (*types.QueryRelayersForChainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetChain(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryRelayersForChainResponse{Relayers: k.GetRelayersForChain(ctx, req.ChainId)}, nil
+}
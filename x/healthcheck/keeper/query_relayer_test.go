@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/testutil/nullify"
+	"healthcheck/x/healthcheck/types"
+)
+
+func TestRelayerStatsQuery(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNRelayerStats(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryRelayerStatsRequest
+		response *types.QueryRelayerStatsResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryRelayerStatsRequest{Relayer: msgs[0].Relayer},
+			response: &types.QueryRelayerStatsResponse{RelayerStats: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryRelayerStatsRequest{Relayer: msgs[1].Relayer},
+			response: &types.QueryRelayerStatsResponse{RelayerStats: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryRelayerStatsRequest{Relayer: "unknown"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.RelayerStats(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestRelayersForChainQuery(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	chains := createNChain(keeper, ctx, 1)
+	keeper.RecordRelayerDelivery(ctx, chains[0].ChainId, "relayer-0")
+
+	response, err := keeper.RelayersForChain(wctx, &types.QueryRelayersForChainRequest{ChainId: chains[0].ChainId})
+	require.NoError(t, err)
+	require.Len(t, response.Relayers, 1)
+	require.Equal(t, "relayer-0", response.Relayers[0].Relayer)
+
+	_, err = keeper.RelayersForChain(wctx, &types.QueryRelayersForChainRequest{ChainId: "unknown"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = keeper.RelayersForChain(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
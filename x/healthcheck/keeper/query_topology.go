@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"healthcheck/x/healthcheck/types"
+)
+
+func (k Keeper) Topology(goCtx context.Context, req *types.QueryTopologyRequest) (*types.QueryTopologyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var links []types.ChainLink
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	connectivityStore := prefix.NewStore(store, types.KeyPrefix(types.ChainConnectivityKeyPrefix))
+
+	// pagination is applied to the chains that reported their connectivity
+	pageRes, err := query.Paginate(connectivityStore, req.Pagination, func(key []byte, value []byte) error {
+		var connectivity types.ChainConnectivity
+		if err := k.cdc.Unmarshal(value, &connectivity); err != nil {
+			return err
+		}
+
+		links = append(links, types.NewChainLinks(connectivity)...)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopologyResponse{Links: links, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ChainNeighbors(goCtx context.Context, req *types.QueryChainNeighborsRequest) (*types.QueryChainNeighborsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryChainNeighborsResponse{Neighbors: k.GetChainNeighbors(ctx, req.ChainId)}, nil
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"healthcheck/x/healthcheck/types"
+)
+
+// SetRelayerStats set a specific relayerStats in the store from its index
+func (k Keeper) SetRelayerStats(ctx sdk.Context, relayerStats types.RelayerStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RelayerStatsKeyPrefix))
+	b := k.cdc.MustMarshal(&relayerStats)
+	store.Set(types.RelayerStatsKey(
+		relayerStats.Relayer,
+	), b)
+}
+
+// GetRelayerStats returns a relayerStats from its index
+func (k Keeper) GetRelayerStats(
+	ctx sdk.Context,
+	relayer string,
+) (val types.RelayerStats, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RelayerStatsKeyPrefix))
+
+	b := store.Get(types.RelayerStatsKey(
+		relayer,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRelayerStats returns all relayerStats
+func (k Keeper) GetAllRelayerStats(ctx sdk.Context) (list []types.RelayerStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RelayerStatsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RelayerStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetChainRelayer set a specific chainRelayer in the store from its index
+func (k Keeper) SetChainRelayer(ctx sdk.Context, chainRelayer types.ChainRelayer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainRelayerKeyPrefix))
+	b := k.cdc.MustMarshal(&chainRelayer)
+	store.Set(types.ChainRelayerKey(
+		chainRelayer.ChainId,
+		chainRelayer.Relayer,
+	), b)
+}
+
+// GetChainRelayer returns a chainRelayer from its index
+func (k Keeper) GetChainRelayer(
+	ctx sdk.Context,
+	chainId string,
+	relayer string,
+) (val types.ChainRelayer, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainRelayerKeyPrefix))
+
+	b := store.Get(types.ChainRelayerKey(
+		chainId,
+		relayer,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllChainRelayer returns all chainRelayer
+func (k Keeper) GetAllChainRelayer(ctx sdk.Context) (list []types.ChainRelayer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainRelayerKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ChainRelayer
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRelayersForChain returns all relayers that relayed healthcheck packets of the given chain,
+// ordered by the number of delivered packets
+func (k Keeper) GetRelayersForChain(ctx sdk.Context, chainId string) (list []types.ChainRelayer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainRelayerKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainRelayerPrefix(chainId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ChainRelayer
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].PacketsDelivered > list[j].PacketsDelivered
+	})
+
+	return
+}
+
+// RecordRelayerDelivery accounts a healthcheck packet of the given chain that was delivered by the relayer
+func (k Keeper) RecordRelayerDelivery(ctx sdk.Context, chainId string, relayer string) {
+	chainRelayer, relayerStats := k.getRelayerRecords(ctx, chainId, relayer)
+
+	chainRelayer.PacketsDelivered++
+	chainRelayer.LastDeliveryHeight = uint64(ctx.BlockHeight())
+	relayerStats.PacketsDelivered++
+
+	k.SetChainRelayer(ctx, chainRelayer)
+	k.SetRelayerStats(ctx, relayerStats)
+}
+
+// RecordRelayerStaleRejection accounts a stale healthcheck packet of the given chain that was relayed by the relayer.
+// Only stale packets acknowledged successfully (i.e. on unordered channels) are accounted, because the state changes
+// of error acknowledgements are discarded. Rejections with an error acknowledgement are reported by EmitUpdateRejected.
+func (k Keeper) RecordRelayerStaleRejection(ctx sdk.Context, chainId string, relayer string) {
+	chainRelayer, relayerStats := k.getRelayerRecords(ctx, chainId, relayer)
+
+	chainRelayer.StalePacketsRejected++
+	relayerStats.StalePacketsRejected++
+
+	k.SetChainRelayer(ctx, chainRelayer)
+	k.SetRelayerStats(ctx, relayerStats)
+}
+
+func (k Keeper) getRelayerRecords(ctx sdk.Context, chainId string, relayer string) (types.ChainRelayer, types.RelayerStats) {
+	relayerStats, found := k.GetRelayerStats(ctx, relayer)
+	if !found {
+		relayerStats = types.RelayerStats{Relayer: relayer}
+	}
+
+	chainRelayer, found := k.GetChainRelayer(ctx, chainId, relayer)
+	if !found {
+		chainRelayer = types.ChainRelayer{ChainId: chainId, Relayer: relayer}
+		relayerStats.ChainsServed++
+	}
+
+	return chainRelayer, relayerStats
+}
+
+// EmitUpdateRejected emits an event for a healthcheck update of the given chain that was rejected with an error
+// acknowledgement. Core IBC discards the state changes of error acknowledgements, but keeps their events, so the
+// rejection can still be accounted to the relayer off-chain.
+func (k Keeper) EmitUpdateRejected(ctx sdk.Context, chainId string, relayer string, clockSkew int64, reason error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRejected,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChainID, chainId),
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer),
+			sdk.NewAttribute(types.AttributeKeyClockSkew, strconv.FormatInt(clockSkew, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason.Error()),
+		),
+	)
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/testutil/nullify"
+	"healthcheck/x/healthcheck/keeper"
+	"healthcheck/x/healthcheck/types"
+)
+
+func createNRelayerStats(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.RelayerStats {
+	items := make([]types.RelayerStats, n)
+	for i := range items {
+		items[i].Relayer = strconv.Itoa(i)
+
+		keeper.SetRelayerStats(ctx, items[i])
+	}
+	return items
+}
+
+func TestRelayerStatsGet(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	items := createNRelayerStats(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetRelayerStats(ctx,
+			item.Relayer,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestRelayerStatsGetAll(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	items := createNRelayerStats(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllRelayerStats(ctx)),
+	)
+}
+
+func TestRecordRelayerDelivery(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	ctx = ctx.WithBlockHeight(7)
+
+	keeper.RecordRelayerDelivery(ctx, "chain-0", "relayer-0")
+	keeper.RecordRelayerDelivery(ctx, "chain-0", "relayer-0")
+	keeper.RecordRelayerDelivery(ctx, "chain-1", "relayer-0")
+	keeper.RecordRelayerStaleRejection(ctx, "chain-0", "relayer-0")
+
+	stats, found := keeper.GetRelayerStats(ctx, "relayer-0")
+	require.True(t, found)
+	require.Equal(t, types.RelayerStats{
+		Relayer:              "relayer-0",
+		PacketsDelivered:     3,
+		StalePacketsRejected: 1,
+		ChainsServed:         2,
+	}, stats)
+
+	chainRelayer, found := keeper.GetChainRelayer(ctx, "chain-0", "relayer-0")
+	require.True(t, found)
+	require.Equal(t, types.ChainRelayer{
+		ChainId:              "chain-0",
+		Relayer:              "relayer-0",
+		PacketsDelivered:     2,
+		StalePacketsRejected: 1,
+		LastDeliveryHeight:   7,
+	}, chainRelayer)
+}
+
+func TestGetRelayersForChain(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+
+	keeper.RecordRelayerDelivery(ctx, "chain-0", "relayer-0")
+	keeper.RecordRelayerDelivery(ctx, "chain-0", "relayer-1")
+	keeper.RecordRelayerDelivery(ctx, "chain-0", "relayer-1")
+	keeper.RecordRelayerDelivery(ctx, "chain-1", "relayer-2")
+
+	relayers := keeper.GetRelayersForChain(ctx, "chain-0")
+	require.Len(t, relayers, 2)
+	require.Equal(t, "relayer-1", relayers[0].Relayer)
+	require.Equal(t, "relayer-0", relayers[1].Relayer)
+}
+
+func TestEmitUpdateRejected(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+
+	keeper.EmitUpdateRejected(ctx, "chain-0", "relayer-0", 42, types.ErrClockDrift)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeUpdateRejected, events[0].Type)
+
+	attributes := make(map[string]string)
+	for _, attribute := range events[0].Attributes {
+		attributes[string(attribute.Key)] = string(attribute.Value)
+	}
+	require.Equal(t, "chain-0", attributes[types.AttributeKeyChainID])
+	require.Equal(t, "relayer-0", attributes[types.AttributeKeyRelayer])
+	require.Equal(t, "42", attributes[types.AttributeKeyClockSkew])
+	require.Equal(t, types.ErrClockDrift.Error(), attributes[types.AttributeKeyReason])
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/healthcheck/types"
+)
+
+// GetChainByIdentity returns the registered chain that is the same chain as the one with the given chain ID,
+// regardless of the revision it's registered under
+func (k Keeper) GetChainByIdentity(ctx sdk.Context, chainID string) (chain types.Chain, found bool) {
+	k.IterateMonitoredChains(ctx, func(monitoredChain types.Chain) bool {
+		if types.IsSameChain(monitoredChain.ChainId, chainID) {
+			chain = monitoredChain
+			found = true
+		}
+
+		return found
+	})
+
+	return chain, found
+}
+
+// resetChain links the latest update of the chain to its history and clears it, so that the block heights
+// of the restarted chain are accepted again. The chain is renamed if the reset bumped its revision, together with
+// the records kept for it.
+func (k Keeper) resetChain(ctx sdk.Context, monitoredChain *types.Chain, newChainID string) {
+	monitoredChain.Resets = append(monitoredChain.Resets, types.ChainReset{
+		ChainId:             monitoredChain.ChainId,
+		Block:               monitoredChain.Block,
+		Timestamp:           monitoredChain.Timestamp,
+		RegistryBlockHeight: uint64(ctx.BlockHeight()),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChainReset,
+			sdk.NewAttribute(types.AttributeKeyChainID, newChainID),
+			sdk.NewAttribute(types.AttributeKeyPreviousChainID, monitoredChain.ChainId),
+		),
+	)
+
+	if newChainID != monitoredChain.ChainId {
+		k.migrateChainRecords(ctx, monitoredChain.ChainId, newChainID)
+	}
+
+	monitoredChain.ChainId = newChainID
+	monitoredChain.Block = 0
+	monitoredChain.Timestamp = 0
+	monitoredChain.ClientLatestHeight = 0
+	monitoredChain.Verified = false
+	monitoredChain.ClockSkew = 0
+}
+
+// migrateChainRecords moves the records kept for the chain from its previous chain ID to the new one
+func (k Keeper) migrateChainRecords(ctx sdk.Context, chainID string, newChainID string) {
+	k.moveChainRecords(ctx, types.ChainRelayerKeyPrefix, chainID, newChainID, func(b []byte) []byte {
+		var val types.ChainRelayer
+		k.cdc.MustUnmarshal(b, &val)
+		val.ChainId = newChainID
+		return k.cdc.MustMarshal(&val)
+	})
+	k.moveChainRecords(ctx, types.RelayLatencyKeyPrefix, chainID, newChainID, func(b []byte) []byte {
+		var val types.RelayLatency
+		k.cdc.MustUnmarshal(b, &val)
+		val.ChainId = newChainID
+		return k.cdc.MustMarshal(&val)
+	})
+	k.moveChainRecords(ctx, types.ChainIndicatorKeyPrefix, chainID, newChainID, func(b []byte) []byte {
+		var val types.ChainIndicator
+		k.cdc.MustUnmarshal(b, &val)
+		val.ChainId = newChainID
+		return k.cdc.MustMarshal(&val)
+	})
+	k.moveChainRecords(ctx, types.DecentralizationSampleKeyPrefix, chainID, newChainID, func(b []byte) []byte {
+		var val types.DecentralizationSample
+		k.cdc.MustUnmarshal(b, &val)
+		val.ChainId = newChainID
+		return k.cdc.MustMarshal(&val)
+	})
+	k.moveChainRecords(ctx, types.HeartbeatKeyPrefix, chainID, newChainID, func(b []byte) []byte {
+		var val types.Heartbeat
+		k.cdc.MustUnmarshal(b, &val)
+		val.ChainId = newChainID
+		return k.cdc.MustMarshal(&val)
+	})
+	k.moveChainRecords(ctx, types.ChainConnectivityKeyPrefix, chainID, newChainID, func(b []byte) []byte {
+		var val types.ChainConnectivity
+		k.cdc.MustUnmarshal(b, &val)
+		val.ChainId = newChainID
+		return k.cdc.MustMarshal(&val)
+	})
+	k.moveChainRecords(ctx, types.ProbeKeyPrefix, chainID, newChainID, func(b []byte) []byte {
+		var val types.Probe
+		k.cdc.MustUnmarshal(b, &val)
+		val.ChainId = newChainID
+		return k.cdc.MustMarshal(&val)
+	})
+}
+
+// moveChainRecords moves the records stored under the key prefix that are indexed by the chain ID first.
+// Records left under the new chain ID by a previously removed chain are dropped, so that they don't mix with
+// the moved ones.
+func (k Keeper) moveChainRecords(
+	ctx sdk.Context,
+	keyPrefix string,
+	chainID string,
+	newChainID string,
+	rename func(value []byte) []byte,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	oldPrefix := []byte(chainID + "/")
+	newPrefix := []byte(newChainID + "/")
+
+	var staleKeys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, newPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		staleKeys = append(staleKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	var keys, values [][]byte
+	iterator = sdk.KVStorePrefixIterator(store, oldPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+
+		newKey := append(append([]byte{}, newPrefix...), key[len(oldPrefix):]...)
+		store.Set(newKey, rename(values[i]))
+	}
+}
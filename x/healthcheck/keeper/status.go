@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/healthcheck/types"
+)
+
+// ApplyDeliveredUpdate sets the status of a monitored chain that delivered a healthcheck update. It has to be called
+// before the registry block height of the update is recorded. An inactive chain returns to the given live status only
+// after delivering the ActivationThreshold consecutive updates, each within the update interval of the previous one.
+// Chains delivering their first update are activated right away.
+func (k Keeper) ApplyDeliveredUpdate(ctx sdk.Context, monitoredChain *types.Chain, liveStatus types.MonitoredChainStatus) {
+	returning := monitoredChain.RegistryBlockHeight != 0
+	if returning && uint64(ctx.BlockHeight()) <= monitoredChain.RegistryBlockHeight+monitoredChain.UpdateInterval {
+		monitoredChain.ConsecutiveUpdates++
+	} else {
+		monitoredChain.ConsecutiveUpdates = 1
+	}
+
+	// chains that never delivered an update before are activated right away
+	if returning &&
+		monitoredChain.Status == uint64(types.Inactive) &&
+		monitoredChain.ConsecutiveUpdates < atLeastOne(k.ActivationThreshold(ctx)) {
+		return
+	}
+
+	k.SetChainStatus(ctx, monitoredChain, liveStatus)
+}
+
+// GetInactivationHeight returns the registry block height after which a live chain becomes inactive, once it missed
+// the InactivationThreshold update intervals
+func (k Keeper) GetInactivationHeight(ctx sdk.Context, monitoredChain types.Chain) uint64 {
+	return monitoredChain.RegistryBlockHeight + atLeastOne(k.InactivationThreshold(ctx))*monitoredChain.UpdateInterval
+}
+
+// SetChainStatus sets the status of the monitored chain and records the transitions between the inactive and live
+// statuses for the flap detection
+func (k Keeper) SetChainStatus(ctx sdk.Context, monitoredChain *types.Chain, status types.MonitoredChainStatus) {
+	if types.MonitoredChainStatus(monitoredChain.Status).IsLive() != status.IsLive() && k.FlapThreshold(ctx) != 0 {
+		monitoredChain.StatusTransitions = append(monitoredChain.StatusTransitions, uint64(ctx.BlockHeight()))
+	}
+
+	if status == types.Inactive {
+		monitoredChain.ConsecutiveUpdates = 0
+	}
+
+	monitoredChain.Status = uint64(status)
+	k.UpdateFlapping(ctx, monitoredChain)
+}
+
+// UpdateFlapping drops the status transitions older than the FlapWindow and flags the chain as flapping while
+// the number of the remaining ones exceeds the FlapThreshold. It returns true if the chain was modified.
+func (k Keeper) UpdateFlapping(ctx sdk.Context, monitoredChain *types.Chain) bool {
+	flapThreshold := k.FlapThreshold(ctx)
+	flapWindow := k.FlapWindow(ctx)
+
+	changed := false
+	var transitions []uint64
+	for _, height := range monitoredChain.StatusTransitions {
+		if flapThreshold != 0 && height+flapWindow > uint64(ctx.BlockHeight()) {
+			transitions = append(transitions, height)
+		} else {
+			changed = true
+		}
+	}
+	monitoredChain.StatusTransitions = transitions
+
+	flapping := flapThreshold != 0 && uint64(len(transitions)) > flapThreshold
+	if monitoredChain.Flapping == flapping {
+		return changed
+	}
+
+	monitoredChain.Flapping = flapping
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChainFlapping,
+			sdk.NewAttribute(types.AttributeKeyChainID, monitoredChain.ChainId),
+			sdk.NewAttribute(types.AttributeKeyFlapping, strconv.FormatBool(flapping)),
+			sdk.NewAttribute(types.AttributeKeyTransitions, strconv.Itoa(len(transitions))),
+		),
+	)
+
+	return true
+}
+
+func atLeastOne(threshold uint64) uint64 {
+	if threshold == 0 {
+		return 1
+	}
+
+	return threshold
+}
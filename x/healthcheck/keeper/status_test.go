@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/x/healthcheck/types"
+)
+
+func TestApplyDeliveredUpdate(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	params := types.DefaultParams()
+	params.ActivationThreshold = 2
+	keeper.SetParams(ctx, params)
+
+	monitoredChain := types.Chain{ChainId: "a", UpdateInterval: 10, RegistryBlockHeight: 5}
+
+	// the first update after the chain went inactive is late
+	ctx = ctx.WithBlockHeight(30)
+	keeper.ApplyDeliveredUpdate(ctx, &monitoredChain, types.Active)
+	monitoredChain.RegistryBlockHeight = 30
+	require.Equal(t, uint64(types.Inactive), monitoredChain.Status)
+	require.Equal(t, uint64(1), monitoredChain.ConsecutiveUpdates)
+
+	ctx = ctx.WithBlockHeight(40)
+	keeper.ApplyDeliveredUpdate(ctx, &monitoredChain, types.Active)
+	monitoredChain.RegistryBlockHeight = 40
+	require.Equal(t, uint64(types.Active), monitoredChain.Status)
+	require.Equal(t, uint64(2), monitoredChain.ConsecutiveUpdates)
+
+	// late updates of an active chain don't deactivate it
+	ctx = ctx.WithBlockHeight(55)
+	keeper.ApplyDeliveredUpdate(ctx, &monitoredChain, types.Active)
+	require.Equal(t, uint64(types.Active), monitoredChain.Status)
+	require.Equal(t, uint64(1), monitoredChain.ConsecutiveUpdates)
+}
+
+func TestApplyFirstDeliveredUpdate(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	params := types.DefaultParams()
+	params.ActivationThreshold = 2
+	keeper.SetParams(ctx, params)
+
+	monitoredChain := types.Chain{ChainId: "a", UpdateInterval: 10}
+	keeper.ApplyDeliveredUpdate(ctx.WithBlockHeight(30), &monitoredChain, types.Active)
+	require.Equal(t, uint64(types.Active), monitoredChain.Status)
+}
+
+func TestGetInactivationHeight(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	monitoredChain := types.Chain{ChainId: "a", UpdateInterval: 10, RegistryBlockHeight: 5}
+	require.Equal(t, uint64(15), keeper.GetInactivationHeight(ctx, monitoredChain))
+
+	params := types.DefaultParams()
+	params.InactivationThreshold = 3
+	keeper.SetParams(ctx, params)
+	require.Equal(t, uint64(35), keeper.GetInactivationHeight(ctx, monitoredChain))
+}
+
+func TestUpdateFlapping(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	params := types.DefaultParams()
+	params.FlapWindow = 10
+	params.FlapThreshold = 2
+	keeper.SetParams(ctx, params)
+
+	monitoredChain := types.Chain{ChainId: "a", Status: uint64(types.Active)}
+	for height, status := range []types.MonitoredChainStatus{types.Inactive, types.Active, types.Degraded, types.Inactive} {
+		keeper.SetChainStatus(ctx.WithBlockHeight(int64(height+1)), &monitoredChain, status)
+	}
+
+	// switching between the live statuses isn't a transition
+	require.Equal(t, []uint64{1, 2, 4}, monitoredChain.StatusTransitions)
+	require.True(t, monitoredChain.Flapping)
+
+	// transitions out of the window are dropped
+	require.True(t, keeper.UpdateFlapping(ctx.WithBlockHeight(11), &monitoredChain))
+	require.Equal(t, []uint64{2, 4}, monitoredChain.StatusTransitions)
+	require.False(t, monitoredChain.Flapping)
+	require.False(t, keeper.UpdateFlapping(ctx.WithBlockHeight(11), &monitoredChain))
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+// SetChainConnectivity set a specific chainConnectivity in the store from its index
+func (k Keeper) SetChainConnectivity(ctx sdk.Context, chainConnectivity types.ChainConnectivity) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainConnectivityKeyPrefix))
+	b := k.cdc.MustMarshal(&chainConnectivity)
+	store.Set(types.ChainConnectivityKey(
+		chainConnectivity.ChainId,
+	), b)
+}
+
+// GetChainConnectivity returns a chainConnectivity from its index
+func (k Keeper) GetChainConnectivity(
+	ctx sdk.Context,
+	chainId string,
+) (val types.ChainConnectivity, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainConnectivityKeyPrefix))
+
+	b := store.Get(types.ChainConnectivityKey(
+		chainId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllChainConnectivity returns all chainConnectivity
+func (k Keeper) GetAllChainConnectivity(ctx sdk.Context) (list []types.ChainConnectivity) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainConnectivityKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ChainConnectivity
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RecordConnectivity stores the summary of the IBC channels reported in the healthcheck update
+// as the latest connectivity of the monitored chain
+func (k Keeper) RecordConnectivity(ctx sdk.Context, chainID string, update commontypes.HealthcheckUpdateData) {
+	if update.Connectivity == nil {
+		return
+	}
+
+	k.SetChainConnectivity(ctx, types.ChainConnectivity{
+		ChainId:             chainID,
+		RegistryBlockHeight: uint64(ctx.BlockHeight()),
+		Block:               update.Block,
+		Channels:            update.Connectivity.Channels,
+	})
+}
+
+// GetChainNeighbors returns the chains connected to the given chain, either according to the connectivity
+// reported by the chain itself or by its counterparties. Neighbors are ordered by their chain ID.
+func (k Keeper) GetChainNeighbors(ctx sdk.Context, chainID string) []types.ChainNeighbor {
+	neighborsByChainID := make(map[string]*types.ChainNeighbor)
+	addLink := func(neighborChainID string, link types.ChainLink) {
+		neighbor, found := neighborsByChainID[neighborChainID]
+		if !found {
+			neighbor = &types.ChainNeighbor{ChainId: neighborChainID}
+			if neighborChain, found := k.GetChain(ctx, neighborChainID); found {
+				neighbor.Registered = true
+				neighbor.Status = neighborChain.Status
+			}
+			neighborsByChainID[neighborChainID] = neighbor
+		}
+
+		neighbor.Links = append(neighbor.Links, link)
+	}
+
+	for _, connectivity := range k.GetAllChainConnectivity(ctx) {
+		for _, link := range types.NewChainLinks(connectivity) {
+			switch {
+			case link.ChainId == chainID:
+				addLink(link.CounterpartyChainId, link)
+			case link.CounterpartyChainId == chainID:
+				addLink(link.ChainId, link)
+			}
+		}
+	}
+
+	neighbors := make([]types.ChainNeighbor, 0, len(neighborsByChainID))
+	for _, neighbor := range neighborsByChainID {
+		neighbors = append(neighbors, *neighbor)
+	}
+	sort.Slice(neighbors, func(i, j int) bool { return neighbors[i].ChainId < neighbors[j].ChainId })
+
+	return neighbors
+}
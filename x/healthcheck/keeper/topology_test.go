@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestGetChainNeighbors(t *testing.T) {
+	keeper, ctx := keepertest.HealthcheckKeeper(t)
+	keeper.SetChain(ctx, types.Chain{ChainId: "b", Status: uint64(types.Active)})
+
+	for chainID, counterparties := range map[string][]string{
+		"a": {"b", "c"},
+		"b": {"a"},
+		"c": {"d"},
+	} {
+		var update commontypes.HealthcheckUpdateData
+		update.Connectivity = &commontypes.ConnectivitySummary{}
+		for _, counterparty := range counterparties {
+			update.Connectivity.Channels = append(update.Connectivity.Channels, commontypes.ChannelSummary{
+				CounterpartyChainId: counterparty,
+				State:               "STATE_OPEN",
+				ClientStatus:        "Active",
+			})
+		}
+
+		keeper.RecordConnectivity(ctx, chainID, update)
+	}
+
+	link := func(chainID, counterpartyChainID string) types.ChainLink {
+		return types.ChainLink{ChainId: chainID, CounterpartyChainId: counterpartyChainID, Channels: 1, ActiveChannels: 1}
+	}
+
+	require.Equal(t, []types.ChainNeighbor{
+		{ChainId: "b", Registered: true, Status: uint64(types.Active), Links: []types.ChainLink{link("a", "b"), link("b", "a")}},
+		{ChainId: "c", Links: []types.ChainLink{link("a", "c")}},
+	}, keeper.GetChainNeighbors(ctx, "a"))
+
+	require.Equal(t, []types.ChainNeighbor{
+		{ChainId: "c", Links: []types.ChainLink{link("c", "d")}},
+	}, keeper.GetChainNeighbors(ctx, "d"))
+}
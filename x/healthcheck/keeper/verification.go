@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+// VerifyHealthcheckUpdate cross-checks the self-reported block timestamp of the healthcheck update against the
+// consensus state stored by the light client of the monitored chain for the reported block. The update is verified
+// if the consensus state is available and the timestamps don't differ by more than the tolerance. An event is
+// emitted if the timestamps disagree.
+func (k Keeper) VerifyHealthcheckUpdate(ctx sdk.Context, monitoredChain types.Chain, update commontypes.HealthcheckUpdateData) bool {
+	consensusTimestamp, err := k.GetCounterpartyConsensusTimestamp(ctx, monitoredChain.ConnectionId, update.Block)
+	if err != nil {
+		// consensus state for the reported block isn't stored, so the update can't be verified
+		return false
+	}
+
+	tolerance := uint64(time.Duration(k.TimestampVerificationTolerance(ctx)) * time.Second)
+	if absDiff(consensusTimestamp, update.Timestamp) <= tolerance {
+		return true
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimestampMismatch,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChainID, monitoredChain.ChainId),
+			sdk.NewAttribute(types.AttributeKeyBlock, strconv.FormatUint(update.Block, 10)),
+			sdk.NewAttribute(types.AttributeKeyReportedTimestamp, strconv.FormatUint(update.Timestamp, 10)),
+			sdk.NewAttribute(types.AttributeKeyConsensusTimestamp, strconv.FormatUint(consensusTimestamp, 10)),
+		),
+	)
+
+	return false
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+
+	return b - a
+}
+
+// MeasureClockSkew returns by how much (in nanoseconds) the timestamp of a healthcheck update is ahead of the
+// registry block time, and whether that exceeds the maximum allowed clock drift. Skew is negative for updates
+// with timestamps in the past and saturates at math.MaxInt64 for timestamps too far in the future.
+func (k Keeper) MeasureClockSkew(ctx sdk.Context, timestamp uint64) (int64, bool) {
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	maxClockDrift := uint64(time.Duration(k.MaxClockDrift(ctx)) * time.Second)
+
+	// the timestamp is compared unsigned, so it can't wrap around into the past
+	if timestamp < blockTime {
+		return -int64(blockTime - timestamp), false
+	}
+
+	skew := timestamp - blockTime
+	if skew > math.MaxInt64 {
+		return math.MaxInt64, true
+	}
+
+	return int64(skew), skew > maxClockDrift
+}
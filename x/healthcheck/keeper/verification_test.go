@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcheck/testutil/keeper"
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestVerifyHealthcheckUpdate(t *testing.T) {
+	tolerance := uint64(time.Duration(types.DefaultTimestampVerificationTolerance) * time.Second)
+
+	for _, tc := range []struct {
+		desc      string
+		timestamp uint64
+		verified  bool
+	}{
+		{
+			desc:      "MatchingTimestamp",
+			timestamp: 0,
+			verified:  true,
+		},
+		{
+			desc:      "WithinTolerance",
+			timestamp: tolerance,
+			verified:  true,
+		},
+		{
+			desc:      "Mismatch",
+			timestamp: tolerance + 1,
+			verified:  false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.HealthcheckKeeper(t)
+			// stubbed consensus states have zero timestamp
+			verified := k.VerifyHealthcheckUpdate(ctx, types.Chain{ChainId: "0"}, commontypes.HealthcheckUpdateData{
+				Block:     1,
+				Timestamp: tc.timestamp,
+			})
+			require.Equal(t, tc.verified, verified)
+
+			mismatchEvents := 0
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeTimestampMismatch {
+					mismatchEvents++
+				}
+			}
+			require.Equal(t, !tc.verified, mismatchEvents == 1)
+		})
+	}
+}
+
+func TestMeasureClockSkew(t *testing.T) {
+	blockTime := time.Unix(1000, 0)
+	maxClockDrift := time.Duration(types.DefaultMaxClockDrift) * time.Second
+
+	for _, tc := range []struct {
+		desc              string
+		timestamp         time.Time
+		skew              time.Duration
+		exceedsClockDrift bool
+	}{
+		{
+			desc:      "PastTimestamp",
+			timestamp: blockTime.Add(-time.Minute),
+			skew:      -time.Minute,
+		},
+		{
+			desc:      "WithinDrift",
+			timestamp: blockTime.Add(maxClockDrift),
+			skew:      maxClockDrift,
+		},
+		{
+			desc:              "ExceedsDrift",
+			timestamp:         blockTime.Add(maxClockDrift + 1),
+			skew:              maxClockDrift + 1,
+			exceedsClockDrift: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.HealthcheckKeeper(t)
+			ctx = ctx.WithBlockTime(blockTime)
+
+			skew, exceedsClockDrift := k.MeasureClockSkew(ctx, uint64(tc.timestamp.UnixNano()))
+			require.Equal(t, tc.skew.Nanoseconds(), skew)
+			require.Equal(t, tc.exceedsClockDrift, exceedsClockDrift)
+		})
+	}
+}
+
+func TestMeasureClockSkewOverflow(t *testing.T) {
+	k, ctx := keepertest.HealthcheckKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	for _, timestamp := range []uint64{math.MaxInt64 + 1, math.MaxUint64} {
+		skew, exceedsClockDrift := k.MeasureClockSkew(ctx, timestamp)
+		require.Positive(t, skew)
+		require.True(t, exceedsClockDrift)
+	}
+}
@@ -129,6 +129,11 @@ func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -152,7 +157,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the module. It should be incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty versions, the initial version should be set to 1
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
@@ -168,18 +173,19 @@ func MonitoredChainsUpdateEndBlock(ctx sdk.Context, keeper keeper.Keeper) {
 	currentHeight := ctx.BlockHeight()
 
 	keeper.IterateMonitoredChains(ctx, func(monitoredChain types.Chain) (stop bool) {
-		inactivationHeight := monitoredChain.RegistryBlockHeight + monitoredChain.UpdateInterval
-		if monitoredChain.Status == uint64(types.Active) &&
-			monitoredChain.ChannelId != "" &&
-			uint64(currentHeight) > inactivationHeight {
-			monitoredChain.Status = uint64(types.Inactive)
-			keeper.SetChain(ctx, monitoredChain)
-
-			return false
+		changed := keeper.TrackClientProgress(ctx, &monitoredChain)
+		if keeper.ApplyLightClientEvidence(ctx, &monitoredChain) {
+			changed = true
 		}
 
-		removalHeight := monitoredChain.RegistryBlockHeight + monitoredChain.UpdateInterval + monitoredChain.TimeoutInterval
-		if monitoredChain.Status == uint64(types.Inactive) &&
+		inactivationHeight := keeper.GetInactivationHeight(ctx, monitoredChain)
+		removalHeight := inactivationHeight + monitoredChain.TimeoutInterval
+		if types.MonitoredChainStatus(monitoredChain.Status).IsLive() &&
+			(monitoredChain.ChannelId != "" || monitoredChain.Passive) &&
+			uint64(currentHeight) > inactivationHeight {
+			keeper.SetChainStatus(ctx, &monitoredChain, types.Inactive)
+			changed = true
+		} else if monitoredChain.Status == uint64(types.Inactive) &&
 			monitoredChain.ChannelId != "" &&
 			uint64(currentHeight) > removalHeight {
 			err := keeper.ChanCloseInit(ctx, keeper.GetPort(ctx), monitoredChain.ChannelId)
@@ -188,9 +194,29 @@ func MonitoredChainsUpdateEndBlock(ctx sdk.Context, keeper keeper.Keeper) {
 			}
 
 			monitoredChain.ChannelId = ""
+			changed = true
+		}
+
+		if keeper.UpdateFlapping(ctx, &monitoredChain) {
+			changed = true
+		}
+
+		diagnosis := uint64(types.DiagnoseChain(monitoredChain))
+		if monitoredChain.Diagnosis != diagnosis {
+			monitoredChain.Diagnosis = diagnosis
+			changed = true
+		}
+
+		if changed {
 			keeper.SetChain(ctx, monitoredChain)
 		}
 
+		if keeper.ShouldSendProbe(ctx, monitoredChain) {
+			if err := keeper.SendProbe(ctx, monitoredChain); err != nil {
+				keeper.Logger(ctx).Debug("failed to send probe challenge", "chain-id", monitoredChain.ChainId, "error", err.Error())
+			}
+		}
+
 		return false
 	})
 }
@@ -2,6 +2,7 @@ package healthcheck
 
 import (
 	"fmt"
+	"time"
 
 	"healthcheck/x/healthcheck/keeper"
 	"healthcheck/x/healthcheck/types"
@@ -51,8 +52,8 @@ func (im IBCModule) OnChanOpenTry(
 	counterparty channeltypes.Counterparty,
 	counterpartyVersion string,
 ) (string, error) {
-	if order != channeltypes.ORDERED {
-		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.ORDERED, order)
+	if err := commontypes.ValidateChannelOrdering(order); err != nil {
+		return "", err
 	}
 
 	// Require portID is the portID module is bound to
@@ -65,14 +66,10 @@ func (im IBCModule) OnChanOpenTry(
 		return "", sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid counterparty port: %s, expected %s", counterparty.PortId, commontypes.MonitoredPortID)
 	}
 
-	metadata := &commontypes.HandshakeMetadata{}
-	if err := metadata.Unmarshal([]byte(counterpartyVersion)); err != nil {
+	metadata, err := commontypes.DecodeHandshakeMetadata(counterpartyVersion)
+	if err != nil {
 		return "", sdkerrors.Wrapf(types.ErrInvalidHandshakeMetadata,
-			"error unmarshalling ibc-try metadata: \n%v; \nmetadata: %v", err, counterpartyVersion)
-	}
-
-	if metadata.Version != commontypes.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, commontypes.Version)
+			"error decoding ibc-try metadata: \n%v; \nmetadata: %v", err, counterpartyVersion)
 	}
 
 	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
@@ -104,6 +101,10 @@ func (im IBCModule) OnChanOpenTry(
 		return "", sdkerrors.Wrapf(types.ErrUnexpectedConnectionID, "unexpected connection for chain with chain ID %s, expected: %s, got: %s", monitoredChainID, monitoredChain.ConnectionId, connectionHops[0])
 	}
 
+	if monitoredChain.Passive {
+		return "", sdkerrors.Wrapf(types.ErrPassiveChain, "chain with the chain ID %s can't open a healthcheck channel", monitoredChainID)
+	}
+
 	// TODO: if channel is closed we could check the timeout and allow a new channel to be opened even if this two fields were set
 	if monitoredChain.UpdateInterval != 0 && monitoredChain.TimeoutInterval != 0 {
 		return "", types.ErrChainAlreadyTracked
@@ -117,12 +118,31 @@ func (im IBCModule) OnChanOpenTry(
 		metadata.TimeoutInterval = types.DefaultTimeoutInterval
 	}
 
+	if err := im.keeper.ValidateIntervals(ctx, metadata.UpdateInterval, metadata.TimeoutInterval); err != nil {
+		return "", err
+	}
+
 	monitoredChain.TimeoutInterval = metadata.TimeoutInterval
 	monitoredChain.UpdateInterval = metadata.UpdateInterval
 
+	if metadata.IsLegacy() {
+		monitoredChain.Features = nil
+		im.keeper.SetChain(ctx, monitoredChain)
+
+		return commontypes.Version, nil
+	}
+
+	metadata.Features = commontypes.IntersectFeatures(metadata.Features, commontypes.SupportedFeatures())
+	monitoredChain.Features = metadata.Features
+
+	version, err := metadata.Encode()
+	if err != nil {
+		return "", sdkerrors.Wrapf(types.ErrInvalidHandshakeMetadata, "error encoding ibc-try metadata: %v", err)
+	}
+
 	im.keeper.SetChain(ctx, monitoredChain)
 
-	return commontypes.Version, nil
+	return version, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -200,24 +220,77 @@ func (im IBCModule) OnRecvPacket(
 
 	monitoredChain, found := im.keeper.GetChain(ctx, chainID)
 	if !found {
+		if registeredChain, found := im.keeper.GetChainByIdentity(ctx, chainID); found {
+			return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(types.ErrChainResetRequired, "chain with the chain ID %s is registered as %s", chainID, registeredChain.ChainId))
+		}
+
 		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(types.ErrChainNotRegistered, "chain with the chain ID %s isn't registered yet", chainID))
 	}
 
 	// Dispatch packet
 	switch packet := modulePacketData.Packet.(type) {
 	case *commontypes.HealthcheckPacketData_Data:
+		clockSkew, exceedsClockDrift := im.keeper.MeasureClockSkew(ctx, packet.Data.Timestamp)
+		if exceedsClockDrift {
+			err := sdkerrors.Wrapf(types.ErrClockDrift, "timestamp of the healthcheck update for chain with chain ID %s is %s ahead of the registry chain", monitoredChain.ChainId, time.Duration(clockSkew))
+			// state changes of error acknowledgements are discarded, so the rejection is only recorded in an event
+			im.keeper.EmitUpdateRejected(ctx, monitoredChain.ChainId, relayer.String(), clockSkew, err)
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+
 		if monitoredChain.Timestamp > packet.Data.Timestamp ||
 			monitoredChain.Block > packet.Data.Block {
-			err := fmt.Errorf("newer healthcheck update has already been submitted for chain with chain ID %s", monitoredChain.ChainId)
+			if im.keeper.IsUnorderedChannel(ctx, modulePacket.DestinationPort, modulePacket.DestinationChannel) {
+				// packets can arrive out of order on unordered channels, so the late ones are just ignored
+				im.keeper.RecordRelayerStaleRejection(ctx, monitoredChain.ChainId, relayer.String())
+				return types.NewHealthcheckAcknowledgement(monitoredChain, im.keeper.GetInactivationHeight(ctx, monitoredChain))
+			}
+
+			err := fmt.Errorf("newer healthcheck update has already been submitted for chain with chain ID %s; if the chain was restarted, the reset has to be acknowledged", monitoredChain.ChainId)
+			im.keeper.EmitUpdateRejected(ctx, monitoredChain.ChainId, relayer.String(), clockSkew, err)
 			return channeltypes.NewErrorAcknowledgement(err)
 		}
 
-		monitoredChain.Status = uint64(types.Active)
+		monitoredChain.ClockSkew = clockSkew
+
+		latencyPercentiles := im.keeper.RecordRelayLatency(ctx, monitoredChain.ChainId, clockSkew)
+		previousStatus := monitoredChain.Status
+		im.keeper.ApplyDeliveredUpdate(ctx, &monitoredChain, im.keeper.GetLiveStatus(ctx, latencyPercentiles))
+		im.keeper.EmitChainDegraded(ctx, monitoredChain, previousStatus, latencyPercentiles)
+		monitoredChain.Diagnosis = uint64(types.DiagnoseChain(monitoredChain))
+		monitoredChain.EvidenceSource = uint64(types.HealthcheckPacket)
+		monitoredChain.Verified = im.keeper.VerifyHealthcheckUpdate(ctx, monitoredChain, *packet.Data)
 		monitoredChain.Timestamp = packet.Data.Timestamp
 		monitoredChain.Block = packet.Data.Block
 		monitoredChain.RegistryBlockHeight = uint64(ctx.BlockHeight())
+		monitoredChain.LastRelayer = relayer.String()
+		monitoredChain.LastHeartbeatReason = packet.Data.Reason
+		im.keeper.RecordSigningParticipation(ctx, &monitoredChain, *packet.Data)
+		im.keeper.RecordDecentralizationMetrics(ctx, monitoredChain.ChainId, *packet.Data)
+		im.keeper.RecordIndicators(ctx, monitoredChain.ChainId, *packet.Data)
+		im.keeper.RecordConnectivity(ctx, monitoredChain.ChainId, *packet.Data)
+		im.keeper.RecordHeartbeat(ctx, monitoredChain.ChainId, *packet.Data)
+		im.keeper.RecordProbeResponse(ctx, monitoredChain.ChainId, *packet.Data)
 		im.keeper.SetChain(ctx, monitoredChain)
 
+		im.keeper.RecordRelayerDelivery(ctx, monitoredChain.ChainId, relayer.String())
+
+		ack = types.NewHealthcheckAcknowledgement(monitoredChain, im.keeper.GetInactivationHeight(ctx, monitoredChain))
+
+	case *commontypes.HealthcheckPacketData_IntervalChangeRequest:
+		if !commontypes.HasFeature(monitoredChain.Features, commontypes.FeatureIntervalChange) {
+			err := sdkerrors.Wrapf(types.ErrInvalidIntervals, "interval change wasn't negotiated for chain with chain ID %s", monitoredChain.ChainId)
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+
+		request := packet.IntervalChangeRequest
+		if err := im.keeper.ChangeIntervals(ctx, &monitoredChain, request.UpdateInterval, request.TimeoutInterval); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+
+		// the acknowledgement confirms the intervals the registry chain applied
+		ack = channeltypes.NewResultAcknowledgement(types.NewHealthcheckAckResult(monitoredChain, im.keeper.GetInactivationHeight(ctx, monitoredChain)))
+
 	// this line is used by starport scaffolding # ibc/packet/module/recv
 	default:
 		err := fmt.Errorf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -235,7 +308,22 @@ func (im IBCModule) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	return fmt.Errorf("registry chain does not send packets; no acknowledgements are expected")
+	var ack channeltypes.Acknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
+	}
+
+	probeChallenge, err := im.getProbeChallenge(modulePacket)
+	if err != nil {
+		return err
+	}
+
+	// the challenge is answered in the next healthcheck update, the acknowledgement only confirms its delivery
+	if !ack.Success() {
+		im.onProbeFailure(ctx, modulePacket, probeChallenge)
+	}
+
+	return nil
 }
 
 // OnTimeoutPacket implements the IBCModule interface
@@ -244,5 +332,45 @@ func (im IBCModule) OnTimeoutPacket(
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	return fmt.Errorf("registry chain does not send packets; no timeouts are expected")
+	probeChallenge, err := im.getProbeChallenge(modulePacket)
+	if err != nil {
+		return err
+	}
+
+	im.onProbeFailure(ctx, modulePacket, probeChallenge)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return nil
+}
+
+// getProbeChallenge returns the probe challenge sent in the packet, since probe challenges are the only packets
+// sent by the registry chain
+func (im IBCModule) getProbeChallenge(modulePacket channeltypes.Packet) (*commontypes.ProbeChallenge, error) {
+	var modulePacketData commontypes.HealthcheckPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(modulePacket.GetData(), &modulePacketData); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %v", err)
+	}
+
+	probeChallenge := modulePacketData.GetProbeChallenge()
+	if probeChallenge == nil {
+		return nil, fmt.Errorf("registry chain sends probe challenges only; got %T", modulePacketData.Packet)
+	}
+
+	return probeChallenge, nil
+}
+
+func (im IBCModule) onProbeFailure(ctx sdk.Context, modulePacket channeltypes.Packet, probeChallenge *commontypes.ProbeChallenge) {
+	chainID, err := im.keeper.GetCounterpartyChainIDFromChannel(ctx, modulePacket.SourcePort, modulePacket.SourceChannel)
+	if err != nil {
+		im.keeper.Logger(ctx).Debug("failed to resolve chain ID of probed chain", "channel-id", modulePacket.SourceChannel, "error", err.Error())
+		return
+	}
+
+	im.keeper.RecordProbeFailure(ctx, chainID, probeChallenge.Nonce)
 }
@@ -36,6 +36,10 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgDeleteChain int = 100
 
+	opWeightMsgResetChain = "op_weight_msg_chain"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgResetChain int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -114,6 +118,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		healthchecksimulation.SimulateMsgDeleteChain(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgResetChain int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgResetChain, &weightMsgResetChain, nil,
+		func(_ *rand.Rand) {
+			weightMsgResetChain = defaultWeightMsgResetChain
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgResetChain,
+		healthchecksimulation.SimulateMsgResetChain(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -145,3 +145,49 @@ func SimulateMsgDeleteChain(
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
+
+func SimulateMsgResetChain(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			simAccount = simtypes.Account{}
+			chain      = types.Chain{}
+			msg        = &types.MsgResetChain{}
+			allChain   = k.GetAllChain(ctx)
+			found      = false
+		)
+		for _, obj := range allChain {
+			simAccount, found = FindAccount(accs, obj.Creator)
+			if found {
+				chain = obj
+				break
+			}
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "chain creator not found"), nil, nil
+		}
+		msg.Creator = simAccount.Address.String()
+
+		msg.ChainId = chain.ChainId
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	commontypes "healthcheck/x/types"
+)
+
+// NewHealthcheckAckResult returns the result of the acknowledgement of an accepted healthcheck update,
+// describing how the registry chain recorded the monitored chain. The next update deadline is the registry block
+// height after which the monitored chain becomes inactive.
+func NewHealthcheckAckResult(monitoredChain Chain, nextUpdateDeadline uint64) []byte {
+	healthcheckAck := commontypes.HealthcheckAck{
+		RegistryBlockHeight: monitoredChain.RegistryBlockHeight,
+		Status:              monitoredChain.Status,
+		NextUpdateDeadline:  nextUpdateDeadline,
+		UpdateInterval:      monitoredChain.UpdateInterval,
+		TimeoutInterval:     monitoredChain.TimeoutInterval,
+	}
+
+	// marshaling of a message with scalar fields only can't fail
+	bz, _ := healthcheckAck.Marshal()
+	return bz
+}
+
+// NewHealthcheckAcknowledgement returns the acknowledgement of an accepted healthcheck update. Chains that
+// negotiated structured acknowledgements receive the registry view, others the legacy opaque result.
+func NewHealthcheckAcknowledgement(monitoredChain Chain, nextUpdateDeadline uint64) channeltypes.Acknowledgement {
+	if !commontypes.HasFeature(monitoredChain.Features, commontypes.FeatureStructuredAck) {
+		return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	}
+
+	return channeltypes.NewResultAcknowledgement(NewHealthcheckAckResult(monitoredChain, nextUpdateDeadline))
+}
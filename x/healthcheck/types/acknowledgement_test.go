@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestNewHealthcheckAcknowledgement(t *testing.T) {
+	monitoredChain := types.Chain{
+		RegistryBlockHeight: 100,
+		Status:              uint64(types.Active),
+		UpdateInterval:      10,
+		TimeoutInterval:     20,
+	}
+
+	// chains without structured acknowledgements receive the legacy result
+	ack := types.NewHealthcheckAcknowledgement(monitoredChain, 130)
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte{byte(1)}), ack)
+
+	monitoredChain.Features = []string{commontypes.FeatureStructuredAck}
+	ack = types.NewHealthcheckAcknowledgement(monitoredChain, 130)
+	var healthcheckAck commontypes.HealthcheckAck
+	require.NoError(t, healthcheckAck.Unmarshal(ack.GetResult()))
+	require.Equal(t, commontypes.HealthcheckAck{
+		RegistryBlockHeight: 100,
+		Status:              uint64(types.Active),
+		NextUpdateDeadline:  130,
+		UpdateInterval:      10,
+		TimeoutInterval:     20,
+	}, healthcheckAck)
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -23,16 +24,36 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Chain struct {
-	ChainId             string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
-	ConnectionId        string `protobuf:"bytes,2,opt,name=connectionId,proto3" json:"connectionId,omitempty"`
-	ChannelId           string `protobuf:"bytes,3,opt,name=channelId,proto3" json:"channelId,omitempty"`
-	Creator             string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
-	UpdateInterval      uint64 `protobuf:"varint,5,opt,name=updateInterval,proto3" json:"updateInterval,omitempty"`
-	TimeoutInterval     uint64 `protobuf:"varint,6,opt,name=timeoutInterval,proto3" json:"timeoutInterval,omitempty"`
-	Status              uint64 `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
-	Timestamp           uint64 `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Block               uint64 `protobuf:"varint,9,opt,name=block,proto3" json:"block,omitempty"`
-	RegistryBlockHeight uint64 `protobuf:"varint,10,opt,name=registryBlockHeight,proto3" json:"registryBlockHeight,omitempty"`
+	ChainId                    string       `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	ConnectionId               string       `protobuf:"bytes,2,opt,name=connectionId,proto3" json:"connectionId,omitempty"`
+	ChannelId                  string       `protobuf:"bytes,3,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Creator                    string       `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	UpdateInterval             uint64       `protobuf:"varint,5,opt,name=updateInterval,proto3" json:"updateInterval,omitempty"`
+	TimeoutInterval            uint64       `protobuf:"varint,6,opt,name=timeoutInterval,proto3" json:"timeoutInterval,omitempty"`
+	Status                     uint64       `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	Timestamp                  uint64       `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Block                      uint64       `protobuf:"varint,9,opt,name=block,proto3" json:"block,omitempty"`
+	RegistryBlockHeight        uint64       `protobuf:"varint,10,opt,name=registryBlockHeight,proto3" json:"registryBlockHeight,omitempty"`
+	LastRelayer                string       `protobuf:"bytes,11,opt,name=lastRelayer,proto3" json:"lastRelayer,omitempty"`
+	ClientLatestHeight         uint64       `protobuf:"varint,12,opt,name=clientLatestHeight,proto3" json:"clientLatestHeight,omitempty"`
+	ClientUpdateRegistryHeight uint64       `protobuf:"varint,13,opt,name=clientUpdateRegistryHeight,proto3" json:"clientUpdateRegistryHeight,omitempty"`
+	Diagnosis                  uint64       `protobuf:"varint,14,opt,name=diagnosis,proto3" json:"diagnosis,omitempty"`
+	Passive                    bool         `protobuf:"varint,15,opt,name=passive,proto3" json:"passive,omitempty"`
+	EvidenceSource             uint64       `protobuf:"varint,16,opt,name=evidenceSource,proto3" json:"evidenceSource,omitempty"`
+	Verified                   bool         `protobuf:"varint,17,opt,name=verified,proto3" json:"verified,omitempty"`
+	ClockSkew                  int64        `protobuf:"varint,18,opt,name=clockSkew,proto3" json:"clockSkew,omitempty"`
+	Resets                     []ChainReset `protobuf:"bytes,19,rep,name=resets,proto3" json:"resets"`
+	Features                   []string     `protobuf:"bytes,20,rep,name=features,proto3" json:"features,omitempty"`
+	SignedVotingPower          uint64       `protobuf:"varint,21,opt,name=signedVotingPower,proto3" json:"signedVotingPower,omitempty"`
+	TotalVotingPower           uint64       `protobuf:"varint,22,opt,name=totalVotingPower,proto3" json:"totalVotingPower,omitempty"`
+	ValidatorCount             uint64       `protobuf:"varint,23,opt,name=validatorCount,proto3" json:"validatorCount,omitempty"`
+	AtRisk                     bool         `protobuf:"varint,24,opt,name=atRisk,proto3" json:"atRisk,omitempty"`
+	LastHeartbeatReason        uint64       `protobuf:"varint,25,opt,name=lastHeartbeatReason,proto3" json:"lastHeartbeatReason,omitempty"`
+	// number of the consecutive updates delivered within the update interval of the previous one
+	ConsecutiveUpdates uint64 `protobuf:"varint,26,opt,name=consecutiveUpdates,proto3" json:"consecutiveUpdates,omitempty"`
+	// registry block heights of the recent transitions between the inactive and live statuses
+	StatusTransitions []uint64 `protobuf:"varint,27,rep,packed,name=statusTransitions,proto3" json:"statusTransitions,omitempty"`
+	Flapping          bool     `protobuf:"varint,28,opt,name=flapping,proto3" json:"flapping,omitempty"`
 }
 
 func (m *Chain) Reset()         { *m = Chain{} }
@@ -138,8 +159,204 @@ func (m *Chain) GetRegistryBlockHeight() uint64 {
 	return 0
 }
 
+func (m *Chain) GetLastRelayer() string {
+	if m != nil {
+		return m.LastRelayer
+	}
+	return ""
+}
+
+func (m *Chain) GetClientLatestHeight() uint64 {
+	if m != nil {
+		return m.ClientLatestHeight
+	}
+	return 0
+}
+
+func (m *Chain) GetClientUpdateRegistryHeight() uint64 {
+	if m != nil {
+		return m.ClientUpdateRegistryHeight
+	}
+	return 0
+}
+
+func (m *Chain) GetDiagnosis() uint64 {
+	if m != nil {
+		return m.Diagnosis
+	}
+	return 0
+}
+
+func (m *Chain) GetPassive() bool {
+	if m != nil {
+		return m.Passive
+	}
+	return false
+}
+
+func (m *Chain) GetEvidenceSource() uint64 {
+	if m != nil {
+		return m.EvidenceSource
+	}
+	return 0
+}
+
+func (m *Chain) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *Chain) GetClockSkew() int64 {
+	if m != nil {
+		return m.ClockSkew
+	}
+	return 0
+}
+
+func (m *Chain) GetResets() []ChainReset {
+	if m != nil {
+		return m.Resets
+	}
+	return nil
+}
+
+func (m *Chain) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *Chain) GetSignedVotingPower() uint64 {
+	if m != nil {
+		return m.SignedVotingPower
+	}
+	return 0
+}
+
+func (m *Chain) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *Chain) GetValidatorCount() uint64 {
+	if m != nil {
+		return m.ValidatorCount
+	}
+	return 0
+}
+
+func (m *Chain) GetAtRisk() bool {
+	if m != nil {
+		return m.AtRisk
+	}
+	return false
+}
+
+func (m *Chain) GetLastHeartbeatReason() uint64 {
+	if m != nil {
+		return m.LastHeartbeatReason
+	}
+	return 0
+}
+
+func (m *Chain) GetConsecutiveUpdates() uint64 {
+	if m != nil {
+		return m.ConsecutiveUpdates
+	}
+	return 0
+}
+
+func (m *Chain) GetStatusTransitions() []uint64 {
+	if m != nil {
+		return m.StatusTransitions
+	}
+	return nil
+}
+
+func (m *Chain) GetFlapping() bool {
+	if m != nil {
+		return m.Flapping
+	}
+	return false
+}
+
+// ChainReset links the history of a chain before an acknowledged restart or revision bump
+type ChainReset struct {
+	ChainId             string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Block               uint64 `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	Timestamp           uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RegistryBlockHeight uint64 `protobuf:"varint,4,opt,name=registryBlockHeight,proto3" json:"registryBlockHeight,omitempty"`
+}
+
+func (m *ChainReset) Reset()         { *m = ChainReset{} }
+func (m *ChainReset) String() string { return proto.CompactTextString(m) }
+func (*ChainReset) ProtoMessage()    {}
+func (*ChainReset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d24e1b453b69ac81, []int{1}
+}
+func (m *ChainReset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainReset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainReset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainReset.Merge(m, src)
+}
+func (m *ChainReset) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainReset) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainReset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainReset proto.InternalMessageInfo
+
+func (m *ChainReset) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainReset) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *ChainReset) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ChainReset) GetRegistryBlockHeight() uint64 {
+	if m != nil {
+		return m.RegistryBlockHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Chain)(nil), "healthcheck.healthcheck.Chain")
+	proto.RegisterType((*ChainReset)(nil), "healthcheck.healthcheck.ChainReset")
 }
 
 func init() {
@@ -147,26 +364,48 @@ func init() {
 }
 
 var fileDescriptor_d24e1b453b69ac81 = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4f, 0xf3, 0x30,
-	0x10, 0x86, 0xeb, 0x7e, 0x6d, 0xfa, 0xc5, 0x42, 0x20, 0x19, 0x04, 0x1e, 0x90, 0xa9, 0x8a, 0x84,
-	0x32, 0x15, 0x24, 0x26, 0xd6, 0xb2, 0x90, 0xb5, 0x23, 0x9b, 0xeb, 0x9c, 0xea, 0xa8, 0xa9, 0x1d,
-	0x39, 0x17, 0x44, 0xff, 0x05, 0x3f, 0x8b, 0x05, 0xa9, 0x23, 0x23, 0x4a, 0xfe, 0x08, 0x8a, 0x5b,
-	0x68, 0x5a, 0xb1, 0xdd, 0xfb, 0x3e, 0x8f, 0x4f, 0x96, 0x8e, 0x5e, 0x6b, 0x90, 0x19, 0x6a, 0xa5,
-	0x41, 0x2d, 0x6e, 0xdb, 0xb3, 0xd2, 0x32, 0x35, 0xe3, 0xdc, 0x59, 0xb4, 0xec, 0xa2, 0x05, 0xc6,
-	0xad, 0x79, 0xf4, 0xd1, 0xa5, 0xfd, 0xc7, 0x46, 0x64, 0x9c, 0x0e, 0xfc, 0x8b, 0x38, 0xe1, 0x64,
-	0x48, 0xa2, 0x70, 0xfa, 0x13, 0xd9, 0x88, 0x1e, 0x29, 0x6b, 0x0c, 0x28, 0x4c, 0x6d, 0x83, 0xbb,
-	0x1e, 0xef, 0x75, 0xec, 0x92, 0x86, 0x4a, 0x4b, 0x63, 0x20, 0x8b, 0x13, 0xfe, 0xcf, 0x0b, 0xbb,
-	0xc2, 0xef, 0x76, 0x20, 0xd1, 0x3a, 0xde, 0xdb, 0xee, 0xde, 0x44, 0x76, 0x43, 0x8f, 0xcb, 0x3c,
-	0x91, 0x08, 0xb1, 0x41, 0x70, 0x2f, 0x32, 0xe3, 0xfd, 0x21, 0x89, 0x7a, 0xd3, 0x83, 0x96, 0x45,
-	0xf4, 0x04, 0xd3, 0x25, 0xd8, 0x12, 0x7f, 0xc5, 0xc0, 0x8b, 0x87, 0x35, 0x3b, 0xa7, 0x41, 0x81,
-	0x12, 0xcb, 0x82, 0x0f, 0xbc, 0xb0, 0x4d, 0xcd, 0x0f, 0x1b, 0xb5, 0x40, 0xb9, 0xcc, 0xf9, 0x7f,
-	0x8f, 0x76, 0x05, 0x3b, 0xa3, 0xfd, 0x59, 0x66, 0xd5, 0x82, 0x87, 0x9e, 0x6c, 0x02, 0xbb, 0xa3,
-	0xa7, 0x0e, 0xe6, 0x69, 0x81, 0x6e, 0x35, 0x69, 0x8a, 0x27, 0x48, 0xe7, 0x1a, 0x39, 0xf5, 0xce,
-	0x5f, 0x68, 0xf2, 0xf0, 0x5e, 0x09, 0xb2, 0xae, 0x04, 0xf9, 0xaa, 0x04, 0x79, 0xab, 0x45, 0x67,
-	0x5d, 0x8b, 0xce, 0x67, 0x2d, 0x3a, 0xcf, 0x57, 0xed, 0xdb, 0xbc, 0xee, 0x5d, 0x0a, 0x57, 0x39,
-	0x14, 0xb3, 0xc0, 0x9f, 0xea, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xad, 0xcc, 0xae, 0x09, 0xd1,
-	0x01, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
+	0x10, 0x8e, 0x49, 0x08, 0xc9, 0xc2, 0x8f, 0x3f, 0x0b, 0x3f, 0xd8, 0xa6, 0x28, 0x58, 0x20, 0x55,
+	0x56, 0x55, 0x85, 0xaa, 0x3d, 0xf5, 0x52, 0xa9, 0x70, 0x01, 0xa9, 0x87, 0xca, 0xb4, 0x3d, 0xf4,
+	0xb6, 0xd8, 0x83, 0xb3, 0x8a, 0xd9, 0xb5, 0x76, 0xc7, 0xa6, 0xbc, 0x43, 0x0f, 0x7d, 0x82, 0x3e,
+	0x0f, 0x47, 0x8e, 0x3d, 0x55, 0x15, 0xbc, 0x48, 0xb5, 0x6b, 0x93, 0x38, 0xe1, 0xcf, 0x6d, 0xe7,
+	0xfb, 0xbe, 0x1d, 0x7b, 0x66, 0xbe, 0x59, 0xb2, 0x37, 0x04, 0x9e, 0xe2, 0x30, 0x1a, 0x42, 0x34,
+	0xda, 0xaf, 0x9f, 0xa3, 0x21, 0x17, 0x72, 0x90, 0x69, 0x85, 0x8a, 0x6e, 0xd5, 0x88, 0x41, 0xed,
+	0xdc, 0xdb, 0x48, 0x54, 0xa2, 0x9c, 0x66, 0xdf, 0x9e, 0x4a, 0xf9, 0xee, 0xaf, 0x0e, 0x99, 0x3f,
+	0xb4, 0xd7, 0x29, 0x23, 0x0b, 0x2e, 0xcf, 0x71, 0xcc, 0x3c, 0xdf, 0x0b, 0xba, 0xe1, 0x5d, 0x48,
+	0x77, 0xc9, 0x52, 0xa4, 0xa4, 0x84, 0x08, 0x85, 0xb2, 0xf4, 0x9c, 0xa3, 0xa7, 0x30, 0xba, 0x4d,
+	0xba, 0xd1, 0x90, 0x4b, 0x09, 0xe9, 0x71, 0xcc, 0x9a, 0x4e, 0x30, 0x01, 0x5c, 0x6e, 0x0d, 0x1c,
+	0x95, 0x66, 0xad, 0x2a, 0x77, 0x19, 0xd2, 0x17, 0x64, 0x39, 0xcf, 0x62, 0x8e, 0x70, 0x2c, 0x11,
+	0x74, 0xc1, 0x53, 0x36, 0xef, 0x7b, 0x41, 0x2b, 0x9c, 0x41, 0x69, 0x40, 0x56, 0x50, 0x9c, 0x83,
+	0xca, 0x71, 0x2c, 0x6c, 0x3b, 0xe1, 0x2c, 0x4c, 0x37, 0x49, 0xdb, 0x20, 0xc7, 0xdc, 0xb0, 0x05,
+	0x27, 0xa8, 0x22, 0xfb, 0x87, 0x56, 0x6a, 0x90, 0x9f, 0x67, 0xac, 0xe3, 0xa8, 0x09, 0x40, 0x37,
+	0xc8, 0xfc, 0x69, 0xaa, 0xa2, 0x11, 0xeb, 0x3a, 0xa6, 0x0c, 0xe8, 0x6b, 0xb2, 0xae, 0x21, 0x11,
+	0x06, 0xf5, 0xe5, 0x81, 0x05, 0x8e, 0x40, 0x24, 0x43, 0x64, 0xc4, 0x69, 0x1e, 0xa2, 0xa8, 0x4f,
+	0x16, 0x53, 0x6e, 0x30, 0x84, 0x94, 0x5f, 0x82, 0x66, 0x8b, 0xae, 0xda, 0x3a, 0x44, 0x07, 0x84,
+	0x46, 0xa9, 0x00, 0x89, 0x1f, 0x39, 0x82, 0xc1, 0x2a, 0xe5, 0x92, 0x4b, 0xf9, 0x00, 0x43, 0xdf,
+	0x93, 0x5e, 0x89, 0x7e, 0x71, 0x1d, 0x09, 0xab, 0x8f, 0x56, 0xf7, 0xfe, 0x73, 0xf7, 0x9e, 0x50,
+	0xd8, 0xba, 0x63, 0xc1, 0x13, 0xa9, 0x8c, 0x30, 0x6c, 0xb9, 0xac, 0x7b, 0x0c, 0xd8, 0xc9, 0x64,
+	0xdc, 0x18, 0x51, 0x00, 0x5b, 0xf1, 0xbd, 0xa0, 0x13, 0xde, 0x85, 0x76, 0x32, 0x50, 0x88, 0x18,
+	0x64, 0x04, 0x27, 0x2a, 0xd7, 0x11, 0xb0, 0xd5, 0x72, 0x32, 0xd3, 0x28, 0xed, 0x91, 0x4e, 0x01,
+	0x5a, 0x9c, 0x09, 0x88, 0xd9, 0x9a, 0x4b, 0x31, 0x8e, 0x9d, 0x2b, 0x6c, 0x73, 0x4e, 0x46, 0x70,
+	0xc1, 0xa8, 0xef, 0x05, 0xcd, 0x70, 0x02, 0xd0, 0x0f, 0xa4, 0xad, 0xc1, 0x00, 0x1a, 0xb6, 0xee,
+	0x37, 0x83, 0xc5, 0x37, 0x7b, 0x83, 0x47, 0xbc, 0x3b, 0x70, 0x0e, 0x0d, 0xad, 0xf6, 0xa0, 0x75,
+	0xf5, 0x67, 0xa7, 0x11, 0x56, 0x17, 0xed, 0xc7, 0xcf, 0x80, 0x63, 0xae, 0xc1, 0xb0, 0x0d, 0xbf,
+	0x19, 0x74, 0xc3, 0x71, 0x4c, 0x5f, 0x91, 0x35, 0x23, 0x12, 0x09, 0xf1, 0x57, 0x85, 0x42, 0x26,
+	0x9f, 0xd4, 0x05, 0x68, 0xf6, 0xbf, 0xab, 0xe1, 0x3e, 0x41, 0x5f, 0x92, 0x55, 0x54, 0xc8, 0xd3,
+	0xba, 0x78, 0xd3, 0x89, 0xef, 0xe1, 0xb6, 0x35, 0x05, 0x4f, 0x45, 0x6c, 0x1d, 0x7c, 0xa8, 0x72,
+	0x89, 0x6c, 0xab, 0x6c, 0xcd, 0x34, 0x6a, 0xad, 0xc8, 0x31, 0x14, 0x66, 0xc4, 0x98, 0x6b, 0x4c,
+	0x15, 0x59, 0x5b, 0x59, 0x47, 0x1c, 0x01, 0xd7, 0x78, 0x0a, 0x1c, 0x43, 0xe0, 0x46, 0x49, 0xf6,
+	0xac, 0xb4, 0xd5, 0x03, 0x94, 0x33, 0x8d, 0x92, 0x06, 0xa2, 0x1c, 0x45, 0x01, 0xe5, 0x9c, 0x0d,
+	0xeb, 0x55, 0xa6, 0xb9, 0xc7, 0xb8, 0xda, 0x9d, 0xed, 0x3f, 0x6b, 0x2e, 0x8d, 0xb0, 0x4b, 0x6a,
+	0xd8, 0x73, 0xbf, 0xe9, 0x6a, 0x9f, 0x25, 0x5c, 0x17, 0x53, 0x9e, 0x65, 0x42, 0x26, 0x6c, 0xbb,
+	0x1c, 0xe1, 0x5d, 0xbc, 0xfb, 0xc3, 0x23, 0x64, 0xd2, 0xfe, 0x27, 0x5e, 0x89, 0xf1, 0x06, 0xcd,
+	0xd5, 0x37, 0x68, 0x6a, 0xeb, 0x9a, 0xb3, 0x5b, 0xf7, 0xc8, 0x7e, 0xb5, 0x1e, 0xdd, 0xaf, 0x83,
+	0x77, 0x57, 0x37, 0x7d, 0xef, 0xfa, 0xa6, 0xef, 0xfd, 0xbd, 0xe9, 0x7b, 0x3f, 0x6f, 0xfb, 0x8d,
+	0xeb, 0xdb, 0x7e, 0xe3, 0xf7, 0x6d, 0xbf, 0xf1, 0x6d, 0xa7, 0xfe, 0x22, 0x7e, 0x9f, 0x7a, 0x1f,
+	0xf1, 0x32, 0x03, 0x73, 0xda, 0x76, 0x2f, 0xde, 0xdb, 0x7f, 0x03, 0x00, 0x1e, 0x05, 0xa4, 0xd3,
+	0x47, 0x05, 0x00, 0x00,
 }
 
 func (m *Chain) Marshal() (dAtA []byte, err error) {
@@ -189,6 +428,170 @@ func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Flapping {
+		i--
+		if m.Flapping {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.StatusTransitions) > 0 {
+		dAtA2 := make([]byte, len(m.StatusTransitions)*10)
+		var j1 int
+		for _, num := range m.StatusTransitions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintChain(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.ConsecutiveUpdates != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.ConsecutiveUpdates))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.LastHeartbeatReason != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.LastHeartbeatReason))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.AtRisk {
+		i--
+		if m.AtRisk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ValidatorCount != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.ValidatorCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.SignedVotingPower != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.SignedVotingPower))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintChain(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.Resets) > 0 {
+		for iNdEx := len(m.Resets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.ClockSkew != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.ClockSkew))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.EvidenceSource != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.EvidenceSource))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Passive {
+		i--
+		if m.Passive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Diagnosis != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.Diagnosis))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ClientUpdateRegistryHeight != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.ClientUpdateRegistryHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ClientLatestHeight != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.ClientLatestHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.LastRelayer) > 0 {
+		i -= len(m.LastRelayer)
+		copy(dAtA[i:], m.LastRelayer)
+		i = encodeVarintChain(dAtA, i, uint64(len(m.LastRelayer)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.RegistryBlockHeight != 0 {
 		i = encodeVarintChain(dAtA, i, uint64(m.RegistryBlockHeight))
 		i--
@@ -250,6 +653,51 @@ func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainReset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainReset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegistryBlockHeight != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.RegistryBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Block != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintChain(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintChain(dAtA []byte, offset int, v uint64) int {
 	offset -= sovChain(v)
 	base := offset
@@ -301,11 +749,98 @@ func (m *Chain) Size() (n int) {
 	if m.RegistryBlockHeight != 0 {
 		n += 1 + sovChain(uint64(m.RegistryBlockHeight))
 	}
+	l = len(m.LastRelayer)
+	if l > 0 {
+		n += 1 + l + sovChain(uint64(l))
+	}
+	if m.ClientLatestHeight != 0 {
+		n += 1 + sovChain(uint64(m.ClientLatestHeight))
+	}
+	if m.ClientUpdateRegistryHeight != 0 {
+		n += 1 + sovChain(uint64(m.ClientUpdateRegistryHeight))
+	}
+	if m.Diagnosis != 0 {
+		n += 1 + sovChain(uint64(m.Diagnosis))
+	}
+	if m.Passive {
+		n += 2
+	}
+	if m.EvidenceSource != 0 {
+		n += 2 + sovChain(uint64(m.EvidenceSource))
+	}
+	if m.Verified {
+		n += 3
+	}
+	if m.ClockSkew != 0 {
+		n += 2 + sovChain(uint64(m.ClockSkew))
+	}
+	if len(m.Resets) > 0 {
+		for _, e := range m.Resets {
+			l = e.Size()
+			n += 2 + l + sovChain(uint64(l))
+		}
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 2 + l + sovChain(uint64(l))
+		}
+	}
+	if m.SignedVotingPower != 0 {
+		n += 2 + sovChain(uint64(m.SignedVotingPower))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 2 + sovChain(uint64(m.TotalVotingPower))
+	}
+	if m.ValidatorCount != 0 {
+		n += 2 + sovChain(uint64(m.ValidatorCount))
+	}
+	if m.AtRisk {
+		n += 3
+	}
+	if m.LastHeartbeatReason != 0 {
+		n += 2 + sovChain(uint64(m.LastHeartbeatReason))
+	}
+	if m.ConsecutiveUpdates != 0 {
+		n += 2 + sovChain(uint64(m.ConsecutiveUpdates))
+	}
+	if len(m.StatusTransitions) > 0 {
+		l = 0
+		for _, e := range m.StatusTransitions {
+			l += sovChain(uint64(e))
+		}
+		n += 2 + sovChain(uint64(l)) + l
+	}
+	if m.Flapping {
+		n += 3
+	}
 	return n
 }
 
-func sovChain(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ChainReset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovChain(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovChain(uint64(m.Block))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovChain(uint64(m.Timestamp))
+	}
+	if m.RegistryBlockHeight != 0 {
+		n += 1 + sovChain(uint64(m.RegistryBlockHeight))
+	}
+	return n
+}
+
+func sovChain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozChain(x uint64) (n int) {
 	return sovChain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -581,6 +1116,589 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRelayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastRelayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatestHeight", wireType)
+			}
+			m.ClientLatestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientLatestHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUpdateRegistryHeight", wireType)
+			}
+			m.ClientUpdateRegistryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientUpdateRegistryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diagnosis", wireType)
+			}
+			m.Diagnosis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Diagnosis |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passive = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceSource", wireType)
+			}
+			m.EvidenceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceSource |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockSkew", wireType)
+			}
+			m.ClockSkew = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClockSkew |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resets = append(m.Resets, ChainReset{})
+			if err := m.Resets[len(m.Resets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedVotingPower", wireType)
+			}
+			m.SignedVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+			}
+			m.ValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtRisk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AtRisk = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatReason", wireType)
+			}
+			m.LastHeartbeatReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeartbeatReason |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveUpdates", wireType)
+			}
+			m.ConsecutiveUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.StatusTransitions = append(m.StatusTransitions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChain
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.StatusTransitions) == 0 {
+					m.StatusTransitions = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.StatusTransitions = append(m.StatusTransitions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusTransitions", wireType)
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flapping", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Flapping = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainReset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainReset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainReset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryBlockHeight", wireType)
+			}
+			m.RegistryBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistryBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChain(dAtA[iNdEx:])
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"strings"
+
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+)
+
+// ParseChainIdentity splits the chain ID into the name identifying the chain across revisions and
+// the revision number. Chain IDs that aren't in the `{name}-{revision}` format have revision 0.
+func ParseChainIdentity(chainID string) (string, uint64) {
+	if !clienttypes.IsRevisionFormat(chainID) {
+		return chainID, 0
+	}
+
+	return chainID[:strings.LastIndex(chainID, "-")], clienttypes.ParseChainID(chainID)
+}
+
+// IsSameChain returns true if both chain IDs identify the same chain, regardless of their revisions
+func IsSameChain(chainID, otherChainID string) bool {
+	name, _ := ParseChainIdentity(chainID)
+	otherName, _ := ParseChainIdentity(otherChainID)
+
+	return name == otherName
+}
@@ -11,6 +11,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateChain{}, "healthcheck/CreateChain", nil)
 	cdc.RegisterConcrete(&MsgUpdateChain{}, "healthcheck/UpdateChain", nil)
 	cdc.RegisterConcrete(&MsgDeleteChain{}, "healthcheck/DeleteChain", nil)
+	cdc.RegisterConcrete(&MsgResetChain{}, "healthcheck/ResetChain", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -19,6 +20,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateChain{},
 		&MsgUpdateChain{},
 		&MsgDeleteChain{},
+		&MsgResetChain{},
 	)
 	// this line is used by starport scaffolding # 3
 
@@ -0,0 +1,571 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcheck/healthcheck/decentralization.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DecentralizationSample holds the decentralization metrics reported by a monitored chain in a healthcheck update
+type DecentralizationSample struct {
+	ChainId             string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	RegistryBlockHeight uint64 `protobuf:"varint,2,opt,name=registryBlockHeight,proto3" json:"registryBlockHeight,omitempty"`
+	Block               uint64 `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	Timestamp           uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ValidatorCount      uint64 `protobuf:"varint,5,opt,name=validatorCount,proto3" json:"validatorCount,omitempty"`
+	NakamotoCoefficient uint64 `protobuf:"varint,6,opt,name=nakamotoCoefficient,proto3" json:"nakamotoCoefficient,omitempty"`
+	TopN                uint64 `protobuf:"varint,7,opt,name=topN,proto3" json:"topN,omitempty"`
+	TopNConcentration   uint64 `protobuf:"varint,8,opt,name=topNConcentration,proto3" json:"topNConcentration,omitempty"`
+}
+
+func (m *DecentralizationSample) Reset()         { *m = DecentralizationSample{} }
+func (m *DecentralizationSample) String() string { return proto.CompactTextString(m) }
+func (*DecentralizationSample) ProtoMessage()    {}
+func (*DecentralizationSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_995f37a7e82ce2bf, []int{0}
+}
+func (m *DecentralizationSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecentralizationSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecentralizationSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecentralizationSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecentralizationSample.Merge(m, src)
+}
+func (m *DecentralizationSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecentralizationSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecentralizationSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecentralizationSample proto.InternalMessageInfo
+
+func (m *DecentralizationSample) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *DecentralizationSample) GetRegistryBlockHeight() uint64 {
+	if m != nil {
+		return m.RegistryBlockHeight
+	}
+	return 0
+}
+
+func (m *DecentralizationSample) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *DecentralizationSample) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *DecentralizationSample) GetValidatorCount() uint64 {
+	if m != nil {
+		return m.ValidatorCount
+	}
+	return 0
+}
+
+func (m *DecentralizationSample) GetNakamotoCoefficient() uint64 {
+	if m != nil {
+		return m.NakamotoCoefficient
+	}
+	return 0
+}
+
+func (m *DecentralizationSample) GetTopN() uint64 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+func (m *DecentralizationSample) GetTopNConcentration() uint64 {
+	if m != nil {
+		return m.TopNConcentration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DecentralizationSample)(nil), "healthcheck.healthcheck.DecentralizationSample")
+}
+
+func init() {
+	proto.RegisterFile("healthcheck/healthcheck/decentralization.proto", fileDescriptor_995f37a7e82ce2bf)
+}
+
+var fileDescriptor_995f37a7e82ce2bf = []byte{
+	// 289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0xe3, 0x92, 0xb6, 0xd4, 0x03, 0x12, 0x06, 0x81, 0x07, 0x64, 0x2a, 0x06, 0xd4, 0x01,
+	0x15, 0x24, 0x26, 0xd6, 0x86, 0x01, 0x16, 0x86, 0xb2, 0xb1, 0xb9, 0x8e, 0xdb, 0x58, 0x49, 0x7c,
+	0x51, 0x72, 0x20, 0xca, 0x53, 0xf0, 0x30, 0x3c, 0x04, 0x63, 0x47, 0x46, 0x94, 0xbc, 0x08, 0x8a,
+	0x23, 0x44, 0x68, 0x3b, 0xdd, 0xfd, 0xf7, 0x7f, 0x27, 0x9d, 0xee, 0xa7, 0xe3, 0x48, 0xcb, 0x04,
+	0x23, 0x15, 0x69, 0x15, 0x5f, 0xb6, 0xfb, 0x50, 0x2b, 0x6d, 0x31, 0x97, 0x89, 0x79, 0x93, 0x68,
+	0xc0, 0x8e, 0xb3, 0x1c, 0x10, 0xd8, 0x71, 0x8b, 0x69, 0xef, 0x9e, 0x7d, 0x74, 0xe8, 0xd1, 0xed,
+	0xda, 0xce, 0xa3, 0x4c, 0xb3, 0x44, 0x33, 0x4e, 0xfb, 0x2a, 0x92, 0xc6, 0xde, 0x87, 0x9c, 0x0c,
+	0xc9, 0x68, 0x30, 0xfd, 0x95, 0xec, 0x8a, 0x1e, 0xe4, 0x7a, 0x61, 0x0a, 0xcc, 0x97, 0x93, 0x04,
+	0x54, 0x7c, 0xa7, 0xcd, 0x22, 0x42, 0xde, 0x19, 0x92, 0x91, 0x3f, 0xdd, 0x66, 0xb1, 0x43, 0xda,
+	0x9d, 0xd5, 0x92, 0xef, 0x38, 0xa6, 0x11, 0xec, 0x84, 0x0e, 0xd0, 0xa4, 0xba, 0x40, 0x99, 0x66,
+	0xdc, 0x77, 0xce, 0xdf, 0x80, 0x9d, 0xd3, 0xbd, 0x17, 0x99, 0x98, 0x50, 0x22, 0xe4, 0x01, 0x3c,
+	0x5b, 0xe4, 0x5d, 0x87, 0xac, 0x4d, 0xeb, 0x6b, 0xac, 0x8c, 0x65, 0x0a, 0x08, 0x01, 0xe8, 0xf9,
+	0xdc, 0x28, 0xa3, 0x2d, 0xf2, 0x5e, 0x73, 0xcd, 0x16, 0x8b, 0x31, 0xea, 0x23, 0x64, 0x0f, 0xbc,
+	0xef, 0x10, 0xd7, 0xb3, 0x0b, 0xba, 0x5f, 0xd7, 0x00, 0x6c, 0xf3, 0x8c, 0xfa, 0x11, 0x7c, 0xd7,
+	0x01, 0x9b, 0xc6, 0xe4, 0xe6, 0xb3, 0x14, 0x64, 0x55, 0x0a, 0xf2, 0x5d, 0x0a, 0xf2, 0x5e, 0x09,
+	0x6f, 0x55, 0x09, 0xef, 0xab, 0x12, 0xde, 0xd3, 0x69, 0x3b, 0x8d, 0xd7, 0x7f, 0xd9, 0xe0, 0x32,
+	0xd3, 0xc5, 0xac, 0xe7, 0x12, 0xb9, 0xfe, 0x19, 0x00, 0x3a, 0xd4, 0x46, 0x07, 0xc3, 0x01, 0x00,
+	0x00,
+}
+
+func (m *DecentralizationSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecentralizationSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecentralizationSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopNConcentration != 0 {
+		i = encodeVarintDecentralization(dAtA, i, uint64(m.TopNConcentration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TopN != 0 {
+		i = encodeVarintDecentralization(dAtA, i, uint64(m.TopN))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NakamotoCoefficient != 0 {
+		i = encodeVarintDecentralization(dAtA, i, uint64(m.NakamotoCoefficient))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValidatorCount != 0 {
+		i = encodeVarintDecentralization(dAtA, i, uint64(m.ValidatorCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintDecentralization(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Block != 0 {
+		i = encodeVarintDecentralization(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RegistryBlockHeight != 0 {
+		i = encodeVarintDecentralization(dAtA, i, uint64(m.RegistryBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintDecentralization(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDecentralization(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDecentralization(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DecentralizationSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovDecentralization(uint64(l))
+	}
+	if m.RegistryBlockHeight != 0 {
+		n += 1 + sovDecentralization(uint64(m.RegistryBlockHeight))
+	}
+	if m.Block != 0 {
+		n += 1 + sovDecentralization(uint64(m.Block))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovDecentralization(uint64(m.Timestamp))
+	}
+	if m.ValidatorCount != 0 {
+		n += 1 + sovDecentralization(uint64(m.ValidatorCount))
+	}
+	if m.NakamotoCoefficient != 0 {
+		n += 1 + sovDecentralization(uint64(m.NakamotoCoefficient))
+	}
+	if m.TopN != 0 {
+		n += 1 + sovDecentralization(uint64(m.TopN))
+	}
+	if m.TopNConcentration != 0 {
+		n += 1 + sovDecentralization(uint64(m.TopNConcentration))
+	}
+	return n
+}
+
+func sovDecentralization(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDecentralization(x uint64) (n int) {
+	return sovDecentralization(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DecentralizationSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDecentralization
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecentralizationSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecentralizationSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecentralization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDecentralization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDecentralization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryBlockHeight", wireType)
+			}
+			m.RegistryBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecentralization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistryBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecentralization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecentralization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+			}
+			m.ValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecentralization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NakamotoCoefficient", wireType)
+			}
+			m.NakamotoCoefficient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecentralization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NakamotoCoefficient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
+			}
+			m.TopN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecentralization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopN |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopNConcentration", wireType)
+			}
+			m.TopNConcentration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecentralization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopNConcentration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDecentralization(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDecentralization
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDecentralization(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDecentralization
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDecentralization
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDecentralization
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDecentralization
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDecentralization
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDecentralization
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDecentralization        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDecentralization          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDecentralization = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,22 @@
+package types
+
+// DiagnoseChain explains why the monitored chain is inactive. A chain whose light client
+// was updated after the last received healthcheck update is producing blocks, so the
+// healthcheck packets are not being relayed. Otherwise, there is no evidence that the chain
+// produces blocks at all.
+func DiagnoseChain(monitoredChain Chain) MonitoredChainDiagnosis {
+	// chain is live or it was never tracked through a healthcheck channel
+	if MonitoredChainStatus(monitoredChain.Status).IsLive() || monitoredChain.UpdateInterval == 0 {
+		return NoDiagnosis
+	}
+
+	if monitoredChain.ConsecutiveUpdates > 0 {
+		return Recovering
+	}
+
+	if monitoredChain.ClientUpdateRegistryHeight > monitoredChain.RegistryBlockHeight {
+		return PacketsNotRelayed
+	}
+
+	return ChainHalted
+}
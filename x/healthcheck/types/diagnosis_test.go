@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnoseChain(t *testing.T) {
+	tests := []struct {
+		name      string
+		chain     Chain
+		diagnosis MonitoredChainDiagnosis
+	}{
+		{
+			name: "active chain",
+			chain: Chain{
+				Status:                     uint64(Active),
+				UpdateInterval:             10,
+				RegistryBlockHeight:        5,
+				ClientUpdateRegistryHeight: 8,
+			},
+			diagnosis: NoDiagnosis,
+		}, {
+			name: "never tracked chain",
+			chain: Chain{
+				Status: uint64(Inactive),
+			},
+			diagnosis: NoDiagnosis,
+		}, {
+			name: "client updated after last healthcheck update",
+			chain: Chain{
+				Status:                     uint64(Inactive),
+				UpdateInterval:             10,
+				RegistryBlockHeight:        5,
+				ClientUpdateRegistryHeight: 8,
+			},
+			diagnosis: PacketsNotRelayed,
+		}, {
+			name: "client not updated after last healthcheck update",
+			chain: Chain{
+				Status:                     uint64(Inactive),
+				UpdateInterval:             10,
+				RegistryBlockHeight:        5,
+				ClientUpdateRegistryHeight: 5,
+			},
+			diagnosis: ChainHalted,
+		}, {
+			name: "degraded chain",
+			chain: Chain{
+				Status:         uint64(Degraded),
+				UpdateInterval: 10,
+			},
+			diagnosis: NoDiagnosis,
+		}, {
+			name: "inactive chain delivering updates again",
+			chain: Chain{
+				Status:                     uint64(Inactive),
+				UpdateInterval:             10,
+				RegistryBlockHeight:        5,
+				ClientUpdateRegistryHeight: 5,
+				ConsecutiveUpdates:         1,
+			},
+			diagnosis: Recovering,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.diagnosis, DiagnoseChain(tt.chain))
+		})
+	}
+}
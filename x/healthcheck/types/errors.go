@@ -17,4 +17,9 @@ var (
 	ErrChainNotRegistered       = sdkerrors.Register(ModuleName, 1505, "chain is not registered")
 	ErrUnexpectedConnectionID   = sdkerrors.Register(ModuleName, 1506, "unexpected connection ID")
 	ErrChainAlreadyTracked      = sdkerrors.Register(ModuleName, 1507, "chain is already tracked through another channel")
+	ErrPassiveChain             = sdkerrors.Register(ModuleName, 1508, "chain is monitored passively through its light client")
+	ErrClockDrift               = sdkerrors.Register(ModuleName, 1509, "healthcheck update timestamp exceeds the allowed clock drift")
+	ErrChainResetRequired       = sdkerrors.Register(ModuleName, 1510, "chain reset has to be acknowledged")
+	ErrInvalidChainReset        = sdkerrors.Register(ModuleName, 1511, "invalid chain reset")
+	ErrInvalidIntervals         = sdkerrors.Register(ModuleName, 1512, "invalid healthcheck intervals")
 )
@@ -0,0 +1,35 @@
+package types
+
+// healthcheck module event types
+const (
+	EventTypeTimestampMismatch = "healthcheck_timestamp_mismatch"
+	EventTypeChainReset        = "healthcheck_chain_reset"
+	EventTypeIntervalChange    = "healthcheck_interval_change"
+	EventTypeChainAtRisk       = "healthcheck_chain_at_risk"
+	EventTypeHeartbeat         = "healthcheck_heartbeat"
+	EventTypeProbeSent         = "healthcheck_probe_sent"
+	EventTypeProbeAnswered     = "healthcheck_probe_answered"
+	EventTypeProbeFailed       = "healthcheck_probe_failed"
+	EventTypeChainDegraded     = "healthcheck_chain_degraded"
+	EventTypeChainFlapping     = "healthcheck_chain_flapping"
+	EventTypeUpdateRejected    = "healthcheck_update_rejected"
+
+	AttributeKeyChainID            = "chain_id"
+	AttributeKeyBlock              = "block"
+	AttributeKeyReportedTimestamp  = "reported_timestamp"
+	AttributeKeyConsensusTimestamp = "consensus_timestamp"
+	AttributeKeyPreviousChainID    = "previous_chain_id"
+	AttributeKeyUpdateInterval     = "update_interval"
+	AttributeKeyTimeoutInterval    = "timeout_interval"
+	AttributeKeySignedVotingPower  = "signed_voting_power"
+	AttributeKeyTotalVotingPower   = "total_voting_power"
+	AttributeKeyReason             = "reason"
+	AttributeKeyNonce              = "nonce"
+	AttributeKeyRttBlocks          = "rtt_blocks"
+	AttributeKeyRttSeconds         = "rtt_seconds"
+	AttributeKeyLatencyP95         = "latency_p95"
+	AttributeKeyFlapping           = "flapping"
+	AttributeKeyTransitions        = "transitions"
+	AttributeKeyRelayer            = "relayer"
+	AttributeKeyClockSkew          = "clock_skew"
+)
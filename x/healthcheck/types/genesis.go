@@ -11,8 +11,16 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		PortId:    PortID,
-		ChainList: []Chain{},
+		PortId:                     PortID,
+		ChainList:                  []Chain{},
+		RelayerStatsList:           []RelayerStats{},
+		ChainRelayerList:           []ChainRelayer{},
+		DecentralizationSampleList: []DecentralizationSample{},
+		ChainIndicatorList:         []ChainIndicator{},
+		ChainConnectivityList:      []ChainConnectivity{},
+		HeartbeatList:              []Heartbeat{},
+		ProbeList:                  []Probe{},
+		RelayLatencyList:           []RelayLatency{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -34,6 +42,86 @@ func (gs GenesisState) Validate() error {
 		}
 		chainIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in relayerStats
+	relayerStatsIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RelayerStatsList {
+		index := string(RelayerStatsKey(elem.Relayer))
+		if _, ok := relayerStatsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for relayerStats")
+		}
+		relayerStatsIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in chainRelayer
+	chainRelayerIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ChainRelayerList {
+		index := string(ChainRelayerKey(elem.ChainId, elem.Relayer))
+		if _, ok := chainRelayerIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for chainRelayer")
+		}
+		chainRelayerIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in decentralizationSample
+	decentralizationSampleIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DecentralizationSampleList {
+		index := string(DecentralizationSampleKey(elem.ChainId, elem.RegistryBlockHeight))
+		if _, ok := decentralizationSampleIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for decentralizationSample")
+		}
+		decentralizationSampleIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in chainIndicator
+	chainIndicatorIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ChainIndicatorList {
+		index := string(ChainIndicatorKey(elem.ChainId, elem.Indicator.Reporter, elem.Indicator.Key))
+		if _, ok := chainIndicatorIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for chainIndicator")
+		}
+		chainIndicatorIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in chainConnectivity
+	chainConnectivityIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ChainConnectivityList {
+		index := string(ChainConnectivityKey(elem.ChainId))
+		if _, ok := chainConnectivityIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for chainConnectivity")
+		}
+		chainConnectivityIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in heartbeat
+	heartbeatIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.HeartbeatList {
+		index := string(HeartbeatKey(elem.ChainId, elem.RegistryBlockHeight))
+		if _, ok := heartbeatIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for heartbeat")
+		}
+		heartbeatIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in probe
+	probeIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ProbeList {
+		index := string(ProbeKey(elem.ChainId))
+		if _, ok := probeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for probe")
+		}
+		probeIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in relayLatency
+	relayLatencyIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RelayLatencyList {
+		index := string(RelayLatencyKey(elem.ChainId))
+		if _, ok := relayLatencyIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for relayLatency")
+		}
+		relayLatencyIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
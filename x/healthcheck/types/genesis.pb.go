@@ -25,9 +25,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the healthcheck module's genesis state.
 type GenesisState struct {
-	Params    Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PortId    string  `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	ChainList []Chain `protobuf:"bytes,3,rep,name=chainList,proto3" json:"chainList"`
+	Params                     Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PortId                     string                   `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChainList                  []Chain                  `protobuf:"bytes,3,rep,name=chainList,proto3" json:"chainList"`
+	RelayerStatsList           []RelayerStats           `protobuf:"bytes,4,rep,name=relayerStatsList,proto3" json:"relayerStatsList"`
+	ChainRelayerList           []ChainRelayer           `protobuf:"bytes,5,rep,name=chainRelayerList,proto3" json:"chainRelayerList"`
+	DecentralizationSampleList []DecentralizationSample `protobuf:"bytes,6,rep,name=decentralizationSampleList,proto3" json:"decentralizationSampleList"`
+	ChainIndicatorList         []ChainIndicator         `protobuf:"bytes,7,rep,name=chainIndicatorList,proto3" json:"chainIndicatorList"`
+	ChainConnectivityList      []ChainConnectivity      `protobuf:"bytes,8,rep,name=chainConnectivityList,proto3" json:"chainConnectivityList"`
+	HeartbeatList              []Heartbeat              `protobuf:"bytes,9,rep,name=heartbeatList,proto3" json:"heartbeatList"`
+	ProbeList                  []Probe                  `protobuf:"bytes,10,rep,name=probeList,proto3" json:"probeList"`
+	RelayLatencyList           []RelayLatency           `protobuf:"bytes,11,rep,name=relayLatencyList,proto3" json:"relayLatencyList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +92,62 @@ func (m *GenesisState) GetChainList() []Chain {
 	return nil
 }
 
+func (m *GenesisState) GetRelayerStatsList() []RelayerStats {
+	if m != nil {
+		return m.RelayerStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChainRelayerList() []ChainRelayer {
+	if m != nil {
+		return m.ChainRelayerList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDecentralizationSampleList() []DecentralizationSample {
+	if m != nil {
+		return m.DecentralizationSampleList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChainIndicatorList() []ChainIndicator {
+	if m != nil {
+		return m.ChainIndicatorList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChainConnectivityList() []ChainConnectivity {
+	if m != nil {
+		return m.ChainConnectivityList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHeartbeatList() []Heartbeat {
+	if m != nil {
+		return m.HeartbeatList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProbeList() []Probe {
+	if m != nil {
+		return m.ProbeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRelayLatencyList() []RelayLatency {
+	if m != nil {
+		return m.RelayLatencyList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "healthcheck.healthcheck.GenesisState")
 }
@@ -93,22 +157,38 @@ func init() {
 }
 
 var fileDescriptor_dbd06504584ec9d6 = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x48, 0x4d, 0xcc,
-	0x29, 0xc9, 0x48, 0xce, 0x48, 0x4d, 0xce, 0xd6, 0x47, 0x66, 0xa7, 0xa7, 0xe6, 0xa5, 0x16, 0x67,
-	0x16, 0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x23, 0x49, 0xe9, 0x21, 0xb1, 0xa5, 0x44,
-	0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x6a, 0xf4, 0x41, 0x2c, 0x88, 0x72, 0x29, 0x15, 0x5c, 0xa6, 0x16,
-	0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x95, 0x52, 0xc6, 0xa5, 0x2a, 0x39, 0x23, 0x31, 0x33, 0x0f,
-	0xa2, 0x48, 0x69, 0x15, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0x2d, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42,
-	0xb6, 0x5c, 0x6c, 0x10, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0xb8,
-	0x4d, 0x2f, 0x00, 0xac, 0xcc, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x26, 0x21, 0x71,
-	0x2e, 0xf6, 0x82, 0xfc, 0xa2, 0x92, 0xf8, 0xcc, 0x14, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xce, 0x20,
-	0x36, 0x10, 0xd7, 0x33, 0x45, 0xc8, 0x89, 0x8b, 0x13, 0x6c, 0xaf, 0x4f, 0x66, 0x71, 0x89, 0x04,
-	0xb3, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x1c, 0x4e, 0xa3, 0x9d, 0x41, 0x2a, 0xa1, 0x26, 0x23, 0xb4,
-	0x39, 0x59, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x3c, 0xb2, 0xff,
-	0x2a, 0x50, 0x7c, 0x5b, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0xae, 0x31, 0x20, 0x00,
-	0x00, 0xff, 0xff, 0x35, 0x32, 0x23, 0xe9, 0x91, 0x01, 0x00, 0x00,
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x8b, 0xd3, 0x40,
+	0x1c, 0xc5, 0x1b, 0x77, 0x6d, 0xed, 0x54, 0x41, 0x06, 0x65, 0x4b, 0x0e, 0x69, 0x59, 0x5d, 0xb7,
+	0x78, 0x48, 0x61, 0x3d, 0x79, 0xf0, 0xd2, 0x0a, 0xba, 0xb0, 0xc8, 0xd2, 0x3d, 0x08, 0x82, 0xc8,
+	0x34, 0xf9, 0x9b, 0x0c, 0x66, 0x33, 0x61, 0x32, 0x8a, 0xf1, 0x0b, 0x78, 0xf5, 0x63, 0xed, 0x71,
+	0x8f, 0x9e, 0x44, 0xda, 0x2f, 0x22, 0xf9, 0x67, 0x12, 0xa7, 0x6b, 0xa7, 0xf1, 0x36, 0x6d, 0xde,
+	0xfb, 0xbd, 0x99, 0xcc, 0xfb, 0x87, 0x1c, 0xc5, 0xc0, 0x12, 0x15, 0x07, 0x31, 0x04, 0x9f, 0xa6,
+	0xe6, 0x3a, 0x82, 0x14, 0x72, 0x9e, 0xfb, 0x99, 0x14, 0x4a, 0xd0, 0x03, 0xe3, 0x91, 0x6f, 0xac,
+	0xdd, 0x07, 0x91, 0x88, 0x04, 0x6a, 0xa6, 0xe5, 0xaa, 0x92, 0xbb, 0x8f, 0x6d, 0xd4, 0x8c, 0x49,
+	0x76, 0xa9, 0xa1, 0xee, 0x23, 0x9b, 0x2a, 0x88, 0x19, 0x4f, 0xb5, 0xc8, 0xba, 0x41, 0x09, 0x09,
+	0x2b, 0x40, 0x6a, 0x99, 0x6f, 0x93, 0x85, 0x10, 0x40, 0xaa, 0x24, 0x4b, 0xf8, 0x37, 0xa6, 0xb8,
+	0xa8, 0xb1, 0xc7, 0x36, 0x3d, 0x4f, 0x43, 0x1e, 0x30, 0x25, 0x6a, 0xf0, 0x13, 0x9b, 0x50, 0x89,
+	0x4c, 0x24, 0x22, 0x2a, 0xda, 0x80, 0x31, 0x30, 0xa9, 0x96, 0xc0, 0x54, 0xdb, 0xa9, 0x33, 0x29,
+	0x96, 0xd0, 0x76, 0xea, 0x84, 0x29, 0x48, 0x03, 0x1d, 0x7a, 0xf8, 0xbd, 0x47, 0xee, 0xbe, 0xaa,
+	0x2e, 0xea, 0x42, 0x31, 0x05, 0xf4, 0x05, 0xe9, 0x56, 0xaf, 0x78, 0xe8, 0x8c, 0x9d, 0xc9, 0xe0,
+	0x64, 0xe4, 0x5b, 0x2e, 0xce, 0x3f, 0x47, 0xd9, 0x6c, 0xff, 0xea, 0xd7, 0xa8, 0xb3, 0xd0, 0x26,
+	0x7a, 0x40, 0x7a, 0x99, 0x90, 0xea, 0x03, 0x0f, 0x87, 0xb7, 0xc6, 0xce, 0xa4, 0xbf, 0xe8, 0x96,
+	0x3f, 0x4f, 0x43, 0x3a, 0x23, 0x7d, 0xbc, 0x94, 0x33, 0x9e, 0xab, 0xe1, 0xde, 0x78, 0x6f, 0x32,
+	0x38, 0xf1, 0xac, 0xe8, 0x79, 0xa9, 0xd4, 0xe4, 0xbf, 0x36, 0xfa, 0x96, 0xdc, 0xd7, 0x77, 0x56,
+	0xee, 0x35, 0x47, 0xd4, 0x3e, 0xa2, 0x8e, 0xac, 0xa8, 0x85, 0x61, 0xd0, 0xc4, 0x7f, 0x20, 0x25,
+	0x18, 0x53, 0xb4, 0x18, 0xc1, 0xb7, 0x5b, 0xc0, 0x73, 0xc3, 0x50, 0x83, 0x6f, 0x42, 0xe8, 0x67,
+	0xe2, 0xde, 0xac, 0xcf, 0x05, 0xbb, 0xcc, 0x12, 0xc0, 0x88, 0x2e, 0x46, 0x4c, 0xad, 0x11, 0x2f,
+	0xb7, 0x5a, 0x75, 0xd8, 0x0e, 0x30, 0x7d, 0x4f, 0x28, 0x6e, 0xe5, 0xb4, 0xae, 0x22, 0xc6, 0xf5,
+	0x30, 0xee, 0x78, 0xf7, 0x89, 0x1a, 0x8b, 0x8e, 0xd9, 0x02, 0xa2, 0x1f, 0xc9, 0x43, 0xfc, 0x77,
+	0x2e, 0xd2, 0x14, 0x02, 0xc5, 0xbf, 0x70, 0x55, 0x60, 0xc2, 0x1d, 0x4c, 0x78, 0xba, 0x3b, 0xc1,
+	0x74, 0xe9, 0x90, 0xed, 0x38, 0xfa, 0x86, 0xdc, 0x6b, 0xba, 0x8f, 0xfc, 0x3e, 0xf2, 0x0f, 0xad,
+	0xfc, 0xd7, 0xb5, 0x5a, 0x73, 0x37, 0xed, 0x65, 0x07, 0x71, 0x44, 0x90, 0x45, 0x5a, 0x3a, 0x78,
+	0x5e, 0x2a, 0xeb, 0x0e, 0x36, 0xb6, 0xa6, 0x83, 0x67, 0xd5, 0x18, 0x21, 0x6a, 0xf0, 0x3f, 0x1d,
+	0xd4, 0x86, 0x8d, 0x0e, 0x1a, 0x90, 0xd9, 0xf3, 0xab, 0x95, 0xe7, 0x5c, 0xaf, 0x3c, 0xe7, 0xf7,
+	0xca, 0x73, 0x7e, 0xac, 0xbd, 0xce, 0xf5, 0xda, 0xeb, 0xfc, 0x5c, 0x7b, 0x9d, 0x77, 0x23, 0x73,
+	0x7c, 0xbf, 0x6e, 0x7e, 0x42, 0x8a, 0x0c, 0xf2, 0x65, 0x17, 0x67, 0xf9, 0xd9, 0x9f, 0x01, 0x00,
+	0x91, 0x0f, 0x60, 0xc7, 0x8b, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +211,118 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RelayLatencyList) > 0 {
+		for iNdEx := len(m.RelayLatencyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayLatencyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ProbeList) > 0 {
+		for iNdEx := len(m.ProbeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProbeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.HeartbeatList) > 0 {
+		for iNdEx := len(m.HeartbeatList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeartbeatList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ChainConnectivityList) > 0 {
+		for iNdEx := len(m.ChainConnectivityList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainConnectivityList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChainIndicatorList) > 0 {
+		for iNdEx := len(m.ChainIndicatorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainIndicatorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DecentralizationSampleList) > 0 {
+		for iNdEx := len(m.DecentralizationSampleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecentralizationSampleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ChainRelayerList) > 0 {
+		for iNdEx := len(m.ChainRelayerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainRelayerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RelayerStatsList) > 0 {
+		for iNdEx := len(m.RelayerStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ChainList) > 0 {
 		for iNdEx := len(m.ChainList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -194,6 +386,54 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RelayerStatsList) > 0 {
+		for _, e := range m.RelayerStatsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainRelayerList) > 0 {
+		for _, e := range m.ChainRelayerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DecentralizationSampleList) > 0 {
+		for _, e := range m.DecentralizationSampleList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainIndicatorList) > 0 {
+		for _, e := range m.ChainIndicatorList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainConnectivityList) > 0 {
+		for _, e := range m.ChainConnectivityList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HeartbeatList) > 0 {
+		for _, e := range m.HeartbeatList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProbeList) > 0 {
+		for _, e := range m.ProbeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RelayLatencyList) > 0 {
+		for _, e := range m.RelayLatencyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -331,6 +571,278 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStatsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerStatsList = append(m.RelayerStatsList, RelayerStats{})
+			if err := m.RelayerStatsList[len(m.RelayerStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainRelayerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainRelayerList = append(m.ChainRelayerList, ChainRelayer{})
+			if err := m.ChainRelayerList[len(m.ChainRelayerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecentralizationSampleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecentralizationSampleList = append(m.DecentralizationSampleList, DecentralizationSample{})
+			if err := m.DecentralizationSampleList[len(m.DecentralizationSampleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIndicatorList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIndicatorList = append(m.ChainIndicatorList, ChainIndicator{})
+			if err := m.ChainIndicatorList[len(m.ChainIndicatorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConnectivityList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainConnectivityList = append(m.ChainConnectivityList, ChainConnectivity{})
+			if err := m.ChainConnectivityList[len(m.ChainConnectivityList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeartbeatList = append(m.HeartbeatList, Heartbeat{})
+			if err := m.HeartbeatList[len(m.HeartbeatList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProbeList = append(m.ProbeList, Probe{})
+			if err := m.ProbeList[len(m.ProbeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayLatencyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayLatencyList = append(m.RelayLatencyList, RelayLatency{})
+			if err := m.RelayLatencyList[len(m.RelayLatencyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
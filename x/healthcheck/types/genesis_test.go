@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"healthcheck/x/healthcheck/types"
+	commontypes "healthcheck/x/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
@@ -30,6 +31,78 @@ func TestGenesisState_Validate(t *testing.T) {
 						ChainId: "1",
 					},
 				},
+				RelayerStatsList: []types.RelayerStats{
+					{
+						Relayer: "0",
+					},
+					{
+						Relayer: "1",
+					},
+				},
+				ChainRelayerList: []types.ChainRelayer{
+					{
+						ChainId: "0",
+						Relayer: "0",
+					},
+					{
+						ChainId: "0",
+						Relayer: "1",
+					},
+				},
+				DecentralizationSampleList: []types.DecentralizationSample{
+					{
+						ChainId:             "0",
+						RegistryBlockHeight: 0,
+					},
+					{
+						ChainId:             "0",
+						RegistryBlockHeight: 1,
+					},
+				},
+				ChainIndicatorList: []types.ChainIndicator{
+					{
+						ChainId:   "0",
+						Indicator: commontypes.Indicator{Reporter: "0", Key: "0"},
+					},
+					{
+						ChainId:   "0",
+						Indicator: commontypes.Indicator{Reporter: "1", Key: "0"},
+					},
+				},
+				ChainConnectivityList: []types.ChainConnectivity{
+					{
+						ChainId: "0",
+					},
+					{
+						ChainId: "1",
+					},
+				},
+				HeartbeatList: []types.Heartbeat{
+					{
+						ChainId:             "0",
+						RegistryBlockHeight: 0,
+					},
+					{
+						ChainId:             "0",
+						RegistryBlockHeight: 1,
+					},
+				},
+				ProbeList: []types.Probe{
+					{
+						ChainId: "0",
+					},
+					{
+						ChainId: "1",
+					},
+				},
+				RelayLatencyList: []types.RelayLatency{
+					{
+						ChainId: "0",
+					},
+					{
+						ChainId: "1",
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -48,6 +121,136 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated relayerStats",
+			genState: &types.GenesisState{
+				RelayerStatsList: []types.RelayerStats{
+					{
+						Relayer: "0",
+					},
+					{
+						Relayer: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated chainRelayer",
+			genState: &types.GenesisState{
+				ChainRelayerList: []types.ChainRelayer{
+					{
+						ChainId: "0",
+						Relayer: "0",
+					},
+					{
+						ChainId: "0",
+						Relayer: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated decentralizationSample",
+			genState: &types.GenesisState{
+				DecentralizationSampleList: []types.DecentralizationSample{
+					{
+						ChainId:             "0",
+						RegistryBlockHeight: 1,
+					},
+					{
+						ChainId:             "0",
+						RegistryBlockHeight: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated chainIndicator",
+			genState: &types.GenesisState{
+				ChainIndicatorList: []types.ChainIndicator{
+					{
+						ChainId:   "0",
+						Indicator: commontypes.Indicator{Reporter: "0", Key: "0"},
+					},
+					{
+						ChainId:   "0",
+						Indicator: commontypes.Indicator{Reporter: "0", Key: "0"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated chainConnectivity",
+			genState: &types.GenesisState{
+				ChainConnectivityList: []types.ChainConnectivity{
+					{
+						ChainId: "0",
+					},
+					{
+						ChainId: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated heartbeat",
+			genState: &types.GenesisState{
+				HeartbeatList: []types.Heartbeat{
+					{
+						ChainId:             "0",
+						RegistryBlockHeight: 1,
+					},
+					{
+						ChainId:             "0",
+						RegistryBlockHeight: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated probe",
+			genState: &types.GenesisState{
+				ProbeList: []types.Probe{
+					{
+						ChainId: "0",
+					},
+					{
+						ChainId: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated relayLatency",
+			genState: &types.GenesisState{
+				RelayLatencyList: []types.RelayLatency{
+					{
+						ChainId: "0",
+					},
+					{
+						ChainId: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "max clock drift overflowing duration",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.Params{
+					MaxClockDrift: types.MaxDurationSeconds + 1,
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcheck/healthcheck/indicator.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "healthcheck/x/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChainIndicator is the latest value of a health indicator reported by a monitored chain
+type ChainIndicator struct {
+	ChainId             string          `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	RegistryBlockHeight uint64          `protobuf:"varint,2,opt,name=registryBlockHeight,proto3" json:"registryBlockHeight,omitempty"`
+	Block               uint64          `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	Indicator           types.Indicator `protobuf:"bytes,4,opt,name=indicator,proto3" json:"indicator"`
+}
+
+func (m *ChainIndicator) Reset()         { *m = ChainIndicator{} }
+func (m *ChainIndicator) String() string { return proto.CompactTextString(m) }
+func (*ChainIndicator) ProtoMessage()    {}
+func (*ChainIndicator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9cb4dc7501e4003, []int{0}
+}
+func (m *ChainIndicator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainIndicator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainIndicator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainIndicator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainIndicator.Merge(m, src)
+}
+func (m *ChainIndicator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainIndicator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainIndicator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainIndicator proto.InternalMessageInfo
+
+func (m *ChainIndicator) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainIndicator) GetRegistryBlockHeight() uint64 {
+	if m != nil {
+		return m.RegistryBlockHeight
+	}
+	return 0
+}
+
+func (m *ChainIndicator) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *ChainIndicator) GetIndicator() types.Indicator {
+	if m != nil {
+		return m.Indicator
+	}
+	return types.Indicator{}
+}
+
+func init() {
+	proto.RegisterType((*ChainIndicator)(nil), "healthcheck.healthcheck.ChainIndicator")
+}
+
+func init() {
+	proto.RegisterFile("healthcheck/healthcheck/indicator.proto", fileDescriptor_a9cb4dc7501e4003)
+}
+
+var fileDescriptor_a9cb4dc7501e4003 = []byte{
+	// 238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcf, 0x48, 0x4d, 0xcc,
+	0x29, 0xc9, 0x48, 0xce, 0x48, 0x4d, 0xce, 0xd6, 0x47, 0x66, 0x67, 0xe6, 0xa5, 0x64, 0x26, 0x27,
+	0x96, 0xe4, 0x17, 0xe9, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x23, 0x49, 0xea, 0x21, 0xb1,
+	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x6a, 0xf4, 0x41, 0x2c, 0x88, 0x72, 0x29, 0x39, 0x64,
+	0xb3, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0xf5, 0x0b, 0x12, 0x93, 0xb3, 0x53, 0x4b, 0x20, 0xf2, 0x4a,
+	0x5b, 0x18, 0xb9, 0xf8, 0x9c, 0x33, 0x12, 0x33, 0xf3, 0x3c, 0x61, 0xf6, 0x08, 0x49, 0x70, 0xb1,
+	0x27, 0x83, 0x45, 0x52, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x60, 0x5c, 0x21, 0x03, 0x2e,
+	0xe1, 0xa2, 0xd4, 0xf4, 0xcc, 0xe2, 0x92, 0xa2, 0x4a, 0xa7, 0x9c, 0xfc, 0xe4, 0x6c, 0x8f, 0xd4,
+	0xcc, 0xf4, 0x8c, 0x12, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x6c, 0x52, 0x42, 0x22, 0x5c,
+	0xac, 0x49, 0x20, 0xae, 0x04, 0x33, 0x58, 0x0d, 0x84, 0x23, 0xe4, 0xc0, 0xc5, 0x09, 0xf7, 0x96,
+	0x04, 0x8b, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x0c, 0xb2, 0x5f, 0xf4, 0xc0, 0x0e, 0xd5, 0x83, 0x3b,
+	0xc9, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0x84, 0x26, 0x27, 0xcb, 0x13, 0x8f, 0xe4, 0x18,
+	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
+	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x92, 0x47, 0xf6, 0x70, 0x85, 0x3e, 0x86, 0xf7, 0x93, 0xd8,
+	0xc0, 0x1e, 0x37, 0x06, 0x0c, 0x00, 0xde, 0x34, 0xf5, 0xb0, 0x72, 0x01, 0x00, 0x00,
+}
+
+func (m *ChainIndicator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainIndicator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainIndicator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Indicator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIndicator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Block != 0 {
+		i = encodeVarintIndicator(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RegistryBlockHeight != 0 {
+		i = encodeVarintIndicator(dAtA, i, uint64(m.RegistryBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintIndicator(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIndicator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIndicator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainIndicator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovIndicator(uint64(l))
+	}
+	if m.RegistryBlockHeight != 0 {
+		n += 1 + sovIndicator(uint64(m.RegistryBlockHeight))
+	}
+	if m.Block != 0 {
+		n += 1 + sovIndicator(uint64(m.Block))
+	}
+	l = m.Indicator.Size()
+	n += 1 + l + sovIndicator(uint64(l))
+	return n
+}
+
+func sovIndicator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIndicator(x uint64) (n int) {
+	return sovIndicator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChainIndicator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndicator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainIndicator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainIndicator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndicator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryBlockHeight", wireType)
+			}
+			m.RegistryBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistryBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indicator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndicator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Indicator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndicator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndicator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIndicator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIndicator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIndicator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIndicator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIndicator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIndicator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIndicator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIndicator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIndicator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIndicator = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,19 @@
+package types
+
+const (
+	// ChainConnectivityKeyPrefix is the prefix to retrieve all ChainConnectivity
+	ChainConnectivityKeyPrefix = "ChainConnectivity/value/"
+)
+
+// ChainConnectivityKey returns the store key to retrieve a ChainConnectivity from the index fields
+func ChainConnectivityKey(
+	chainId string,
+) []byte {
+	var key []byte
+
+	chainIdBytes := []byte(chainId)
+	key = append(key, chainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
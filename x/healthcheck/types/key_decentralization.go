@@ -0,0 +1,37 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// DecentralizationSampleKeyPrefix is the prefix to retrieve all DecentralizationSample
+	DecentralizationSampleKeyPrefix = "DecentralizationSample/value/"
+)
+
+// DecentralizationSamplePrefix returns the store prefix to iterate over the decentralization samples of a chain
+func DecentralizationSamplePrefix(
+	chainId string,
+) []byte {
+	var key []byte
+
+	chainIdBytes := []byte(chainId)
+	key = append(key, chainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// DecentralizationSampleKey returns the store key to retrieve a DecentralizationSample from the index fields.
+// Samples of a chain are ordered by the registry block height.
+func DecentralizationSampleKey(
+	chainId string,
+	registryBlockHeight uint64,
+) []byte {
+	key := DecentralizationSamplePrefix(chainId)
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, registryBlockHeight)
+	key = append(key, heightBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,37 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// HeartbeatKeyPrefix is the prefix to retrieve all Heartbeat
+	HeartbeatKeyPrefix = "Heartbeat/value/"
+)
+
+// HeartbeatPrefix returns the store prefix to iterate over the out-of-band heartbeats of a chain
+func HeartbeatPrefix(
+	chainId string,
+) []byte {
+	var key []byte
+
+	chainIdBytes := []byte(chainId)
+	key = append(key, chainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// HeartbeatKey returns the store key to retrieve a Heartbeat from the index fields.
+// Heartbeats of a chain are ordered by the registry block height.
+func HeartbeatKey(
+	chainId string,
+	registryBlockHeight uint64,
+) []byte {
+	key := HeartbeatPrefix(chainId)
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, registryBlockHeight)
+	key = append(key, heightBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
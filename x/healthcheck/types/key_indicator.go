@@ -0,0 +1,38 @@
+package types
+
+const (
+	// ChainIndicatorKeyPrefix is the prefix to retrieve all ChainIndicator
+	ChainIndicatorKeyPrefix = "ChainIndicator/value/"
+)
+
+// ChainIndicatorPrefix returns the store prefix to iterate over all indicators of a chain
+func ChainIndicatorPrefix(
+	chainId string,
+) []byte {
+	var key []byte
+
+	chainIdBytes := []byte(chainId)
+	key = append(key, chainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ChainIndicatorKey returns the store key to retrieve a ChainIndicator from the index fields
+func ChainIndicatorKey(
+	chainId string,
+	reporter string,
+	key string,
+) []byte {
+	indicatorKey := ChainIndicatorPrefix(chainId)
+
+	reporterBytes := []byte(reporter)
+	indicatorKey = append(indicatorKey, reporterBytes...)
+	indicatorKey = append(indicatorKey, []byte("/")...)
+
+	keyBytes := []byte(key)
+	indicatorKey = append(indicatorKey, keyBytes...)
+	indicatorKey = append(indicatorKey, []byte("/")...)
+
+	return indicatorKey
+}
@@ -0,0 +1,19 @@
+package types
+
+const (
+	// RelayLatencyKeyPrefix is the prefix to retrieve all RelayLatency
+	RelayLatencyKeyPrefix = "RelayLatency/value/"
+)
+
+// RelayLatencyKey returns the store key to retrieve a RelayLatency from the index fields
+func RelayLatencyKey(
+	chainId string,
+) []byte {
+	var key []byte
+
+	chainIdBytes := []byte(chainId)
+	key = append(key, chainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
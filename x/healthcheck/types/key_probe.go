@@ -0,0 +1,19 @@
+package types
+
+const (
+	// ProbeKeyPrefix is the prefix to retrieve all Probe
+	ProbeKeyPrefix = "Probe/value/"
+)
+
+// ProbeKey returns the store key to retrieve a Probe from the index fields
+func ProbeKey(
+	chainId string,
+) []byte {
+	var key []byte
+
+	chainIdBytes := []byte(chainId)
+	key = append(key, chainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,49 @@
+package types
+
+const (
+	// RelayerStatsKeyPrefix is the prefix to retrieve all RelayerStats
+	RelayerStatsKeyPrefix = "RelayerStats/value/"
+
+	// ChainRelayerKeyPrefix is the prefix to retrieve all ChainRelayer
+	ChainRelayerKeyPrefix = "ChainRelayer/value/"
+)
+
+// RelayerStatsKey returns the store key to retrieve a RelayerStats from the index fields
+func RelayerStatsKey(
+	relayer string,
+) []byte {
+	var key []byte
+
+	relayerBytes := []byte(relayer)
+	key = append(key, relayerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ChainRelayerPrefix returns the store prefix to iterate over all relayers of a chain
+func ChainRelayerPrefix(
+	chainId string,
+) []byte {
+	var key []byte
+
+	chainIdBytes := []byte(chainId)
+	key = append(key, chainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ChainRelayerKey returns the store key to retrieve a ChainRelayer from the index fields
+func ChainRelayerKey(
+	chainId string,
+	relayer string,
+) []byte {
+	key := ChainRelayerPrefix(chainId)
+
+	relayerBytes := []byte(relayer)
+	key = append(key, relayerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "healthcheck"
@@ -22,6 +24,13 @@ const (
 	DefaultUpdateInterval = 10
 
 	DefaultTimeoutInterval = 20
+
+	// ProbeTimeoutPeriod is the timeout of the probe challenges. Timeouts close ordered channels,
+	// so it matches the timeout of the healthcheck updates sent by the monitored chains.
+	ProbeTimeoutPeriod = 7 * 24 * time.Hour
+
+	// LatencySampleWindow is the number of the last healthcheck updates whose relay latency is kept per chain
+	LatencySampleWindow = 100
 )
 
 type MonitoredChainStatus uint64
@@ -29,6 +38,41 @@ type MonitoredChainStatus uint64
 const (
 	Inactive MonitoredChainStatus = iota
 	Active
+	// Degraded is used for chains that deliver healthcheck updates, but with a relay latency above the DegradedLatency
+	Degraded
+)
+
+// IsLive returns true for the chains that deliver healthcheck updates
+func (s MonitoredChainStatus) IsLive() bool {
+	return s == Active || s == Degraded
+}
+
+// MonitoredChainDiagnosis explains why an inactive chain doesn't deliver healthcheck updates
+type MonitoredChainDiagnosis uint64
+
+const (
+	// NoDiagnosis is used for chains that are active or were never tracked
+	NoDiagnosis MonitoredChainDiagnosis = iota
+	// PacketsNotRelayed means that the chain keeps producing blocks (its light client
+	// is updated), but healthcheck packets aren't relayed
+	PacketsNotRelayed
+	// ChainHalted means that there is no evidence of new blocks on the chain
+	ChainHalted
+	// Recovering means that the chain delivers healthcheck updates again, but not yet for
+	// the ActivationThreshold consecutive update intervals
+	Recovering
+)
+
+// EvidenceSource defines what was used as the evidence of the monitored chain's liveness
+type EvidenceSource uint64
+
+const (
+	NoEvidence EvidenceSource = iota
+	// HealthcheckPacket is used for chains that send healthcheck updates through the healthcheck channel
+	HealthcheckPacket
+	// LightClient is used for passively monitored chains, whose liveness is derived from
+	// the latest height and consensus state timestamp of their light client
+	LightClient
 )
 
 var (
@@ -0,0 +1,42 @@
+package types
+
+import "sort"
+
+// AddSample appends the relay latency (in milliseconds) of a healthcheck update, dropping the oldest samples
+// beyond the LatencySampleWindow
+func (l *RelayLatency) AddSample(latency uint64) {
+	l.Samples = append(l.Samples, latency)
+	if len(l.Samples) > LatencySampleWindow {
+		l.Samples = l.Samples[len(l.Samples)-LatencySampleWindow:]
+	}
+
+	l.Measured++
+}
+
+// Percentiles returns the nearest-rank percentiles of the kept relay latencies
+func (l RelayLatency) Percentiles() LatencyPercentiles {
+	if len(l.Samples) == 0 {
+		return LatencyPercentiles{}
+	}
+
+	sorted := make([]uint64, len(l.Samples))
+	copy(sorted, l.Samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	return LatencyPercentiles{
+		Samples: uint64(len(sorted)),
+		P50:     percentile(sorted, 50),
+		P95:     percentile(sorted, 95),
+		Max:     sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted samples
+func percentile(sorted []uint64, p int) uint64 {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
@@ -0,0 +1,729 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcheck/healthcheck/latency.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RelayLatency keeps the relay latencies of the last healthcheck updates of a monitored chain.
+// Relay latency is the registry block time at which an update was received minus the update timestamp.
+type RelayLatency struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	// latencies in milliseconds, oldest first
+	Samples []uint64 `protobuf:"varint,2,rep,packed,name=samples,proto3" json:"samples,omitempty"`
+	// number of the updates measured since the chain was registered
+	Measured uint64 `protobuf:"varint,3,opt,name=measured,proto3" json:"measured,omitempty"`
+}
+
+func (m *RelayLatency) Reset()         { *m = RelayLatency{} }
+func (m *RelayLatency) String() string { return proto.CompactTextString(m) }
+func (*RelayLatency) ProtoMessage()    {}
+func (*RelayLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde7734fbf98efa3, []int{0}
+}
+func (m *RelayLatency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayLatency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayLatency.Merge(m, src)
+}
+func (m *RelayLatency) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayLatency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayLatency proto.InternalMessageInfo
+
+func (m *RelayLatency) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *RelayLatency) GetSamples() []uint64 {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+func (m *RelayLatency) GetMeasured() uint64 {
+	if m != nil {
+		return m.Measured
+	}
+	return 0
+}
+
+// LatencyPercentiles summarizes the relay latencies (in milliseconds) kept for a monitored chain
+type LatencyPercentiles struct {
+	Samples uint64 `protobuf:"varint,1,opt,name=samples,proto3" json:"samples,omitempty"`
+	P50     uint64 `protobuf:"varint,2,opt,name=p50,proto3" json:"p50,omitempty"`
+	P95     uint64 `protobuf:"varint,3,opt,name=p95,proto3" json:"p95,omitempty"`
+	Max     uint64 `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *LatencyPercentiles) Reset()         { *m = LatencyPercentiles{} }
+func (m *LatencyPercentiles) String() string { return proto.CompactTextString(m) }
+func (*LatencyPercentiles) ProtoMessage()    {}
+func (*LatencyPercentiles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde7734fbf98efa3, []int{1}
+}
+func (m *LatencyPercentiles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LatencyPercentiles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LatencyPercentiles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LatencyPercentiles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatencyPercentiles.Merge(m, src)
+}
+func (m *LatencyPercentiles) XXX_Size() int {
+	return m.Size()
+}
+func (m *LatencyPercentiles) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatencyPercentiles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LatencyPercentiles proto.InternalMessageInfo
+
+func (m *LatencyPercentiles) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+func (m *LatencyPercentiles) GetP50() uint64 {
+	if m != nil {
+		return m.P50
+	}
+	return 0
+}
+
+func (m *LatencyPercentiles) GetP95() uint64 {
+	if m != nil {
+		return m.P95
+	}
+	return 0
+}
+
+func (m *LatencyPercentiles) GetMax() uint64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RelayLatency)(nil), "healthcheck.healthcheck.RelayLatency")
+	proto.RegisterType((*LatencyPercentiles)(nil), "healthcheck.healthcheck.LatencyPercentiles")
+}
+
+func init() {
+	proto.RegisterFile("healthcheck/healthcheck/latency.proto", fileDescriptor_bde7734fbf98efa3)
+}
+
+var fileDescriptor_bde7734fbf98efa3 = []byte{
+	// 227 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x48, 0x4d, 0xcc,
+	0x29, 0xc9, 0x48, 0xce, 0x48, 0x4d, 0xce, 0xd6, 0x47, 0x66, 0xe7, 0x24, 0x96, 0xa4, 0xe6, 0x25,
+	0x57, 0xea, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x23, 0x49, 0xe9, 0x21, 0xb1, 0x95, 0xe2,
+	0xb8, 0x78, 0x82, 0x52, 0x73, 0x12, 0x2b, 0x7d, 0x20, 0xca, 0x85, 0x24, 0xb8, 0xd8, 0x93, 0x33,
+	0x12, 0x33, 0xf3, 0x3c, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x60, 0x5c, 0x90, 0x4c,
+	0x71, 0x62, 0x6e, 0x41, 0x4e, 0x6a, 0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0x4b, 0x10, 0x8c, 0x2b,
+	0x24, 0xc5, 0xc5, 0x91, 0x9b, 0x9a, 0x58, 0x5c, 0x5a, 0x94, 0x9a, 0x22, 0xc1, 0xac, 0xc0, 0xa8,
+	0xc1, 0x12, 0x04, 0xe7, 0x2b, 0xa5, 0x70, 0x09, 0x41, 0x8d, 0x0e, 0x48, 0x2d, 0x4a, 0x4e, 0xcd,
+	0x2b, 0xc9, 0x04, 0xe9, 0x40, 0x32, 0x8b, 0x11, 0xac, 0x01, 0x6e, 0x96, 0x00, 0x17, 0x73, 0x81,
+	0xa9, 0x81, 0x04, 0x13, 0x58, 0x14, 0xc4, 0x04, 0x8b, 0x58, 0x9a, 0x42, 0x0d, 0x06, 0x31, 0x41,
+	0x22, 0xb9, 0x89, 0x15, 0x12, 0x2c, 0x10, 0x91, 0xdc, 0xc4, 0x0a, 0x27, 0xcb, 0x13, 0x8f, 0xe4,
+	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
+	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x92, 0x47, 0x0e, 0x93, 0x0a, 0x94, 0x10, 0x2a, 0xa9,
+	0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07, 0x90, 0x31, 0x60, 0x00, 0x97, 0x88, 0xbe, 0x45, 0x49,
+	0x01, 0x00, 0x00,
+}
+
+func (m *RelayLatency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayLatency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayLatency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Measured != 0 {
+		i = encodeVarintLatency(dAtA, i, uint64(m.Measured))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Samples) > 0 {
+		dAtA2 := make([]byte, len(m.Samples)*10)
+		var j1 int
+		for _, num := range m.Samples {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintLatency(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLatency(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LatencyPercentiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatencyPercentiles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatencyPercentiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != 0 {
+		i = encodeVarintLatency(dAtA, i, uint64(m.Max))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.P95 != 0 {
+		i = encodeVarintLatency(dAtA, i, uint64(m.P95))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.P50 != 0 {
+		i = encodeVarintLatency(dAtA, i, uint64(m.P50))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Samples != 0 {
+		i = encodeVarintLatency(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLatency(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLatency(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RelayLatency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLatency(uint64(l))
+	}
+	if len(m.Samples) > 0 {
+		l = 0
+		for _, e := range m.Samples {
+			l += sovLatency(uint64(e))
+		}
+		n += 1 + sovLatency(uint64(l)) + l
+	}
+	if m.Measured != 0 {
+		n += 1 + sovLatency(uint64(m.Measured))
+	}
+	return n
+}
+
+func (m *LatencyPercentiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Samples != 0 {
+		n += 1 + sovLatency(uint64(m.Samples))
+	}
+	if m.P50 != 0 {
+		n += 1 + sovLatency(uint64(m.P50))
+	}
+	if m.P95 != 0 {
+		n += 1 + sovLatency(uint64(m.P95))
+	}
+	if m.Max != 0 {
+		n += 1 + sovLatency(uint64(m.Max))
+	}
+	return n
+}
+
+func sovLatency(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLatency(x uint64) (n int) {
+	return sovLatency(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RelayLatency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLatency
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayLatency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayLatency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLatency
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLatency
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLatency
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLatency
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Samples = append(m.Samples, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLatency
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLatency
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLatency
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Samples) == 0 {
+					m.Samples = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLatency
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Samples = append(m.Samples, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Measured", wireType)
+			}
+			m.Measured = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLatency
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Measured |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLatency(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLatency
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatencyPercentiles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLatency
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatencyPercentiles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatencyPercentiles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLatency
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
+			}
+			m.P50 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLatency
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P50 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P95", wireType)
+			}
+			m.P95 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLatency
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P95 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLatency
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLatency(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLatency
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLatency(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLatency
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLatency
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLatency
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLatency
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLatency
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLatency
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLatency        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLatency          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLatency = fmt.Errorf("proto: unexpected end of group")
+)
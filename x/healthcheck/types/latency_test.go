@@ -0,0 +1,24 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayLatencyPercentiles(t *testing.T) {
+	var relayLatency RelayLatency
+	require.Equal(t, LatencyPercentiles{}, relayLatency.Percentiles())
+
+	relayLatency.AddSample(3000)
+	require.Equal(t, LatencyPercentiles{Samples: 1, P50: 3000, P95: 3000, Max: 3000}, relayLatency.Percentiles())
+
+	for latency := uint64(1); latency <= LatencySampleWindow; latency++ {
+		relayLatency.AddSample(latency)
+	}
+
+	// the oldest sample is dropped
+	require.Len(t, relayLatency.Samples, LatencySampleWindow)
+	require.Equal(t, uint64(LatencySampleWindow+1), relayLatency.Measured)
+	require.Equal(t, LatencyPercentiles{Samples: LatencySampleWindow, P50: 50, P95: 95, Max: 100}, relayLatency.Percentiles())
+}
@@ -9,6 +9,7 @@ const (
 	TypeMsgCreateChain = "create_chain"
 	TypeMsgUpdateChain = "update_chain"
 	TypeMsgDeleteChain = "delete_chain"
+	TypeMsgResetChain  = "reset_chain"
 )
 
 var _ sdk.Msg = &MsgCreateChain{}
@@ -17,12 +18,14 @@ func NewMsgCreateChain(
 	creator string,
 	chainId string,
 	connectionId string,
+	passive bool,
 
 ) *MsgCreateChain {
 	return &MsgCreateChain{
 		Creator:      creator,
 		ChainId:      chainId,
 		ConnectionId: connectionId,
+		Passive:      passive,
 	}
 }
 
@@ -61,12 +64,14 @@ func NewMsgUpdateChain(
 	creator string,
 	chainId string,
 	connectionId string,
+	passive bool,
 
 ) *MsgUpdateChain {
 	return &MsgUpdateChain{
 		Creator:      creator,
 		ChainId:      chainId,
 		ConnectionId: connectionId,
+		Passive:      passive,
 	}
 }
 
@@ -139,3 +144,62 @@ func (msg *MsgDeleteChain) ValidateBasic() error {
 	}
 	return nil
 }
+
+var _ sdk.Msg = &MsgResetChain{}
+
+func NewMsgResetChain(
+	creator string,
+	chainId string,
+	newChainId string,
+
+) *MsgResetChain {
+	return &MsgResetChain{
+		Creator:    creator,
+		ChainId:    chainId,
+		NewChainId: newChainId,
+	}
+}
+
+func (msg *MsgResetChain) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResetChain) Type() string {
+	return TypeMsgResetChain
+}
+
+func (msg *MsgResetChain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResetChain) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResetChain) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.NewChainId == "" {
+		return nil
+	}
+
+	if !IsSameChain(msg.ChainId, msg.NewChainId) {
+		return sdkerrors.Wrapf(ErrInvalidChainReset, "chain ID %s doesn't identify the same chain as %s", msg.NewChainId, msg.ChainId)
+	}
+
+	_, revision := ParseChainIdentity(msg.ChainId)
+	_, newRevision := ParseChainIdentity(msg.NewChainId)
+	if newRevision < revision {
+		return sdkerrors.Wrapf(ErrInvalidChainReset, "revision of chain ID %s is lower than the revision of %s", msg.NewChainId, msg.ChainId)
+	}
+
+	return nil
+}
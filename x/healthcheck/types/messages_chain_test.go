@@ -100,3 +100,58 @@ func TestMsgDeleteChain_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgResetChain_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgResetChain
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgResetChain{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgResetChain{
+				Creator: sample.AccAddress(),
+				ChainId: "foo-1",
+			},
+		}, {
+			name: "revision bump",
+			msg: MsgResetChain{
+				Creator:    sample.AccAddress(),
+				ChainId:    "foo-1",
+				NewChainId: "foo-2",
+			},
+		}, {
+			name: "different chain",
+			msg: MsgResetChain{
+				Creator:    sample.AccAddress(),
+				ChainId:    "foo-1",
+				NewChainId: "bar-2",
+			},
+			err: ErrInvalidChainReset,
+		}, {
+			name: "lower revision",
+			msg: MsgResetChain{
+				Creator:    sample.AccAddress(),
+				ChainId:    "foo-2",
+				NewChainId: "foo-1",
+			},
+			err: ErrInvalidChainReset,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,34 +1,238 @@
 package types
 
 import (
+	"fmt"
+	"math"
+	"time"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
+
+	commontypes "healthcheck/x/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// MaxDurationSeconds is the largest number of seconds that can be converted to a time.Duration without overflowing
+const MaxDurationSeconds = uint64(math.MaxInt64 / int64(time.Second))
+
+var (
+	KeyTimestampVerificationTolerance = []byte("TimestampVerificationTolerance")
+	// DefaultTimestampVerificationTolerance is the maximum allowed difference (in seconds) between the reported
+	// timestamp of a monitored chain block and the timestamp of its consensus state stored by the light client
+	DefaultTimestampVerificationTolerance uint64 = 1
+
+	KeyMaxClockDrift = []byte("MaxClockDrift")
+	// DefaultMaxClockDrift is the maximum time (in seconds) by which the timestamp of a healthcheck update
+	// can be ahead of the registry chain block time
+	DefaultMaxClockDrift uint64 = 30
+
+	KeyMaxUpdateInterval = []byte("MaxUpdateInterval")
+	// DefaultMaxUpdateInterval is the maximum update interval (in blocks) a monitored chain can negotiate
+	DefaultMaxUpdateInterval uint64 = 100
+
+	KeyMaxTimeoutInterval = []byte("MaxTimeoutInterval")
+	// DefaultMaxTimeoutInterval is the maximum timeout interval (in blocks) a monitored chain can negotiate
+	DefaultMaxTimeoutInterval uint64 = 200
+
+	KeyAtRiskParticipation = []byte("AtRiskParticipation")
+	// DefaultAtRiskParticipation is the share of voting power (in basis points) that has to sign the last commit
+	// of a monitored chain for it not to be at risk of halting. Chains halt below 2/3 of the voting power.
+	DefaultAtRiskParticipation uint64 = 7500
+
+	KeyProbeInterval = []byte("ProbeInterval")
+	// DefaultProbeInterval is the number of registry chain blocks between the probe challenges sent to
+	// the monitored chains that negotiated the probe feature. Probes are disabled with zero.
+	DefaultProbeInterval uint64 = 0
+
+	KeyDegradedLatency = []byte("DegradedLatency")
+	// DefaultDegradedLatency is the 95th percentile of the relay latency (in seconds) above which an active monitored
+	// chain is considered degraded. The degraded status is disabled with zero.
+	DefaultDegradedLatency uint64 = 0
+
+	KeyActivationThreshold = []byte("ActivationThreshold")
+	// DefaultActivationThreshold is the number of consecutive on-time healthcheck updates an inactive chain
+	// has to deliver to become active again. Zero is treated as one.
+	DefaultActivationThreshold uint64 = 1
+
+	KeyInactivationThreshold = []byte("InactivationThreshold")
+	// DefaultInactivationThreshold is the number of update intervals an active chain has to miss to become inactive.
+	// Zero is treated as one.
+	DefaultInactivationThreshold uint64 = 1
+
+	KeyFlapWindow = []byte("FlapWindow")
+	// DefaultFlapWindow is the number of registry chain blocks in which the status transitions of a chain are counted
+	DefaultFlapWindow uint64 = 100
+
+	KeyFlapThreshold = []byte("FlapThreshold")
+	// DefaultFlapThreshold is the number of status transitions within the FlapWindow above which a chain is
+	// flapping. Flap detection is disabled with zero.
+	DefaultFlapThreshold uint64 = 0
+
+	KeyMaxChainIndicators = []byte("MaxChainIndicators")
+	// DefaultMaxChainIndicators is the maximum number of indicators stored for a monitored chain
+	DefaultMaxChainIndicators uint64 = 50
+
+	KeyDecentralizationRetention = []byte("DecentralizationRetention")
+	// DefaultDecentralizationRetention is the number of registry blocks the decentralization samples of a chain are kept for
+	DefaultDecentralizationRetention uint64 = 100800
+
+	KeyDecentralizationSampleInterval = []byte("DecentralizationSampleInterval")
+	// DefaultDecentralizationSampleInterval is the minimum number of registry blocks between two decentralization samples
+	// of a chain. Metrics reported earlier are not recorded.
+	DefaultDecentralizationSampleInterval uint64 = 50
+
+	KeyHeartbeatRetention = []byte("HeartbeatRetention")
+	// DefaultHeartbeatRetention is the number of registry blocks the out-of-band heartbeats of a chain are kept for
+	DefaultHeartbeatRetention uint64 = 100800
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	timestampVerificationTolerance uint64,
+	maxClockDrift uint64,
+	maxUpdateInterval uint64,
+	maxTimeoutInterval uint64,
+	atRiskParticipation uint64,
+	probeInterval uint64,
+	degradedLatency uint64,
+	activationThreshold uint64,
+	inactivationThreshold uint64,
+	flapWindow uint64,
+	flapThreshold uint64,
+	maxChainIndicators uint64,
+	decentralizationRetention uint64,
+	decentralizationSampleInterval uint64,
+	heartbeatRetention uint64,
+) Params {
+	return Params{
+		TimestampVerificationTolerance: timestampVerificationTolerance,
+		MaxClockDrift:                  maxClockDrift,
+		MaxUpdateInterval:              maxUpdateInterval,
+		MaxTimeoutInterval:             maxTimeoutInterval,
+		AtRiskParticipation:            atRiskParticipation,
+		ProbeInterval:                  probeInterval,
+		DegradedLatency:                degradedLatency,
+		ActivationThreshold:            activationThreshold,
+		InactivationThreshold:          inactivationThreshold,
+		FlapWindow:                     flapWindow,
+		FlapThreshold:                  flapThreshold,
+		MaxChainIndicators:             maxChainIndicators,
+		DecentralizationRetention:      decentralizationRetention,
+		DecentralizationSampleInterval: decentralizationSampleInterval,
+		HeartbeatRetention:             heartbeatRetention,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultTimestampVerificationTolerance,
+		DefaultMaxClockDrift,
+		DefaultMaxUpdateInterval,
+		DefaultMaxTimeoutInterval,
+		DefaultAtRiskParticipation,
+		DefaultProbeInterval,
+		DefaultDegradedLatency,
+		DefaultActivationThreshold,
+		DefaultInactivationThreshold,
+		DefaultFlapWindow,
+		DefaultFlapThreshold,
+		DefaultMaxChainIndicators,
+		DefaultDecentralizationRetention,
+		DefaultDecentralizationSampleInterval,
+		DefaultHeartbeatRetention,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyTimestampVerificationTolerance, &p.TimestampVerificationTolerance, validateTimestampVerificationTolerance),
+		paramtypes.NewParamSetPair(KeyMaxClockDrift, &p.MaxClockDrift, validateMaxClockDrift),
+		paramtypes.NewParamSetPair(KeyMaxUpdateInterval, &p.MaxUpdateInterval, validateMaxUpdateInterval),
+		paramtypes.NewParamSetPair(KeyMaxTimeoutInterval, &p.MaxTimeoutInterval, validateMaxTimeoutInterval),
+		paramtypes.NewParamSetPair(KeyAtRiskParticipation, &p.AtRiskParticipation, validateAtRiskParticipation),
+		paramtypes.NewParamSetPair(KeyProbeInterval, &p.ProbeInterval, validateProbeInterval),
+		paramtypes.NewParamSetPair(KeyDegradedLatency, &p.DegradedLatency, validateDegradedLatency),
+		paramtypes.NewParamSetPair(KeyActivationThreshold, &p.ActivationThreshold, validateActivationThreshold),
+		paramtypes.NewParamSetPair(KeyInactivationThreshold, &p.InactivationThreshold, validateInactivationThreshold),
+		paramtypes.NewParamSetPair(KeyFlapWindow, &p.FlapWindow, validateFlapWindow),
+		paramtypes.NewParamSetPair(KeyFlapThreshold, &p.FlapThreshold, validateFlapThreshold),
+		paramtypes.NewParamSetPair(KeyMaxChainIndicators, &p.MaxChainIndicators, validateMaxChainIndicators),
+		paramtypes.NewParamSetPair(KeyDecentralizationRetention, &p.DecentralizationRetention, validateDecentralizationRetention),
+		paramtypes.NewParamSetPair(KeyDecentralizationSampleInterval, &p.DecentralizationSampleInterval, validateDecentralizationSampleInterval),
+		paramtypes.NewParamSetPair(KeyHeartbeatRetention, &p.HeartbeatRetention, validateHeartbeatRetention),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateTimestampVerificationTolerance(p.TimestampVerificationTolerance); err != nil {
+		return err
+	}
+
+	if err := validateMaxClockDrift(p.MaxClockDrift); err != nil {
+		return err
+	}
+
+	if err := validateMaxUpdateInterval(p.MaxUpdateInterval); err != nil {
+		return err
+	}
+
+	if err := validateMaxTimeoutInterval(p.MaxTimeoutInterval); err != nil {
+		return err
+	}
+
+	if err := validateAtRiskParticipation(p.AtRiskParticipation); err != nil {
+		return err
+	}
+
+	if err := validateProbeInterval(p.ProbeInterval); err != nil {
+		return err
+	}
+
+	if err := validateDegradedLatency(p.DegradedLatency); err != nil {
+		return err
+	}
+
+	if err := validateActivationThreshold(p.ActivationThreshold); err != nil {
+		return err
+	}
+
+	if err := validateInactivationThreshold(p.InactivationThreshold); err != nil {
+		return err
+	}
+
+	if err := validateFlapWindow(p.FlapWindow); err != nil {
+		return err
+	}
+
+	if err := validateFlapThreshold(p.FlapThreshold); err != nil {
+		return err
+	}
+
+	if err := validateMaxChainIndicators(p.MaxChainIndicators); err != nil {
+		return err
+	}
+
+	if err := validateDecentralizationRetention(p.DecentralizationRetention); err != nil {
+		return err
+	}
+
+	if err := validateDecentralizationSampleInterval(p.DecentralizationSampleInterval); err != nil {
+		return err
+	}
+
+	if err := validateHeartbeatRetention(p.HeartbeatRetention); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -37,3 +241,169 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// validateTimestampVerificationTolerance validates the TimestampVerificationTolerance param
+func validateTimestampVerificationTolerance(v interface{}) error {
+	tolerance, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if tolerance > MaxDurationSeconds {
+		return fmt.Errorf("timestamp verification tolerance must not exceed %d seconds: %d", MaxDurationSeconds, tolerance)
+	}
+
+	return nil
+}
+
+// validateMaxClockDrift validates the MaxClockDrift param
+func validateMaxClockDrift(v interface{}) error {
+	maxClockDrift, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxClockDrift > MaxDurationSeconds {
+		return fmt.Errorf("max clock drift must not exceed %d seconds: %d", MaxDurationSeconds, maxClockDrift)
+	}
+
+	return nil
+}
+
+// validateMaxUpdateInterval validates the MaxUpdateInterval param
+func validateMaxUpdateInterval(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxTimeoutInterval validates the MaxTimeoutInterval param
+func validateMaxTimeoutInterval(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateAtRiskParticipation validates the AtRiskParticipation param
+func validateAtRiskParticipation(v interface{}) error {
+	participation, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if participation > commontypes.BasisPoints {
+		return fmt.Errorf("at risk participation must not exceed %d basis points: %d", commontypes.BasisPoints, participation)
+	}
+
+	return nil
+}
+
+// validateProbeInterval validates the ProbeInterval param
+func validateProbeInterval(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateDegradedLatency validates the DegradedLatency param
+func validateDegradedLatency(v interface{}) error {
+	value, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if value > MaxDurationSeconds {
+		return fmt.Errorf("degraded latency overflows a duration: %d", value)
+	}
+
+	return nil
+}
+
+// validateActivationThreshold validates the ActivationThreshold param
+func validateActivationThreshold(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateInactivationThreshold validates the InactivationThreshold param
+func validateInactivationThreshold(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateFlapWindow validates the FlapWindow param
+func validateFlapWindow(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateFlapThreshold validates the FlapThreshold param
+func validateFlapThreshold(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxChainIndicators validates the MaxChainIndicators param
+func validateMaxChainIndicators(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateDecentralizationRetention validates the DecentralizationRetention param
+func validateDecentralizationRetention(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateDecentralizationSampleInterval validates the DecentralizationSampleInterval param
+func validateDecentralizationSampleInterval(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateHeartbeatRetention validates the HeartbeatRetention param
+func validateHeartbeatRetention(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -25,6 +25,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	TimestampVerificationTolerance uint64 `protobuf:"varint,1,opt,name=timestampVerificationTolerance,proto3" json:"timestampVerificationTolerance,omitempty" yaml:"timestamp_verification_tolerance"`
+	MaxClockDrift                  uint64 `protobuf:"varint,2,opt,name=maxClockDrift,proto3" json:"maxClockDrift,omitempty" yaml:"max_clock_drift"`
+	MaxUpdateInterval              uint64 `protobuf:"varint,3,opt,name=maxUpdateInterval,proto3" json:"maxUpdateInterval,omitempty" yaml:"max_update_interval"`
+	MaxTimeoutInterval             uint64 `protobuf:"varint,4,opt,name=maxTimeoutInterval,proto3" json:"maxTimeoutInterval,omitempty" yaml:"max_timeout_interval"`
+	AtRiskParticipation            uint64 `protobuf:"varint,5,opt,name=atRiskParticipation,proto3" json:"atRiskParticipation,omitempty" yaml:"at_risk_participation"`
+	ProbeInterval                  uint64 `protobuf:"varint,6,opt,name=probeInterval,proto3" json:"probeInterval,omitempty" yaml:"probe_interval"`
+	DegradedLatency                uint64 `protobuf:"varint,7,opt,name=degradedLatency,proto3" json:"degradedLatency,omitempty" yaml:"degraded_latency"`
+	ActivationThreshold            uint64 `protobuf:"varint,8,opt,name=activationThreshold,proto3" json:"activationThreshold,omitempty" yaml:"activation_threshold"`
+	InactivationThreshold          uint64 `protobuf:"varint,9,opt,name=inactivationThreshold,proto3" json:"inactivationThreshold,omitempty" yaml:"inactivation_threshold"`
+	FlapWindow                     uint64 `protobuf:"varint,10,opt,name=flapWindow,proto3" json:"flapWindow,omitempty" yaml:"flap_window"`
+	FlapThreshold                  uint64 `protobuf:"varint,11,opt,name=flapThreshold,proto3" json:"flapThreshold,omitempty" yaml:"flap_threshold"`
+	MaxChainIndicators             uint64 `protobuf:"varint,12,opt,name=maxChainIndicators,proto3" json:"maxChainIndicators,omitempty" yaml:"max_chain_indicators"`
+	DecentralizationRetention      uint64 `protobuf:"varint,13,opt,name=decentralizationRetention,proto3" json:"decentralizationRetention,omitempty" yaml:"decentralization_retention"`
+	DecentralizationSampleInterval uint64 `protobuf:"varint,14,opt,name=decentralizationSampleInterval,proto3" json:"decentralizationSampleInterval,omitempty" yaml:"decentralization_sample_interval"`
+	HeartbeatRetention             uint64 `protobuf:"varint,15,opt,name=heartbeatRetention,proto3" json:"heartbeatRetention,omitempty" yaml:"heartbeat_retention"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +74,111 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetTimestampVerificationTolerance() uint64 {
+	if m != nil {
+		return m.TimestampVerificationTolerance
+	}
+	return 0
+}
+
+func (m *Params) GetMaxClockDrift() uint64 {
+	if m != nil {
+		return m.MaxClockDrift
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUpdateInterval() uint64 {
+	if m != nil {
+		return m.MaxUpdateInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTimeoutInterval() uint64 {
+	if m != nil {
+		return m.MaxTimeoutInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAtRiskParticipation() uint64 {
+	if m != nil {
+		return m.AtRiskParticipation
+	}
+	return 0
+}
+
+func (m *Params) GetProbeInterval() uint64 {
+	if m != nil {
+		return m.ProbeInterval
+	}
+	return 0
+}
+
+func (m *Params) GetDegradedLatency() uint64 {
+	if m != nil {
+		return m.DegradedLatency
+	}
+	return 0
+}
+
+func (m *Params) GetActivationThreshold() uint64 {
+	if m != nil {
+		return m.ActivationThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetInactivationThreshold() uint64 {
+	if m != nil {
+		return m.InactivationThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetFlapWindow() uint64 {
+	if m != nil {
+		return m.FlapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetFlapThreshold() uint64 {
+	if m != nil {
+		return m.FlapThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetMaxChainIndicators() uint64 {
+	if m != nil {
+		return m.MaxChainIndicators
+	}
+	return 0
+}
+
+func (m *Params) GetDecentralizationRetention() uint64 {
+	if m != nil {
+		return m.DecentralizationRetention
+	}
+	return 0
+}
+
+func (m *Params) GetDecentralizationSampleInterval() uint64 {
+	if m != nil {
+		return m.DecentralizationSampleInterval
+	}
+	return 0
+}
+
+func (m *Params) GetHeartbeatRetention() uint64 {
+	if m != nil {
+		return m.HeartbeatRetention
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "healthcheck.healthcheck.Params")
 }
@@ -68,16 +188,46 @@ func init() {
 }
 
 var fileDescriptor_c247fbb4f766ae8e = []byte{
-	// 130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x48, 0x4d, 0xcc,
-	0x29, 0xc9, 0x48, 0xce, 0x48, 0x4d, 0xce, 0xd6, 0x47, 0x66, 0x17, 0x24, 0x16, 0x25, 0xe6, 0x16,
-	0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x23, 0xc9, 0xe8, 0x21, 0xb1, 0xa5, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0xc1, 0x6a, 0xf4, 0x41, 0x2c, 0x88, 0x72, 0x25, 0x3e, 0x2e, 0xb6, 0x00, 0xb0,
-	0x76, 0x2b, 0x96, 0x19, 0x0b, 0xe4, 0x19, 0x9c, 0x2c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x1e, 0xd9, 0xca, 0x0a, 0x14, 0x07, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27,
-	0xb1, 0x81, 0x4d, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xe8, 0x29, 0xf0, 0xa8, 0x00,
-	0x00, 0x00,
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc7, 0x77, 0x7f, 0x3f, 0x44, 0x19, 0x45, 0xe2, 0x20, 0x50, 0x40, 0x3b, 0xd0, 0x68, 0x34,
+	0x31, 0x81, 0x83, 0x89, 0x89, 0x5c, 0x34, 0xa0, 0x07, 0x12, 0xa2, 0x58, 0x51, 0x12, 0x2f, 0x93,
+	0x87, 0xe9, 0x40, 0x27, 0xdb, 0x76, 0x9a, 0xe9, 0x00, 0x8b, 0xaf, 0xc2, 0xa3, 0x47, 0x5f, 0x8e,
+	0x47, 0x8e, 0x9e, 0x1a, 0x02, 0xef, 0xa0, 0xaf, 0xc0, 0x74, 0xca, 0x6e, 0x67, 0xbb, 0x35, 0xdc,
+	0x66, 0xf3, 0x7c, 0xbe, 0x9f, 0xf9, 0xb3, 0x4f, 0x1f, 0xf4, 0x24, 0xe4, 0x10, 0xe9, 0x90, 0x85,
+	0x9c, 0xf5, 0xd6, 0xed, 0x75, 0x0a, 0x0a, 0xe2, 0x6c, 0x2d, 0x55, 0x52, 0x4b, 0xbc, 0x60, 0x55,
+	0xd6, 0xac, 0xf5, 0xd2, 0xc3, 0x23, 0x79, 0x24, 0x0d, 0xb3, 0x5e, 0xae, 0x2a, 0xdc, 0xbb, 0x98,
+	0x42, 0x93, 0xbb, 0x26, 0x8f, 0x33, 0xe4, 0x6a, 0x11, 0xf3, 0x4c, 0x43, 0x9c, 0x7e, 0xe5, 0x4a,
+	0x1c, 0x0a, 0x06, 0x5a, 0xc8, 0x64, 0x4f, 0x46, 0x5c, 0x41, 0xc2, 0xb8, 0xd3, 0x5d, 0xe9, 0x3e,
+	0x9f, 0xd8, 0x7c, 0x51, 0xe4, 0xe4, 0xd9, 0x19, 0xc4, 0xd1, 0x86, 0x37, 0xe4, 0xe9, 0x89, 0x15,
+	0xa0, 0x7a, 0x90, 0xf0, 0xfc, 0x1b, 0x94, 0xf8, 0x2d, 0x9a, 0x8e, 0xa1, 0xbf, 0x15, 0x49, 0xd6,
+	0x7b, 0xa7, 0xc4, 0xa1, 0x76, 0xfe, 0x33, 0x7b, 0x2c, 0x15, 0x39, 0x99, 0xaf, 0xf6, 0x88, 0xa1,
+	0x4f, 0x59, 0x59, 0xa7, 0x41, 0x09, 0x78, 0xfe, 0x68, 0x00, 0xef, 0xa0, 0x07, 0x31, 0xf4, 0xbf,
+	0xa4, 0x01, 0x68, 0xbe, 0x9d, 0x68, 0xae, 0x4e, 0x20, 0x72, 0xfe, 0x37, 0x16, 0xb7, 0xc8, 0xc9,
+	0x52, 0x6d, 0x39, 0x36, 0x0c, 0x15, 0xd7, 0x90, 0xe7, 0x8f, 0x07, 0xf1, 0x47, 0x84, 0x63, 0xe8,
+	0xef, 0x89, 0x98, 0xcb, 0x63, 0x3d, 0xd4, 0x4d, 0x18, 0x1d, 0x29, 0x72, 0xb2, 0x5c, 0xeb, 0x74,
+	0x05, 0x59, 0xbe, 0x96, 0x28, 0xf6, 0xd1, 0x2c, 0x68, 0x5f, 0x64, 0xbd, 0x5d, 0x50, 0x5a, 0x30,
+	0x91, 0x9a, 0x07, 0x70, 0x6e, 0x19, 0xe3, 0x4a, 0x91, 0x93, 0x47, 0x95, 0x11, 0x34, 0x55, 0x22,
+	0xeb, 0xd1, 0xd4, 0xc6, 0x3c, 0xbf, 0x2d, 0x8c, 0xdf, 0xa0, 0xe9, 0x54, 0xc9, 0x83, 0xfa, 0xba,
+	0x93, 0xc6, 0xb6, 0x58, 0xe4, 0x64, 0xae, 0xb2, 0x99, 0xb2, 0x75, 0xb2, 0x51, 0x1e, 0xbf, 0x47,
+	0x33, 0x01, 0x3f, 0x52, 0x10, 0xf0, 0x60, 0x07, 0x34, 0x4f, 0xd8, 0x99, 0x73, 0xdb, 0x28, 0x96,
+	0x8b, 0x9c, 0x2c, 0x54, 0x8a, 0x01, 0x40, 0xa3, 0x8a, 0xf0, 0xfc, 0x66, 0x06, 0x7f, 0x42, 0xb3,
+	0xc0, 0xb4, 0x38, 0xa9, 0xfe, 0xd3, 0x50, 0xf1, 0x2c, 0x94, 0x51, 0xe0, 0xdc, 0x69, 0xbe, 0x56,
+	0x0d, 0x51, 0x3d, 0xa0, 0xca, 0xab, 0x8d, 0x67, 0xf1, 0x3e, 0x9a, 0x13, 0x49, 0x9b, 0x74, 0xca,
+	0x48, 0x57, 0x8b, 0x9c, 0x3c, 0xae, 0xa4, 0x22, 0x69, 0xd7, 0xb6, 0xe7, 0xf1, 0x2b, 0x84, 0x0e,
+	0x23, 0x48, 0xf7, 0x45, 0x12, 0xc8, 0x53, 0x07, 0x19, 0xdb, 0x7c, 0x91, 0x13, 0x5c, 0xd9, 0xca,
+	0x1a, 0x3d, 0x35, 0x45, 0xcf, 0xb7, 0xc8, 0xf2, 0xad, 0xcb, 0x5f, 0xf5, 0x41, 0xee, 0x36, 0xdf,
+	0xda, 0x44, 0xad, 0x03, 0x8c, 0xf2, 0xd7, 0x1d, 0xb5, 0x15, 0x82, 0x48, 0xb6, 0x93, 0xa0, 0xfc,
+	0x00, 0xa4, 0xca, 0x9c, 0x7b, 0x6d, 0x1d, 0xc5, 0x4a, 0x88, 0x8a, 0x21, 0xe5, 0xf9, 0x2d, 0x51,
+	0xcc, 0xd0, 0x62, 0xc0, 0x19, 0x4f, 0xb4, 0x82, 0x48, 0x7c, 0x37, 0xd7, 0xf4, 0xb9, 0xe6, 0x89,
+	0xe9, 0xab, 0x69, 0xe3, 0x7d, 0x5a, 0xe4, 0x64, 0x75, 0xf0, 0x37, 0x8e, 0xa2, 0x54, 0x0d, 0x58,
+	0xcf, 0xff, 0xb7, 0xa7, 0x1c, 0x06, 0xcd, 0xe2, 0x67, 0x88, 0xd3, 0xa8, 0xee, 0xb9, 0xfb, 0xcd,
+	0x61, 0x30, 0xb6, 0x53, 0x66, 0x02, 0x56, 0x17, 0xde, 0xa0, 0xc4, 0x1f, 0x10, 0x0e, 0x39, 0x28,
+	0x7d, 0xc0, 0x41, 0xd7, 0x57, 0x9a, 0x69, 0x7e, 0xcb, 0x43, 0xc6, 0xbe, 0x4b, 0x4b, 0x72, 0x63,
+	0xe2, 0xe7, 0x2f, 0xd2, 0xd9, 0x7c, 0xfd, 0xfb, 0xd2, 0xed, 0x9e, 0x5f, 0xba, 0xdd, 0x8b, 0x4b,
+	0xb7, 0xfb, 0xe3, 0xca, 0xed, 0x9c, 0x5f, 0xb9, 0x9d, 0x3f, 0x57, 0x6e, 0xe7, 0x1b, 0xb1, 0xa7,
+	0x68, 0x7f, 0x64, 0xa6, 0xea, 0xb3, 0x94, 0x67, 0x07, 0x93, 0x66, 0x48, 0xbe, 0xfc, 0x3b, 0x00,
+	0x96, 0xf4, 0xb0, 0xb9, 0x7b, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -100,6 +250,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HeartbeatRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HeartbeatRetention))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.DecentralizationSampleInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DecentralizationSampleInterval))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.DecentralizationRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DecentralizationRetention))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxChainIndicators != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxChainIndicators))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.FlapThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FlapThreshold))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.FlapWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FlapWindow))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.InactivationThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InactivationThreshold))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ActivationThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationThreshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DegradedLatency != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DegradedLatency))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ProbeInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProbeInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AtRiskParticipation != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AtRiskParticipation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxTimeoutInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTimeoutInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxUpdateInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUpdateInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxClockDrift != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxClockDrift))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TimestampVerificationTolerance != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TimestampVerificationTolerance))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -120,6 +345,51 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.TimestampVerificationTolerance != 0 {
+		n += 1 + sovParams(uint64(m.TimestampVerificationTolerance))
+	}
+	if m.MaxClockDrift != 0 {
+		n += 1 + sovParams(uint64(m.MaxClockDrift))
+	}
+	if m.MaxUpdateInterval != 0 {
+		n += 1 + sovParams(uint64(m.MaxUpdateInterval))
+	}
+	if m.MaxTimeoutInterval != 0 {
+		n += 1 + sovParams(uint64(m.MaxTimeoutInterval))
+	}
+	if m.AtRiskParticipation != 0 {
+		n += 1 + sovParams(uint64(m.AtRiskParticipation))
+	}
+	if m.ProbeInterval != 0 {
+		n += 1 + sovParams(uint64(m.ProbeInterval))
+	}
+	if m.DegradedLatency != 0 {
+		n += 1 + sovParams(uint64(m.DegradedLatency))
+	}
+	if m.ActivationThreshold != 0 {
+		n += 1 + sovParams(uint64(m.ActivationThreshold))
+	}
+	if m.InactivationThreshold != 0 {
+		n += 1 + sovParams(uint64(m.InactivationThreshold))
+	}
+	if m.FlapWindow != 0 {
+		n += 1 + sovParams(uint64(m.FlapWindow))
+	}
+	if m.FlapThreshold != 0 {
+		n += 1 + sovParams(uint64(m.FlapThreshold))
+	}
+	if m.MaxChainIndicators != 0 {
+		n += 1 + sovParams(uint64(m.MaxChainIndicators))
+	}
+	if m.DecentralizationRetention != 0 {
+		n += 1 + sovParams(uint64(m.DecentralizationRetention))
+	}
+	if m.DecentralizationSampleInterval != 0 {
+		n += 1 + sovParams(uint64(m.DecentralizationSampleInterval))
+	}
+	if m.HeartbeatRetention != 0 {
+		n += 1 + sovParams(uint64(m.HeartbeatRetention))
+	}
 	return n
 }
 
@@ -158,6 +428,291 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampVerificationTolerance", wireType)
+			}
+			m.TimestampVerificationTolerance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampVerificationTolerance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClockDrift", wireType)
+			}
+			m.MaxClockDrift = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClockDrift |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdateInterval", wireType)
+			}
+			m.MaxUpdateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUpdateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeoutInterval", wireType)
+			}
+			m.MaxTimeoutInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTimeoutInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtRiskParticipation", wireType)
+			}
+			m.AtRiskParticipation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtRiskParticipation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbeInterval", wireType)
+			}
+			m.ProbeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProbeInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DegradedLatency", wireType)
+			}
+			m.DegradedLatency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DegradedLatency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThreshold", wireType)
+			}
+			m.ActivationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivationThreshold", wireType)
+			}
+			m.InactivationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivationThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlapWindow", wireType)
+			}
+			m.FlapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlapThreshold", wireType)
+			}
+			m.FlapThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlapThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChainIndicators", wireType)
+			}
+			m.MaxChainIndicators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChainIndicators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecentralizationRetention", wireType)
+			}
+			m.DecentralizationRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecentralizationRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecentralizationSampleInterval", wireType)
+			}
+			m.DecentralizationSampleInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecentralizationSampleInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatRetention", wireType)
+			}
+			m.HeartbeatRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeartbeatRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"healthcheck/x/healthcheck/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		modify func(params *types.Params)
+		valid  bool
+	}{
+		{
+			desc:   "default",
+			modify: func(params *types.Params) {},
+			valid:  true,
+		},
+		{
+			desc: "timestamp verification tolerance at the duration limit",
+			modify: func(params *types.Params) {
+				params.TimestampVerificationTolerance = types.MaxDurationSeconds
+			},
+			valid: true,
+		},
+		{
+			desc: "timestamp verification tolerance overflowing duration",
+			modify: func(params *types.Params) {
+				params.TimestampVerificationTolerance = types.MaxDurationSeconds + 1
+			},
+			valid: false,
+		},
+		{
+			desc: "degraded latency at the duration limit",
+			modify: func(params *types.Params) {
+				params.DegradedLatency = types.MaxDurationSeconds
+			},
+			valid: true,
+		},
+		{
+			desc: "degraded latency overflowing duration",
+			modify: func(params *types.Params) {
+				params.DegradedLatency = types.MaxDurationSeconds + 1
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
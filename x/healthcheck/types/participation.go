@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "healthcheck/x/types"
+)
+
+// IsParticipationAtRisk returns true if the share of voting power that signed the last commit of the monitored
+// chain, in basis points, is below the threshold. Chains that don't report their participation are never at risk.
+func IsParticipationAtRisk(signedVotingPower uint64, totalVotingPower uint64, threshold uint64) bool {
+	if totalVotingPower == 0 {
+		return false
+	}
+
+	// voting power can be large enough to overflow when scaled to basis points
+	signed := sdk.NewIntFromUint64(signedVotingPower).Mul(sdk.NewIntFromUint64(commontypes.BasisPoints))
+	required := sdk.NewIntFromUint64(totalVotingPower).Mul(sdk.NewIntFromUint64(threshold))
+
+	return signed.LT(required)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsParticipationAtRisk(t *testing.T) {
+	for _, tc := range []struct {
+		desc              string
+		signedVotingPower uint64
+		totalVotingPower  uint64
+		atRisk            bool
+	}{
+		{
+			desc:              "NotReported",
+			signedVotingPower: 0,
+			totalVotingPower:  0,
+			atRisk:            false,
+		},
+		{
+			desc:              "FullParticipation",
+			signedVotingPower: 100,
+			totalVotingPower:  100,
+			atRisk:            false,
+		},
+		{
+			desc:              "AtThreshold",
+			signedVotingPower: 75,
+			totalVotingPower:  100,
+			atRisk:            false,
+		},
+		{
+			desc:              "BelowThreshold",
+			signedVotingPower: 70,
+			totalVotingPower:  100,
+			atRisk:            true,
+		},
+		{
+			desc:              "LargeVotingPower",
+			signedVotingPower: math.MaxUint64 / 2,
+			totalVotingPower:  math.MaxUint64,
+			atRisk:            true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.atRisk, IsParticipationAtRisk(tc.signedVotingPower, tc.totalVotingPower, DefaultAtRiskParticipation))
+		})
+	}
+}
@@ -0,0 +1,608 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcheck/healthcheck/probe.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Probe tracks the challenges sent to a monitored chain to check that packets are relayed from the registry chain
+type Probe struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	// nonce of the outstanding challenge, zero if there is none
+	Nonce                   uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	SentRegistryBlockHeight uint64 `protobuf:"varint,3,opt,name=sentRegistryBlockHeight,proto3" json:"sentRegistryBlockHeight,omitempty"`
+	SentTimestamp           uint64 `protobuf:"varint,4,opt,name=sentTimestamp,proto3" json:"sentTimestamp,omitempty"`
+	// round-trip time of the last answered challenge, in registry chain blocks and seconds
+	RttBlocks                   uint64 `protobuf:"varint,5,opt,name=rttBlocks,proto3" json:"rttBlocks,omitempty"`
+	RttSeconds                  uint64 `protobuf:"varint,6,opt,name=rttSeconds,proto3" json:"rttSeconds,omitempty"`
+	AnsweredRegistryBlockHeight uint64 `protobuf:"varint,7,opt,name=answeredRegistryBlockHeight,proto3" json:"answeredRegistryBlockHeight,omitempty"`
+	Answered                    uint64 `protobuf:"varint,8,opt,name=answered,proto3" json:"answered,omitempty"`
+	// number of the challenges that were rejected, timed out or replaced before being answered
+	Failed uint64 `protobuf:"varint,9,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (m *Probe) Reset()         { *m = Probe{} }
+func (m *Probe) String() string { return proto.CompactTextString(m) }
+func (*Probe) ProtoMessage()    {}
+func (*Probe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1606fbdf0c71c292, []int{0}
+}
+func (m *Probe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Probe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Probe.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Probe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Probe.Merge(m, src)
+}
+func (m *Probe) XXX_Size() int {
+	return m.Size()
+}
+func (m *Probe) XXX_DiscardUnknown() {
+	xxx_messageInfo_Probe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Probe proto.InternalMessageInfo
+
+func (m *Probe) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *Probe) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Probe) GetSentRegistryBlockHeight() uint64 {
+	if m != nil {
+		return m.SentRegistryBlockHeight
+	}
+	return 0
+}
+
+func (m *Probe) GetSentTimestamp() uint64 {
+	if m != nil {
+		return m.SentTimestamp
+	}
+	return 0
+}
+
+func (m *Probe) GetRttBlocks() uint64 {
+	if m != nil {
+		return m.RttBlocks
+	}
+	return 0
+}
+
+func (m *Probe) GetRttSeconds() uint64 {
+	if m != nil {
+		return m.RttSeconds
+	}
+	return 0
+}
+
+func (m *Probe) GetAnsweredRegistryBlockHeight() uint64 {
+	if m != nil {
+		return m.AnsweredRegistryBlockHeight
+	}
+	return 0
+}
+
+func (m *Probe) GetAnswered() uint64 {
+	if m != nil {
+		return m.Answered
+	}
+	return 0
+}
+
+func (m *Probe) GetFailed() uint64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Probe)(nil), "healthcheck.healthcheck.Probe")
+}
+
+func init() {
+	proto.RegisterFile("healthcheck/healthcheck/probe.proto", fileDescriptor_1606fbdf0c71c292)
+}
+
+var fileDescriptor_1606fbdf0c71c292 = []byte{
+	// 278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
+	0x14, 0x45, 0xeb, 0xd2, 0xa4, 0xcd, 0x93, 0x58, 0x2c, 0x44, 0x2d, 0x40, 0xa6, 0x02, 0x86, 0x4e,
+	0x65, 0x60, 0x81, 0x0d, 0x75, 0x82, 0x0d, 0x05, 0x26, 0xb6, 0xd4, 0x79, 0x34, 0x51, 0x53, 0x3b,
+	0xb2, 0x9f, 0x04, 0xfd, 0x0b, 0x3e, 0x0b, 0x89, 0xa5, 0x23, 0x23, 0x4a, 0x7e, 0x04, 0xd5, 0x50,
+	0x48, 0x25, 0x60, 0xf3, 0xbd, 0xe7, 0x3c, 0xc9, 0xd2, 0x85, 0xe3, 0x0c, 0x93, 0x82, 0x32, 0x95,
+	0xa1, 0x9a, 0x9d, 0x36, 0xdf, 0xa5, 0x35, 0x13, 0x1c, 0x95, 0xd6, 0x90, 0xe1, 0xfd, 0x06, 0x18,
+	0x35, 0xde, 0x47, 0xaf, 0x6d, 0x08, 0x6e, 0x56, 0x22, 0x17, 0xd0, 0x55, 0x59, 0x92, 0xeb, 0xeb,
+	0x54, 0xb0, 0x01, 0x1b, 0x46, 0xf1, 0x3a, 0xf2, 0x1d, 0x08, 0xb4, 0xd1, 0x0a, 0x45, 0x7b, 0xc0,
+	0x86, 0x9d, 0xf8, 0x33, 0xf0, 0x73, 0xe8, 0x3b, 0xd4, 0x14, 0xe3, 0x34, 0x77, 0x64, 0x17, 0xe3,
+	0xc2, 0xa8, 0xd9, 0x15, 0xe6, 0xd3, 0x8c, 0xc4, 0x96, 0xf7, 0xfe, 0xc2, 0xfc, 0x04, 0xb6, 0x57,
+	0xe8, 0x2e, 0x9f, 0xa3, 0xa3, 0x64, 0x5e, 0x8a, 0x8e, 0xf7, 0x37, 0x4b, 0x7e, 0x00, 0x91, 0x25,
+	0xf2, 0x77, 0x4e, 0x04, 0xde, 0xf8, 0x29, 0xb8, 0x04, 0xb0, 0x44, 0xb7, 0xa8, 0x8c, 0x4e, 0x9d,
+	0x08, 0x3d, 0x6e, 0x34, 0xfc, 0x12, 0xf6, 0x13, 0xed, 0x1e, 0xd1, 0x62, 0xfa, 0xdb, 0x0f, 0xbb,
+	0xfe, 0xe0, 0x3f, 0x85, 0xef, 0x41, 0x6f, 0x8d, 0x45, 0xcf, 0xeb, 0xdf, 0x99, 0xef, 0x42, 0xf8,
+	0x90, 0xe4, 0x05, 0xa6, 0x22, 0xf2, 0xe4, 0x2b, 0x8d, 0x2f, 0x5e, 0x2a, 0xc9, 0x96, 0x95, 0x64,
+	0xef, 0x95, 0x64, 0xcf, 0xb5, 0x6c, 0x2d, 0x6b, 0xd9, 0x7a, 0xab, 0x65, 0xeb, 0xfe, 0xb0, 0xb9,
+	0xcc, 0xd3, 0xc6, 0x4e, 0xb4, 0x28, 0xd1, 0x4d, 0x42, 0x3f, 0xd4, 0xd9, 0xc7, 0x00, 0x20, 0x40,
+	0x29, 0x4f, 0xcf, 0x01, 0x00, 0x00,
+}
+
+func (m *Probe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Probe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Probe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed != 0 {
+		i = encodeVarintProbe(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Answered != 0 {
+		i = encodeVarintProbe(dAtA, i, uint64(m.Answered))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AnsweredRegistryBlockHeight != 0 {
+		i = encodeVarintProbe(dAtA, i, uint64(m.AnsweredRegistryBlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RttSeconds != 0 {
+		i = encodeVarintProbe(dAtA, i, uint64(m.RttSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RttBlocks != 0 {
+		i = encodeVarintProbe(dAtA, i, uint64(m.RttBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SentTimestamp != 0 {
+		i = encodeVarintProbe(dAtA, i, uint64(m.SentTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SentRegistryBlockHeight != 0 {
+		i = encodeVarintProbe(dAtA, i, uint64(m.SentRegistryBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProbe(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProbe(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProbe(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProbe(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Probe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProbe(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProbe(uint64(m.Nonce))
+	}
+	if m.SentRegistryBlockHeight != 0 {
+		n += 1 + sovProbe(uint64(m.SentRegistryBlockHeight))
+	}
+	if m.SentTimestamp != 0 {
+		n += 1 + sovProbe(uint64(m.SentTimestamp))
+	}
+	if m.RttBlocks != 0 {
+		n += 1 + sovProbe(uint64(m.RttBlocks))
+	}
+	if m.RttSeconds != 0 {
+		n += 1 + sovProbe(uint64(m.RttSeconds))
+	}
+	if m.AnsweredRegistryBlockHeight != 0 {
+		n += 1 + sovProbe(uint64(m.AnsweredRegistryBlockHeight))
+	}
+	if m.Answered != 0 {
+		n += 1 + sovProbe(uint64(m.Answered))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovProbe(uint64(m.Failed))
+	}
+	return n
+}
+
+func sovProbe(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProbe(x uint64) (n int) {
+	return sovProbe(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Probe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Probe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Probe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProbe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentRegistryBlockHeight", wireType)
+			}
+			m.SentRegistryBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentRegistryBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentTimestamp", wireType)
+			}
+			m.SentTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RttBlocks", wireType)
+			}
+			m.RttBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RttBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RttSeconds", wireType)
+			}
+			m.RttSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RttSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnsweredRegistryBlockHeight", wireType)
+			}
+			m.AnsweredRegistryBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnsweredRegistryBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answered", wireType)
+			}
+			m.Answered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Answered |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProbe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProbe(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProbe
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProbe
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProbe
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProbe
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProbe
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProbe
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProbe        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProbe          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProbe = fmt.Errorf("proto: unexpected end of group")
+)
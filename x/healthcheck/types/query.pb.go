@@ -297,506 +297,4424 @@ func (m *QueryAllChainResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "healthcheck.healthcheck.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "healthcheck.healthcheck.QueryParamsResponse")
-	proto.RegisterType((*QueryGetChainRequest)(nil), "healthcheck.healthcheck.QueryGetChainRequest")
-	proto.RegisterType((*QueryGetChainResponse)(nil), "healthcheck.healthcheck.QueryGetChainResponse")
-	proto.RegisterType((*QueryAllChainRequest)(nil), "healthcheck.healthcheck.QueryAllChainRequest")
-	proto.RegisterType((*QueryAllChainResponse)(nil), "healthcheck.healthcheck.QueryAllChainResponse")
+type QueryRelayerStatsRequest struct {
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("healthcheck/healthcheck/query.proto", fileDescriptor_89748a99d0ba3c0a)
+func (m *QueryRelayerStatsRequest) Reset()         { *m = QueryRelayerStatsRequest{} }
+func (m *QueryRelayerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerStatsRequest) ProtoMessage()    {}
+func (*QueryRelayerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{6}
 }
-
-var fileDescriptor_89748a99d0ba3c0a = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xb1, 0x6f, 0xd4, 0x30,
-	0x14, 0xc6, 0xe3, 0x96, 0x3b, 0xc0, 0x6c, 0xe6, 0x10, 0x55, 0x84, 0x9c, 0x62, 0x50, 0x5b, 0x15,
-	0xb0, 0xdb, 0x32, 0x81, 0xc4, 0xd0, 0x22, 0x51, 0xb1, 0x95, 0xc0, 0xc4, 0x80, 0xe4, 0x0b, 0x56,
-	0x12, 0x91, 0xc6, 0xe9, 0xd9, 0x45, 0x54, 0x88, 0x85, 0x99, 0x01, 0xc4, 0xc2, 0xc0, 0xbf, 0x83,
-	0xd4, 0xb1, 0x12, 0x0b, 0x13, 0x42, 0x77, 0xfc, 0x21, 0x28, 0xb6, 0xab, 0x4b, 0x72, 0x4a, 0xee,
-	0xd8, 0x9c, 0xf8, 0xfb, 0xde, 0xf7, 0xb3, 0xdf, 0x33, 0xbc, 0x95, 0x08, 0x9e, 0xe9, 0x24, 0x4a,
-	0x44, 0xf4, 0x86, 0x55, 0xd7, 0x47, 0xc7, 0x62, 0x74, 0x42, 0x8b, 0x91, 0xd4, 0x12, 0x5d, 0xaf,
-	0x6c, 0xd0, 0xca, 0xda, 0x1f, 0xc4, 0x32, 0x96, 0x46, 0xc3, 0xca, 0x95, 0x95, 0xfb, 0x37, 0x62,
-	0x29, 0xe3, 0x4c, 0x30, 0x5e, 0xa4, 0x8c, 0xe7, 0xb9, 0xd4, 0x5c, 0xa7, 0x32, 0x57, 0x6e, 0x77,
-	0x33, 0x92, 0xea, 0x50, 0x2a, 0x36, 0xe4, 0x4a, 0xd8, 0x14, 0xf6, 0x76, 0x7b, 0x28, 0x34, 0xdf,
-	0x66, 0x05, 0x8f, 0xd3, 0xdc, 0x88, 0x9d, 0xf6, 0x76, 0x1b, 0x5d, 0xc1, 0x47, 0xfc, 0xf0, 0xbc,
-	0x62, 0xeb, 0x19, 0xa2, 0x84, 0xa7, 0xae, 0x14, 0x19, 0x40, 0xf4, 0xac, 0x0c, 0x3b, 0x30, 0xce,
-	0x50, 0x1c, 0x1d, 0x0b, 0xa5, 0xc9, 0x0b, 0x78, 0xb5, 0xf6, 0x57, 0x15, 0x32, 0x57, 0x02, 0x3d,
-	0x82, 0x7d, 0x9b, 0xb0, 0x02, 0x56, 0xc1, 0xc6, 0x95, 0x9d, 0x80, 0xb6, 0xdc, 0x00, 0xb5, 0xc6,
-	0xbd, 0x0b, 0xa7, 0xbf, 0x03, 0x2f, 0x74, 0x26, 0xb2, 0x05, 0x07, 0xa6, 0xea, 0xbe, 0xd0, 0x8f,
-	0x4b, 0x04, 0x97, 0x86, 0x56, 0xe0, 0x45, 0x83, 0xf4, 0xf4, 0xb5, 0xa9, 0x7b, 0x39, 0x3c, 0xff,
-	0x24, 0xcf, 0xe1, 0xb5, 0x86, 0xc3, 0x91, 0x3c, 0x84, 0x3d, 0xa3, 0x71, 0x20, 0xb8, 0x15, 0xc4,
-	0xd8, 0x1c, 0x87, 0xb5, 0x90, 0x57, 0x0e, 0x63, 0x37, 0xcb, 0x6a, 0x18, 0x4f, 0x20, 0x9c, 0xde,
-	0xb4, 0x2b, 0xbc, 0x46, 0x6d, 0x5b, 0x68, 0xd9, 0x16, 0x6a, 0x9b, 0xef, 0xda, 0x42, 0x0f, 0x78,
-	0x2c, 0x9c, 0x37, 0xac, 0x38, 0xc9, 0x77, 0xe0, 0xa8, 0xa7, 0x01, 0xb3, 0xd4, 0xcb, 0xff, 0x49,
-	0x8d, 0xf6, 0x6b, 0x74, 0x4b, 0x86, 0x6e, 0x7d, 0x2e, 0x9d, 0x0d, 0xae, 0xe2, 0xed, 0xfc, 0x58,
-	0x86, 0x3d, 0x83, 0x87, 0x3e, 0x01, 0xd8, 0xb7, 0x8d, 0x42, 0x77, 0x5a, 0x51, 0x66, 0xa7, 0xc3,
-	0xbf, 0xbb, 0x98, 0xd8, 0x66, 0x93, 0xf5, 0x8f, 0x3f, 0xff, 0x7e, 0x5d, 0xba, 0x89, 0x02, 0xd6,
-	0x3d, 0xb5, 0xe8, 0x1b, 0x80, 0x3d, 0x73, 0x70, 0x74, 0xaf, 0x3b, 0xa0, 0x31, 0x3f, 0x3e, 0x5d,
-	0x54, 0xee, 0x88, 0xb6, 0x0c, 0xd1, 0x26, 0xda, 0x60, 0x9d, 0x2f, 0x84, 0xbd, 0x77, 0x63, 0xf8,
-	0x01, 0x7d, 0x01, 0xf0, 0x92, 0xa9, 0xb1, 0x9b, 0x65, 0xf3, 0xe8, 0x1a, 0x63, 0x35, 0x8f, 0xae,
-	0x39, 0x24, 0x64, 0xcd, 0xd0, 0xad, 0x22, 0xdc, 0x4d, 0xb7, 0xf7, 0xe0, 0x74, 0x8c, 0xc1, 0xd9,
-	0x18, 0x83, 0x3f, 0x63, 0x0c, 0x3e, 0x4f, 0xb0, 0x77, 0x36, 0xc1, 0xde, 0xaf, 0x09, 0xf6, 0x5e,
-	0x06, 0x55, 0xf1, 0xbb, 0x9a, 0x55, 0x9f, 0x14, 0x42, 0x0d, 0xfb, 0xe6, 0xed, 0xdf, 0xff, 0x17,
-	0x00, 0x00, 0xff, 0xff, 0x19, 0x8f, 0x30, 0xcb, 0xe6, 0x04, 0x00, 0x00,
+func (m *QueryRelayerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of Chain items.
-	Chain(ctx context.Context, in *QueryGetChainRequest, opts ...grpc.CallOption) (*QueryGetChainResponse, error)
-	ChainAll(ctx context.Context, in *QueryAllChainRequest, opts ...grpc.CallOption) (*QueryAllChainResponse, error)
+func (m *QueryRelayerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRelayerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerStatsRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRelayerStatsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRelayerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerStatsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Chain(ctx context.Context, in *QueryGetChainRequest, opts ...grpc.CallOption) (*QueryGetChainResponse, error) {
-	out := new(QueryGetChainResponse)
-	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/Chain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryRelayerStatsRequest proto.InternalMessageInfo
 
-func (c *queryClient) ChainAll(ctx context.Context, in *QueryAllChainRequest, opts ...grpc.CallOption) (*QueryAllChainResponse, error) {
-	out := new(QueryAllChainResponse)
-	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/ChainAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRelayerStatsRequest) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of Chain items.
-	Chain(context.Context, *QueryGetChainRequest) (*QueryGetChainResponse, error)
-	ChainAll(context.Context, *QueryAllChainRequest) (*QueryAllChainResponse, error)
+type QueryRelayerStatsResponse struct {
+	RelayerStats RelayerStats `protobuf:"bytes,1,opt,name=relayerStats,proto3" json:"relayerStats"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryRelayerStatsResponse) Reset()         { *m = QueryRelayerStatsResponse{} }
+func (m *QueryRelayerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerStatsResponse) ProtoMessage()    {}
+func (*QueryRelayerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{7}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryRelayerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Chain(ctx context.Context, req *QueryGetChainRequest) (*QueryGetChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Chain not implemented")
+func (m *QueryRelayerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ChainAll(ctx context.Context, req *QueryAllChainRequest) (*QueryAllChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChainAll not implemented")
+func (m *QueryRelayerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerStatsResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryRelayerStatsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/healthcheck.healthcheck.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryRelayerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerStatsResponse.DiscardUnknown(m)
 }
 
-func _Query_Chain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Chain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/healthcheck.healthcheck.Query/Chain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Chain(ctx, req.(*QueryGetChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryRelayerStatsResponse proto.InternalMessageInfo
 
-func _Query_ChainAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ChainAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/healthcheck.healthcheck.Query/ChainAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ChainAll(ctx, req.(*QueryAllChainRequest))
+func (m *QueryRelayerStatsResponse) GetRelayerStats() RelayerStats {
+	if m != nil {
+		return m.RelayerStats
 	}
-	return interceptor(ctx, in, info, handler)
+	return RelayerStats{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "healthcheck.healthcheck.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Chain",
-			Handler:    _Query_Chain_Handler,
-		},
-		{
-			MethodName: "ChainAll",
-			Handler:    _Query_ChainAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "healthcheck/healthcheck/query.proto",
+type QueryRelayersForChainRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRelayersForChainRequest) Reset()         { *m = QueryRelayersForChainRequest{} }
+func (m *QueryRelayersForChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayersForChainRequest) ProtoMessage()    {}
+func (*QueryRelayersForChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{8}
+}
+func (m *QueryRelayersForChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayersForChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayersForChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRelayersForChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayersForChainRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRelayersForChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayersForChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayersForChainRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRelayersForChainRequest proto.InternalMessageInfo
+
+func (m *QueryRelayersForChainRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRelayersForChainResponse struct {
+	Relayers []ChainRelayer `protobuf:"bytes,1,rep,name=relayers,proto3" json:"relayers"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRelayersForChainResponse) Reset()         { *m = QueryRelayersForChainResponse{} }
+func (m *QueryRelayersForChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayersForChainResponse) ProtoMessage()    {}
+func (*QueryRelayersForChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{9}
+}
+func (m *QueryRelayersForChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayersForChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayersForChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRelayersForChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayersForChainResponse.Merge(m, src)
+}
+func (m *QueryRelayersForChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayersForChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayersForChainResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRelayersForChainResponse proto.InternalMessageInfo
+
+func (m *QueryRelayersForChainResponse) GetRelayers() []ChainRelayer {
+	if m != nil {
+		return m.Relayers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDecentralizationMetricsRequest struct {
+	ChainId    string             `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDecentralizationMetricsRequest) Reset()         { *m = QueryDecentralizationMetricsRequest{} }
+func (m *QueryDecentralizationMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDecentralizationMetricsRequest) ProtoMessage()    {}
+func (*QueryDecentralizationMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{10}
+}
+func (m *QueryDecentralizationMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecentralizationMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecentralizationMetricsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDecentralizationMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecentralizationMetricsRequest.Merge(m, src)
+}
+func (m *QueryDecentralizationMetricsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecentralizationMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecentralizationMetricsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDecentralizationMetricsRequest proto.InternalMessageInfo
+
+func (m *QueryDecentralizationMetricsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDecentralizationMetricsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
+type QueryDecentralizationMetricsResponse struct {
+	Samples    []DecentralizationSample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples"`
+	Pagination *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDecentralizationMetricsResponse) Reset()         { *m = QueryDecentralizationMetricsResponse{} }
+func (m *QueryDecentralizationMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDecentralizationMetricsResponse) ProtoMessage()    {}
+func (*QueryDecentralizationMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{11}
+}
+func (m *QueryDecentralizationMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecentralizationMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecentralizationMetricsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDecentralizationMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecentralizationMetricsResponse.Merge(m, src)
+}
+func (m *QueryDecentralizationMetricsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecentralizationMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecentralizationMetricsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDecentralizationMetricsResponse proto.InternalMessageInfo
+
+func (m *QueryDecentralizationMetricsResponse) GetSamples() []DecentralizationSample {
+	if m != nil {
+		return m.Samples
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDecentralizationMetricsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryChainIndicatorsRequest struct {
+	ChainId    string             `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChainIndicatorsRequest) Reset()         { *m = QueryChainIndicatorsRequest{} }
+func (m *QueryChainIndicatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainIndicatorsRequest) ProtoMessage()    {}
+func (*QueryChainIndicatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{12}
+}
+func (m *QueryChainIndicatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainIndicatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainIndicatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryChainIndicatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainIndicatorsRequest.Merge(m, src)
+}
+func (m *QueryChainIndicatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainIndicatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainIndicatorsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChainIndicatorsRequest proto.InternalMessageInfo
+
+func (m *QueryChainIndicatorsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryChainIndicatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Chain) > 0 {
-		for iNdEx := len(m.Chain) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+type QueryChainIndicatorsResponse struct {
+	Indicators []ChainIndicator    `protobuf:"bytes,1,rep,name=indicators,proto3" json:"indicators"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChainIndicatorsResponse) Reset()         { *m = QueryChainIndicatorsResponse{} }
+func (m *QueryChainIndicatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainIndicatorsResponse) ProtoMessage()    {}
+func (*QueryChainIndicatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{13}
+}
+func (m *QueryChainIndicatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainIndicatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainIndicatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryChainIndicatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainIndicatorsResponse.Merge(m, src)
+}
+func (m *QueryChainIndicatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainIndicatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainIndicatorsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryChainIndicatorsResponse proto.InternalMessageInfo
+
+func (m *QueryChainIndicatorsResponse) GetIndicators() []ChainIndicator {
+	if m != nil {
+		return m.Indicators
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryChainIndicatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryTopologyRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTopologyRequest) Reset()         { *m = QueryTopologyRequest{} }
+func (m *QueryTopologyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopologyRequest) ProtoMessage()    {}
+func (*QueryTopologyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{14}
+}
+func (m *QueryTopologyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopologyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopologyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryTopologyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopologyRequest.Merge(m, src)
+}
+func (m *QueryTopologyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopologyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopologyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryTopologyRequest proto.InternalMessageInfo
+
+func (m *QueryTopologyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Chain.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllChainRequest) Size() (n int) {
-	if m == nil {
+type QueryTopologyResponse struct {
+	Links      []ChainLink         `protobuf:"bytes,1,rep,name=links,proto3" json:"links"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTopologyResponse) Reset()         { *m = QueryTopologyResponse{} }
+func (m *QueryTopologyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopologyResponse) ProtoMessage()    {}
+func (*QueryTopologyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{15}
+}
+func (m *QueryTopologyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopologyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopologyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTopologyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopologyResponse.Merge(m, src)
+}
+func (m *QueryTopologyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopologyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopologyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopologyResponse proto.InternalMessageInfo
+
+func (m *QueryTopologyResponse) GetLinks() []ChainLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func (m *QueryTopologyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryChainNeighborsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+}
+
+func (m *QueryChainNeighborsRequest) Reset()         { *m = QueryChainNeighborsRequest{} }
+func (m *QueryChainNeighborsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainNeighborsRequest) ProtoMessage()    {}
+func (*QueryChainNeighborsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{16}
+}
+func (m *QueryChainNeighborsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainNeighborsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainNeighborsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainNeighborsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainNeighborsRequest.Merge(m, src)
+}
+func (m *QueryChainNeighborsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainNeighborsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainNeighborsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainNeighborsRequest proto.InternalMessageInfo
+
+func (m *QueryChainNeighborsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryChainNeighborsResponse struct {
+	Neighbors []ChainNeighbor `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors"`
+}
+
+func (m *QueryChainNeighborsResponse) Reset()         { *m = QueryChainNeighborsResponse{} }
+func (m *QueryChainNeighborsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainNeighborsResponse) ProtoMessage()    {}
+func (*QueryChainNeighborsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{17}
+}
+func (m *QueryChainNeighborsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainNeighborsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainNeighborsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainNeighborsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainNeighborsResponse.Merge(m, src)
+}
+func (m *QueryChainNeighborsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainNeighborsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainNeighborsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainNeighborsResponse proto.InternalMessageInfo
+
+func (m *QueryChainNeighborsResponse) GetNeighbors() []ChainNeighbor {
+	if m != nil {
+		return m.Neighbors
+	}
+	return nil
+}
+
+type QueryHeartbeatsRequest struct {
+	ChainId    string             `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHeartbeatsRequest) Reset()         { *m = QueryHeartbeatsRequest{} }
+func (m *QueryHeartbeatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeartbeatsRequest) ProtoMessage()    {}
+func (*QueryHeartbeatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{18}
+}
+func (m *QueryHeartbeatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeartbeatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeartbeatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeartbeatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeartbeatsRequest.Merge(m, src)
+}
+func (m *QueryHeartbeatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeartbeatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeartbeatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeartbeatsRequest proto.InternalMessageInfo
+
+func (m *QueryHeartbeatsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryHeartbeatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryHeartbeatsResponse struct {
+	Heartbeats []Heartbeat         `protobuf:"bytes,1,rep,name=heartbeats,proto3" json:"heartbeats"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHeartbeatsResponse) Reset()         { *m = QueryHeartbeatsResponse{} }
+func (m *QueryHeartbeatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeartbeatsResponse) ProtoMessage()    {}
+func (*QueryHeartbeatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{19}
+}
+func (m *QueryHeartbeatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeartbeatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeartbeatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeartbeatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeartbeatsResponse.Merge(m, src)
+}
+func (m *QueryHeartbeatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeartbeatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeartbeatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeartbeatsResponse proto.InternalMessageInfo
+
+func (m *QueryHeartbeatsResponse) GetHeartbeats() []Heartbeat {
+	if m != nil {
+		return m.Heartbeats
+	}
+	return nil
+}
+
+func (m *QueryHeartbeatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryProbeRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+}
+
+func (m *QueryProbeRequest) Reset()         { *m = QueryProbeRequest{} }
+func (m *QueryProbeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProbeRequest) ProtoMessage()    {}
+func (*QueryProbeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{20}
+}
+func (m *QueryProbeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProbeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProbeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProbeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProbeRequest.Merge(m, src)
+}
+func (m *QueryProbeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProbeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProbeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProbeRequest proto.InternalMessageInfo
+
+func (m *QueryProbeRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryProbeResponse struct {
+	Probe Probe `protobuf:"bytes,1,opt,name=probe,proto3" json:"probe"`
+}
+
+func (m *QueryProbeResponse) Reset()         { *m = QueryProbeResponse{} }
+func (m *QueryProbeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProbeResponse) ProtoMessage()    {}
+func (*QueryProbeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{21}
+}
+func (m *QueryProbeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProbeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProbeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProbeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProbeResponse.Merge(m, src)
+}
+func (m *QueryProbeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProbeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProbeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProbeResponse proto.InternalMessageInfo
+
+func (m *QueryProbeResponse) GetProbe() Probe {
+	if m != nil {
+		return m.Probe
+	}
+	return Probe{}
+}
+
+type QueryRelayLatencyRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+}
+
+func (m *QueryRelayLatencyRequest) Reset()         { *m = QueryRelayLatencyRequest{} }
+func (m *QueryRelayLatencyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayLatencyRequest) ProtoMessage()    {}
+func (*QueryRelayLatencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{22}
+}
+func (m *QueryRelayLatencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayLatencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayLatencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayLatencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayLatencyRequest.Merge(m, src)
+}
+func (m *QueryRelayLatencyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayLatencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayLatencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayLatencyRequest proto.InternalMessageInfo
+
+func (m *QueryRelayLatencyRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryRelayLatencyResponse struct {
+	Percentiles LatencyPercentiles `protobuf:"bytes,1,opt,name=percentiles,proto3" json:"percentiles"`
+	// latency (in milliseconds) of the last healthcheck update
+	Last     uint64 `protobuf:"varint,2,opt,name=last,proto3" json:"last,omitempty"`
+	Measured uint64 `protobuf:"varint,3,opt,name=measured,proto3" json:"measured,omitempty"`
+}
+
+func (m *QueryRelayLatencyResponse) Reset()         { *m = QueryRelayLatencyResponse{} }
+func (m *QueryRelayLatencyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayLatencyResponse) ProtoMessage()    {}
+func (*QueryRelayLatencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89748a99d0ba3c0a, []int{23}
+}
+func (m *QueryRelayLatencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayLatencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayLatencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayLatencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayLatencyResponse.Merge(m, src)
+}
+func (m *QueryRelayLatencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayLatencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayLatencyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayLatencyResponse proto.InternalMessageInfo
+
+func (m *QueryRelayLatencyResponse) GetPercentiles() LatencyPercentiles {
+	if m != nil {
+		return m.Percentiles
+	}
+	return LatencyPercentiles{}
+}
+
+func (m *QueryRelayLatencyResponse) GetLast() uint64 {
+	if m != nil {
+		return m.Last
+	}
+	return 0
+}
+
+func (m *QueryRelayLatencyResponse) GetMeasured() uint64 {
+	if m != nil {
+		return m.Measured
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "healthcheck.healthcheck.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "healthcheck.healthcheck.QueryParamsResponse")
+	proto.RegisterType((*QueryGetChainRequest)(nil), "healthcheck.healthcheck.QueryGetChainRequest")
+	proto.RegisterType((*QueryGetChainResponse)(nil), "healthcheck.healthcheck.QueryGetChainResponse")
+	proto.RegisterType((*QueryAllChainRequest)(nil), "healthcheck.healthcheck.QueryAllChainRequest")
+	proto.RegisterType((*QueryAllChainResponse)(nil), "healthcheck.healthcheck.QueryAllChainResponse")
+	proto.RegisterType((*QueryRelayerStatsRequest)(nil), "healthcheck.healthcheck.QueryRelayerStatsRequest")
+	proto.RegisterType((*QueryRelayerStatsResponse)(nil), "healthcheck.healthcheck.QueryRelayerStatsResponse")
+	proto.RegisterType((*QueryRelayersForChainRequest)(nil), "healthcheck.healthcheck.QueryRelayersForChainRequest")
+	proto.RegisterType((*QueryRelayersForChainResponse)(nil), "healthcheck.healthcheck.QueryRelayersForChainResponse")
+	proto.RegisterType((*QueryDecentralizationMetricsRequest)(nil), "healthcheck.healthcheck.QueryDecentralizationMetricsRequest")
+	proto.RegisterType((*QueryDecentralizationMetricsResponse)(nil), "healthcheck.healthcheck.QueryDecentralizationMetricsResponse")
+	proto.RegisterType((*QueryChainIndicatorsRequest)(nil), "healthcheck.healthcheck.QueryChainIndicatorsRequest")
+	proto.RegisterType((*QueryChainIndicatorsResponse)(nil), "healthcheck.healthcheck.QueryChainIndicatorsResponse")
+	proto.RegisterType((*QueryTopologyRequest)(nil), "healthcheck.healthcheck.QueryTopologyRequest")
+	proto.RegisterType((*QueryTopologyResponse)(nil), "healthcheck.healthcheck.QueryTopologyResponse")
+	proto.RegisterType((*QueryChainNeighborsRequest)(nil), "healthcheck.healthcheck.QueryChainNeighborsRequest")
+	proto.RegisterType((*QueryChainNeighborsResponse)(nil), "healthcheck.healthcheck.QueryChainNeighborsResponse")
+	proto.RegisterType((*QueryHeartbeatsRequest)(nil), "healthcheck.healthcheck.QueryHeartbeatsRequest")
+	proto.RegisterType((*QueryHeartbeatsResponse)(nil), "healthcheck.healthcheck.QueryHeartbeatsResponse")
+	proto.RegisterType((*QueryProbeRequest)(nil), "healthcheck.healthcheck.QueryProbeRequest")
+	proto.RegisterType((*QueryProbeResponse)(nil), "healthcheck.healthcheck.QueryProbeResponse")
+	proto.RegisterType((*QueryRelayLatencyRequest)(nil), "healthcheck.healthcheck.QueryRelayLatencyRequest")
+	proto.RegisterType((*QueryRelayLatencyResponse)(nil), "healthcheck.healthcheck.QueryRelayLatencyResponse")
+}
+
+func init() {
+	proto.RegisterFile("healthcheck/healthcheck/query.proto", fileDescriptor_89748a99d0ba3c0a)
+}
+
+var fileDescriptor_89748a99d0ba3c0a = []byte{
+	// 1216 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0xcf, 0xb4, 0xd9, 0x34, 0x7d, 0xad, 0xa0, 0x0c, 0x29, 0x49, 0xdd, 0xb0, 0x69, 0x9d, 0x26,
+	0x29, 0x29, 0xb1, 0x93, 0x4d, 0xb6, 0x94, 0x96, 0x20, 0xb5, 0x45, 0x49, 0x8b, 0x5a, 0x08, 0x9b,
+	0x9e, 0x38, 0xb0, 0x9a, 0xdd, 0x0c, 0xbb, 0x56, 0xbc, 0xb6, 0x6b, 0x3b, 0x88, 0xb4, 0xaa, 0x90,
+	0xb8, 0x70, 0xe1, 0xc0, 0x97, 0x04, 0x07, 0x0e, 0x3d, 0x71, 0x42, 0xe2, 0x06, 0x07, 0x2e, 0x3d,
+	0xf6, 0x58, 0x89, 0x0b, 0x12, 0x12, 0x42, 0x09, 0x7f, 0x48, 0xb5, 0xe3, 0xe7, 0xf5, 0xac, 0xb3,
+	0xfe, 0xd8, 0x6a, 0x95, 0x9b, 0xed, 0x7d, 0xbf, 0xf7, 0x7e, 0xef, 0xc3, 0x6f, 0x7e, 0x5e, 0x98,
+	0x6e, 0x72, 0x66, 0xfa, 0xcd, 0x7a, 0x93, 0xd7, 0xb7, 0x75, 0xf9, 0xfa, 0xfe, 0x0e, 0x77, 0x77,
+	0x35, 0xc7, 0xb5, 0x7d, 0x9b, 0x8e, 0x4b, 0x3f, 0x68, 0xd2, 0xb5, 0x32, 0xd6, 0xb0, 0x1b, 0xb6,
+	0xb0, 0xd1, 0xdb, 0x57, 0x81, 0xb9, 0x32, 0xd9, 0xb0, 0xed, 0x86, 0xc9, 0x75, 0xe6, 0x18, 0x3a,
+	0xb3, 0x2c, 0xdb, 0x67, 0xbe, 0x61, 0x5b, 0x1e, 0xfe, 0x3a, 0x5f, 0xb7, 0xbd, 0x96, 0xed, 0xe9,
+	0x35, 0xe6, 0xf1, 0x20, 0x8a, 0xfe, 0xd9, 0x52, 0x8d, 0xfb, 0x6c, 0x49, 0x77, 0x58, 0xc3, 0xb0,
+	0x84, 0x31, 0xda, 0x5e, 0x48, 0x62, 0xe7, 0x30, 0x97, 0xb5, 0x42, 0x8f, 0x89, 0x39, 0xd4, 0x9b,
+	0xcc, 0x08, 0x5d, 0xcd, 0x24, 0x19, 0xb9, 0xdc, 0x64, 0xbb, 0xdc, 0x45, 0x33, 0x2d, 0xc9, 0x6c,
+	0x8b, 0xd7, 0xb9, 0xe5, 0xbb, 0xcc, 0x34, 0x1e, 0xc8, 0x0c, 0xe7, 0x92, 0xec, 0x0d, 0x6b, 0xcb,
+	0xa8, 0x33, 0xdf, 0x0e, 0x1d, 0xcf, 0x26, 0x19, 0xfa, 0xb6, 0x63, 0x9b, 0x76, 0x63, 0x37, 0xcb,
+	0x61, 0x93, 0x33, 0xd7, 0xaf, 0x71, 0xe6, 0x67, 0x65, 0xed, 0xb8, 0x76, 0x8d, 0x67, 0x65, 0x6d,
+	0x32, 0x9f, 0x5b, 0x75, 0x0c, 0xaa, 0x8e, 0x01, 0xfd, 0xa8, 0xdd, 0x89, 0x0d, 0x51, 0xd6, 0x0a,
+	0xbf, 0xbf, 0xc3, 0x3d, 0x5f, 0xbd, 0x07, 0xaf, 0x76, 0x3d, 0xf5, 0x1c, 0xdb, 0xf2, 0x38, 0x5d,
+	0x85, 0x91, 0xa0, 0xfc, 0x13, 0xe4, 0x1c, 0xb9, 0x78, 0xa2, 0x34, 0xa5, 0x25, 0x8c, 0x87, 0x16,
+	0x00, 0x6f, 0x0c, 0x3f, 0xfd, 0x77, 0x6a, 0xa8, 0x82, 0x20, 0x75, 0x11, 0xc6, 0x84, 0xd7, 0x75,
+	0xee, 0xdf, 0x6c, 0xf7, 0x07, 0xa3, 0xd1, 0x09, 0x38, 0x26, 0xfa, 0x75, 0x7b, 0x4b, 0xf8, 0x3d,
+	0x5e, 0x09, 0x6f, 0xd5, 0x4d, 0x38, 0x1d, 0x43, 0x20, 0x93, 0xab, 0x50, 0x10, 0x36, 0x48, 0xa4,
+	0x98, 0x48, 0x44, 0xc0, 0x90, 0x47, 0x00, 0x51, 0x3f, 0x41, 0x1a, 0xd7, 0x4d, 0xb3, 0x8b, 0xc6,
+	0x1a, 0x40, 0x34, 0x86, 0xe8, 0x78, 0x56, 0x0b, 0x66, 0x56, 0x6b, 0xcf, 0xac, 0x16, 0xbc, 0x19,
+	0x38, 0xb3, 0xda, 0x06, 0x6b, 0x70, 0xc4, 0x56, 0x24, 0xa4, 0xfa, 0x33, 0x81, 0xd3, 0xb1, 0x00,
+	0x07, 0x59, 0x1f, 0xed, 0x93, 0x35, 0x5d, 0xef, 0x62, 0x77, 0x44, 0xb0, 0x9b, 0xcb, 0x64, 0x17,
+	0x04, 0xee, 0xa2, 0xb7, 0x02, 0x13, 0x82, 0x5d, 0x25, 0x98, 0xfe, 0x4d, 0x9f, 0xf9, 0x9e, 0xd4,
+	0x09, 0x7c, 0x29, 0xc2, 0x4e, 0xe0, 0xad, 0x6a, 0xc2, 0x99, 0x1e, 0x28, 0xcc, 0xeb, 0x43, 0x38,
+	0xe9, 0x4a, 0xcf, 0xb1, 0x76, 0x33, 0x89, 0xe9, 0xc9, 0x4e, 0x30, 0xcb, 0x2e, 0x07, 0xea, 0x15,
+	0x98, 0x94, 0xa3, 0x79, 0x6b, 0xb6, 0x9b, 0x73, 0x62, 0x9a, 0xf0, 0x7a, 0x02, 0x12, 0xb9, 0xae,
+	0xc3, 0x28, 0x86, 0xf2, 0xb0, 0x0d, 0x33, 0xe9, 0x6d, 0x40, 0x4f, 0xc8, 0xb3, 0x03, 0x56, 0xbf,
+	0x22, 0x30, 0x2d, 0x42, 0xbd, 0x17, 0xdb, 0x0f, 0x77, 0xb9, 0xef, 0x1a, 0x75, 0x2f, 0x93, 0x2b,
+	0x5d, 0xeb, 0xd1, 0xd2, 0x17, 0x19, 0xb8, 0x27, 0x04, 0x2e, 0xa4, 0x33, 0xe9, 0xf4, 0xe9, 0x98,
+	0xc7, 0x5a, 0x8e, 0xc9, 0xc3, 0xd4, 0xf5, 0xc4, 0xd4, 0xe3, 0xae, 0x36, 0x05, 0x0e, 0x8b, 0x10,
+	0x7a, 0x19, 0xdc, 0x50, 0x7e, 0x01, 0x67, 0x45, 0x06, 0xa2, 0xe2, 0xb7, 0xc3, 0x05, 0x7a, 0x88,
+	0x35, 0xfc, 0x9d, 0xc0, 0x64, 0x6f, 0x06, 0x58, 0xbb, 0xbb, 0x00, 0x9d, 0xc5, 0x1e, 0x96, 0x6f,
+	0x2e, 0x7d, 0x72, 0x3a, 0x5e, 0xb0, 0x6c, 0x92, 0x83, 0xc1, 0x55, 0x2e, 0xdc, 0x66, 0xf7, 0xf0,
+	0x30, 0x19, 0xf4, 0x36, 0x7b, 0x1c, 0x6e, 0xb3, 0x28, 0x00, 0x56, 0xe4, 0x5d, 0x28, 0x98, 0x86,
+	0xb5, 0x1d, 0x16, 0x43, 0x4d, 0x2f, 0xc6, 0x1d, 0xc3, 0xda, 0x0e, 0x37, 0x9a, 0x80, 0x0d, 0xae,
+	0x04, 0x97, 0x41, 0x89, 0x5a, 0xf7, 0x01, 0x37, 0x1a, 0xcd, 0x5a, 0x9e, 0xd9, 0x51, 0x0d, 0x38,
+	0xdb, 0x13, 0x87, 0xf9, 0xbd, 0x0f, 0xc7, 0xad, 0xf0, 0x21, 0xe6, 0x38, 0x9b, 0x9e, 0x63, 0xe8,
+	0x03, 0xf3, 0x8c, 0xe0, 0xea, 0x03, 0x78, 0x4d, 0x84, 0xba, 0x15, 0x1e, 0xe5, 0x87, 0x38, 0xda,
+	0xbf, 0x12, 0x18, 0x3f, 0x10, 0x1c, 0x73, 0xbc, 0x05, 0xd0, 0x51, 0x17, 0xd9, 0x8d, 0xec, 0x38,
+	0x08, 0x07, 0x3a, 0xc2, 0x0e, 0xae, 0x9b, 0x0b, 0xf0, 0x4a, 0xa0, 0x3d, 0xda, 0x62, 0x26, 0xbb,
+	0x89, 0x1b, 0x40, 0x65, 0xf3, 0xe8, 0xa4, 0x15, 0x62, 0x28, 0x53, 0x1f, 0x08, 0x58, 0x38, 0x97,
+	0x02, 0xd2, 0x7d, 0x40, 0xde, 0x09, 0xd4, 0x52, 0x36, 0x8f, 0xc7, 0x04, 0xce, 0xf4, 0x80, 0x21,
+	0x9f, 0x4d, 0x38, 0xe1, 0x70, 0xb7, 0xce, 0x2d, 0xdf, 0x08, 0xb6, 0x6f, 0x9b, 0xd5, 0xa5, 0x44,
+	0x56, 0x08, 0xdf, 0x88, 0x20, 0x48, 0x51, 0xf6, 0x42, 0x29, 0x0c, 0x9b, 0xcc, 0xf3, 0x45, 0xb1,
+	0x87, 0x2b, 0xe2, 0x9a, 0x2a, 0x30, 0xda, 0xe2, 0xcc, 0xdb, 0x71, 0xf9, 0xd6, 0xc4, 0x51, 0xf1,
+	0xbc, 0x73, 0x5f, 0xfa, 0xf1, 0x14, 0x14, 0x04, 0x45, 0xfa, 0x35, 0x81, 0x91, 0x40, 0xa2, 0xd1,
+	0x64, 0x12, 0x07, 0x75, 0xa1, 0xf2, 0x66, 0x3e, 0xe3, 0x20, 0x69, 0x75, 0xee, 0xcb, 0xbf, 0xfe,
+	0xff, 0xfe, 0xc8, 0x79, 0x3a, 0xa5, 0xa7, 0x8b, 0x79, 0xfa, 0x13, 0x81, 0x82, 0x78, 0x81, 0xe8,
+	0x42, 0x7a, 0x80, 0x98, 0x72, 0x54, 0xb4, 0xbc, 0xe6, 0xc8, 0x68, 0x51, 0x30, 0x9a, 0xa7, 0x17,
+	0xf5, 0xd4, 0x0f, 0x07, 0xfd, 0x21, 0x76, 0xf5, 0x11, 0xfd, 0x96, 0xc0, 0xa8, 0xf0, 0x71, 0xdd,
+	0x34, 0xb3, 0xd8, 0xc5, 0x04, 0xa5, 0xa2, 0xe5, 0x35, 0x47, 0x76, 0xb3, 0x82, 0xdd, 0x39, 0x5a,
+	0x4c, 0x67, 0x47, 0x7f, 0x23, 0x70, 0x52, 0x96, 0x50, 0x74, 0x29, 0x3d, 0x50, 0x0f, 0xa5, 0xa7,
+	0x94, 0xfa, 0x81, 0x20, 0xbf, 0x2b, 0x82, 0x5f, 0x89, 0x2e, 0xea, 0x19, 0x5f, 0x54, 0x55, 0xaf,
+	0x8d, 0xd3, 0x1f, 0xe2, 0xed, 0x23, 0xfa, 0x84, 0xc0, 0xa9, 0xb8, 0x22, 0xa3, 0xe5, 0x5c, 0x14,
+	0xe2, 0xda, 0x4f, 0xb9, 0xdc, 0x2f, 0x0c, 0xd9, 0xaf, 0x0a, 0xf6, 0x6f, 0xd1, 0x72, 0x16, 0x7b,
+	0xaf, 0xfa, 0xa9, 0xed, 0x56, 0xe3, 0x83, 0xf0, 0x0f, 0x81, 0xf1, 0x04, 0x7d, 0x45, 0xdf, 0x49,
+	0xa7, 0x94, 0x2e, 0x10, 0x95, 0xd5, 0x17, 0x44, 0x63, 0x5e, 0x37, 0x45, 0x5e, 0xab, 0xf4, 0x9a,
+	0x9e, 0xf7, 0x03, 0xb6, 0xda, 0x0a, 0x5c, 0x48, 0xd9, 0xfd, 0x49, 0xe0, 0xe5, 0x98, 0xf2, 0xa1,
+	0x2b, 0xe9, 0xbc, 0x7a, 0x4b, 0x35, 0xa5, 0xdc, 0x27, 0x0a, 0xb3, 0xb8, 0x26, 0xb2, 0x28, 0xd3,
+	0xe5, 0xf4, 0xd9, 0xaf, 0x46, 0x12, 0x4a, 0x62, 0xff, 0x03, 0x81, 0xd1, 0x50, 0x9e, 0x64, 0xbd,
+	0xa4, 0x31, 0x9d, 0xa4, 0x68, 0x79, 0xcd, 0x91, 0xe8, 0x1b, 0x82, 0xe8, 0x34, 0x3d, 0xaf, 0x67,
+	0x7d, 0xd6, 0xd3, 0x3f, 0x08, 0xbc, 0xd4, 0xad, 0x2d, 0xe8, 0x72, 0x8e, 0xea, 0xc4, 0x15, 0x8c,
+	0xb2, 0xd2, 0x1f, 0x08, 0x89, 0x5e, 0x15, 0x44, 0x57, 0x68, 0x29, 0xa3, 0xa2, 0x1d, 0x91, 0x22,
+	0x15, 0xf4, 0x17, 0x02, 0x10, 0xa9, 0x05, 0xaa, 0xa7, 0x13, 0x38, 0x20, 0x6a, 0x94, 0xc5, 0xfc,
+	0x00, 0x64, 0x5b, 0x16, 0x6c, 0x75, 0xba, 0xa0, 0x67, 0xfe, 0x0b, 0x22, 0x13, 0xfd, 0x8e, 0x40,
+	0x41, 0x1c, 0xe1, 0x74, 0x3e, 0xe3, 0x68, 0x92, 0xd4, 0x84, 0x72, 0x29, 0x97, 0x6d, 0xee, 0x33,
+	0x43, 0xc8, 0x06, 0x89, 0x54, 0x67, 0x3f, 0xe3, 0x31, 0x9e, 0x6b, 0x3f, 0x77, 0x0b, 0x0d, 0xa5,
+	0xd4, 0x0f, 0xa4, 0xbf, 0xfd, 0x5c, 0xc5, 0x7f, 0x80, 0x22, 0xc6, 0x37, 0xde, 0x7e, 0xba, 0x57,
+	0x24, 0xcf, 0xf6, 0x8a, 0xe4, 0xbf, 0xbd, 0x22, 0xf9, 0x66, 0xbf, 0x38, 0xf4, 0x6c, 0xbf, 0x38,
+	0xf4, 0xf7, 0x7e, 0x71, 0xe8, 0xe3, 0x29, 0x19, 0xfe, 0x79, 0xf7, 0x9c, 0xef, 0x3a, 0xdc, 0xab,
+	0x8d, 0x88, 0xff, 0x91, 0x96, 0x9f, 0x0f, 0x00, 0xfb, 0xed, 0x1d, 0x21, 0x4f, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Chain items.
+	Chain(ctx context.Context, in *QueryGetChainRequest, opts ...grpc.CallOption) (*QueryGetChainResponse, error)
+	ChainAll(ctx context.Context, in *QueryAllChainRequest, opts ...grpc.CallOption) (*QueryAllChainResponse, error)
+	// Queries the delivery statistics of a relayer.
+	RelayerStats(ctx context.Context, in *QueryRelayerStatsRequest, opts ...grpc.CallOption) (*QueryRelayerStatsResponse, error)
+	// Queries the relayers that delivered healthcheck packets of a chain, ordered by the number of delivered packets.
+	RelayersForChain(ctx context.Context, in *QueryRelayersForChainRequest, opts ...grpc.CallOption) (*QueryRelayersForChainResponse, error)
+	// Queries the time series of the decentralization metrics reported by a chain.
+	DecentralizationMetrics(ctx context.Context, in *QueryDecentralizationMetricsRequest, opts ...grpc.CallOption) (*QueryDecentralizationMetricsResponse, error)
+	// Queries the latest values of the health indicators reported by a chain.
+	ChainIndicators(ctx context.Context, in *QueryChainIndicatorsRequest, opts ...grpc.CallOption) (*QueryChainIndicatorsResponse, error)
+	// Queries the interchain topology built from the IBC connectivity reported by the monitored chains.
+	Topology(ctx context.Context, in *QueryTopologyRequest, opts ...grpc.CallOption) (*QueryTopologyResponse, error)
+	// Queries the chains connected to a chain, as reported by the chain itself or by its counterparties.
+	ChainNeighbors(ctx context.Context, in *QueryChainNeighborsRequest, opts ...grpc.CallOption) (*QueryChainNeighborsResponse, error)
+	// Queries the history of the out-of-band heartbeats sent by a chain on notable events.
+	Heartbeats(ctx context.Context, in *QueryHeartbeatsRequest, opts ...grpc.CallOption) (*QueryHeartbeatsResponse, error)
+	// Queries the liveness probes sent to a chain and the round-trip time of the last answered one.
+	Probe(ctx context.Context, in *QueryProbeRequest, opts ...grpc.CallOption) (*QueryProbeResponse, error)
+	// Queries the relay latency percentiles of the last healthcheck updates of a chain.
+	RelayLatency(ctx context.Context, in *QueryRelayLatencyRequest, opts ...grpc.CallOption) (*QueryRelayLatencyResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Chain(ctx context.Context, in *QueryGetChainRequest, opts ...grpc.CallOption) (*QueryGetChainResponse, error) {
+	out := new(QueryGetChainResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/Chain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChainAll(ctx context.Context, in *QueryAllChainRequest, opts ...grpc.CallOption) (*QueryAllChainResponse, error) {
+	out := new(QueryAllChainResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/ChainAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelayerStats(ctx context.Context, in *QueryRelayerStatsRequest, opts ...grpc.CallOption) (*QueryRelayerStatsResponse, error) {
+	out := new(QueryRelayerStatsResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/RelayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelayersForChain(ctx context.Context, in *QueryRelayersForChainRequest, opts ...grpc.CallOption) (*QueryRelayersForChainResponse, error) {
+	out := new(QueryRelayersForChainResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/RelayersForChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DecentralizationMetrics(ctx context.Context, in *QueryDecentralizationMetricsRequest, opts ...grpc.CallOption) (*QueryDecentralizationMetricsResponse, error) {
+	out := new(QueryDecentralizationMetricsResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/DecentralizationMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChainIndicators(ctx context.Context, in *QueryChainIndicatorsRequest, opts ...grpc.CallOption) (*QueryChainIndicatorsResponse, error) {
+	out := new(QueryChainIndicatorsResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/ChainIndicators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Topology(ctx context.Context, in *QueryTopologyRequest, opts ...grpc.CallOption) (*QueryTopologyResponse, error) {
+	out := new(QueryTopologyResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/Topology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChainNeighbors(ctx context.Context, in *QueryChainNeighborsRequest, opts ...grpc.CallOption) (*QueryChainNeighborsResponse, error) {
+	out := new(QueryChainNeighborsResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/ChainNeighbors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Heartbeats(ctx context.Context, in *QueryHeartbeatsRequest, opts ...grpc.CallOption) (*QueryHeartbeatsResponse, error) {
+	out := new(QueryHeartbeatsResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/Heartbeats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Probe(ctx context.Context, in *QueryProbeRequest, opts ...grpc.CallOption) (*QueryProbeResponse, error) {
+	out := new(QueryProbeResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/Probe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelayLatency(ctx context.Context, in *QueryRelayLatencyRequest, opts ...grpc.CallOption) (*QueryRelayLatencyResponse, error) {
+	out := new(QueryRelayLatencyResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Query/RelayLatency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Chain items.
+	Chain(context.Context, *QueryGetChainRequest) (*QueryGetChainResponse, error)
+	ChainAll(context.Context, *QueryAllChainRequest) (*QueryAllChainResponse, error)
+	// Queries the delivery statistics of a relayer.
+	RelayerStats(context.Context, *QueryRelayerStatsRequest) (*QueryRelayerStatsResponse, error)
+	// Queries the relayers that delivered healthcheck packets of a chain, ordered by the number of delivered packets.
+	RelayersForChain(context.Context, *QueryRelayersForChainRequest) (*QueryRelayersForChainResponse, error)
+	// Queries the time series of the decentralization metrics reported by a chain.
+	DecentralizationMetrics(context.Context, *QueryDecentralizationMetricsRequest) (*QueryDecentralizationMetricsResponse, error)
+	// Queries the latest values of the health indicators reported by a chain.
+	ChainIndicators(context.Context, *QueryChainIndicatorsRequest) (*QueryChainIndicatorsResponse, error)
+	// Queries the interchain topology built from the IBC connectivity reported by the monitored chains.
+	Topology(context.Context, *QueryTopologyRequest) (*QueryTopologyResponse, error)
+	// Queries the chains connected to a chain, as reported by the chain itself or by its counterparties.
+	ChainNeighbors(context.Context, *QueryChainNeighborsRequest) (*QueryChainNeighborsResponse, error)
+	// Queries the history of the out-of-band heartbeats sent by a chain on notable events.
+	Heartbeats(context.Context, *QueryHeartbeatsRequest) (*QueryHeartbeatsResponse, error)
+	// Queries the liveness probes sent to a chain and the round-trip time of the last answered one.
+	Probe(context.Context, *QueryProbeRequest) (*QueryProbeResponse, error)
+	// Queries the relay latency percentiles of the last healthcheck updates of a chain.
+	RelayLatency(context.Context, *QueryRelayLatencyRequest) (*QueryRelayLatencyResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Chain(ctx context.Context, req *QueryGetChainRequest) (*QueryGetChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Chain not implemented")
+}
+func (*UnimplementedQueryServer) ChainAll(ctx context.Context, req *QueryAllChainRequest) (*QueryAllChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainAll not implemented")
+}
+func (*UnimplementedQueryServer) RelayerStats(ctx context.Context, req *QueryRelayerStatsRequest) (*QueryRelayerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerStats not implemented")
+}
+func (*UnimplementedQueryServer) RelayersForChain(ctx context.Context, req *QueryRelayersForChainRequest) (*QueryRelayersForChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayersForChain not implemented")
+}
+func (*UnimplementedQueryServer) DecentralizationMetrics(ctx context.Context, req *QueryDecentralizationMetricsRequest) (*QueryDecentralizationMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecentralizationMetrics not implemented")
+}
+func (*UnimplementedQueryServer) ChainIndicators(ctx context.Context, req *QueryChainIndicatorsRequest) (*QueryChainIndicatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainIndicators not implemented")
+}
+func (*UnimplementedQueryServer) Topology(ctx context.Context, req *QueryTopologyRequest) (*QueryTopologyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Topology not implemented")
+}
+func (*UnimplementedQueryServer) ChainNeighbors(ctx context.Context, req *QueryChainNeighborsRequest) (*QueryChainNeighborsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainNeighbors not implemented")
+}
+func (*UnimplementedQueryServer) Heartbeats(ctx context.Context, req *QueryHeartbeatsRequest) (*QueryHeartbeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeats not implemented")
+}
+func (*UnimplementedQueryServer) Probe(ctx context.Context, req *QueryProbeRequest) (*QueryProbeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Probe not implemented")
+}
+func (*UnimplementedQueryServer) RelayLatency(ctx context.Context, req *QueryRelayLatencyRequest) (*QueryRelayLatencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayLatency not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Chain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Chain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Query/Chain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Chain(ctx, req.(*QueryGetChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChainAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Query/ChainAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainAll(ctx, req.(*QueryAllChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Query/RelayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerStats(ctx, req.(*QueryRelayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelayersForChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayersForChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayersForChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Query/RelayersForChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayersForChain(ctx, req.(*QueryRelayersForChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DecentralizationMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDecentralizationMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecentralizationMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Query/DecentralizationMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecentralizationMetrics(ctx, req.(*QueryDecentralizationMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChainIndicators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainIndicatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainIndicators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Query/ChainIndicators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainIndicators(ctx, req.(*QueryChainIndicatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Topology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Topology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Query/Topology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Topology(ctx, req.(*QueryTopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChainNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Query/ChainNeighbors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainNeighbors(ctx, req.(*QueryChainNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Heartbeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeartbeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Heartbeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Query/Heartbeats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Heartbeats(ctx, req.(*QueryHeartbeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Probe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProbeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Probe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Query/Probe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Probe(ctx, req.(*QueryProbeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelayLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayLatencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayLatency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Query/RelayLatency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayLatency(ctx, req.(*QueryRelayLatencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "healthcheck.healthcheck.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Chain",
+			Handler:    _Query_Chain_Handler,
+		},
+		{
+			MethodName: "ChainAll",
+			Handler:    _Query_ChainAll_Handler,
+		},
+		{
+			MethodName: "RelayerStats",
+			Handler:    _Query_RelayerStats_Handler,
+		},
+		{
+			MethodName: "RelayersForChain",
+			Handler:    _Query_RelayersForChain_Handler,
+		},
+		{
+			MethodName: "DecentralizationMetrics",
+			Handler:    _Query_DecentralizationMetrics_Handler,
+		},
+		{
+			MethodName: "ChainIndicators",
+			Handler:    _Query_ChainIndicators_Handler,
+		},
+		{
+			MethodName: "Topology",
+			Handler:    _Query_Topology_Handler,
+		},
+		{
+			MethodName: "ChainNeighbors",
+			Handler:    _Query_ChainNeighbors_Handler,
+		},
+		{
+			MethodName: "Heartbeats",
+			Handler:    _Query_Heartbeats_Handler,
+		},
+		{
+			MethodName: "Probe",
+			Handler:    _Query_Probe_Handler,
+		},
+		{
+			MethodName: "RelayLatency",
+			Handler:    _Query_RelayLatency_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "healthcheck/healthcheck/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		for iNdEx := len(m.Chain) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RelayerStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayersForChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayersForChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayersForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayersForChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayersForChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayersForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecentralizationMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecentralizationMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecentralizationMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecentralizationMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecentralizationMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecentralizationMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Samples) > 0 {
+		for iNdEx := len(m.Samples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Samples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainIndicatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainIndicatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainIndicatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainIndicatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainIndicatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainIndicatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Indicators) > 0 {
+		for iNdEx := len(m.Indicators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indicators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopologyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopologyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopologyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopologyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopologyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopologyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainNeighborsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainNeighborsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainNeighborsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainNeighborsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainNeighborsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainNeighborsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Neighbors) > 0 {
+		for iNdEx := len(m.Neighbors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Neighbors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeartbeatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeartbeatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeartbeatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeartbeatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeartbeatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeartbeatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Heartbeats) > 0 {
+		for iNdEx := len(m.Heartbeats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Heartbeats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProbeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProbeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProbeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProbeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProbeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProbeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Probe.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayLatencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayLatencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayLatencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayLatencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayLatencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayLatencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Measured != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Measured))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Last != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Last))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Percentiles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Chain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chain) > 0 {
+		for _, e := range m.Chain {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RelayerStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRelayersForChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayersForChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for _, e := range m.Relayers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDecentralizationMetricsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDecentralizationMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainIndicatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainIndicatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indicators) > 0 {
+		for _, e := range m.Indicators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopologyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopologyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainNeighborsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainNeighborsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Neighbors) > 0 {
+		for _, e := range m.Neighbors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHeartbeatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeartbeatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Heartbeats) > 0 {
+		for _, e := range m.Heartbeats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProbeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProbeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Probe.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRelayLatencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayLatencyResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	l = m.Percentiles.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Last != 0 {
+		n += 1 + sovQuery(uint64(m.Last))
+	}
+	if m.Measured != 0 {
+		n += 1 + sovQuery(uint64(m.Measured))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = append(m.Chain, Chain{})
+			if err := m.Chain[len(m.Chain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayerStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayerStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayersForChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayersForChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayersForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayersForChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayersForChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayersForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, ChainRelayer{})
+			if err := m.Relayers[len(m.Relayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDecentralizationMetricsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDecentralizationMetricsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDecentralizationMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDecentralizationMetricsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDecentralizationMetricsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDecentralizationMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Samples = append(m.Samples, DecentralizationSample{})
+			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainIndicatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainIndicatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainIndicatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainIndicatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainIndicatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainIndicatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indicators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indicators = append(m.Indicators, ChainIndicator{})
+			if err := m.Indicators[len(m.Indicators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopologyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopologyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopologyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
+}
+func (m *QueryTopologyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopologyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopologyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Links = append(m.Links, ChainLink{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *QueryChainNeighborsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainNeighborsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainNeighborsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAllChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Chain) > 0 {
-		for _, e := range m.Chain {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryChainNeighborsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainNeighborsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainNeighborsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Neighbors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Neighbors = append(m.Neighbors, ChainNeighbor{})
+			if err := m.Neighbors[len(m.Neighbors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeartbeatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -819,12 +4737,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeartbeatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeartbeatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -846,7 +4832,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeartbeatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -869,15 +4855,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeartbeatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeartbeatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -904,7 +4890,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Heartbeats = append(m.Heartbeats, Heartbeat{})
+			if err := m.Heartbeats[len(m.Heartbeats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -929,7 +4952,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProbeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -952,10 +4975,10 @@ func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProbeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProbeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1011,7 +5034,7 @@ func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProbeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1034,15 +5057,15 @@ func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProbeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProbeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Probe", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1069,7 +5092,7 @@ func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Probe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1094,7 +5117,7 @@ func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayLatencyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1117,17 +5140,17 @@ func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayLatencyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayLatencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1137,27 +5160,23 @@ func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1180,7 +5199,7 @@ func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayLatencyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1203,15 +5222,15 @@ func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayLatencyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayLatencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1238,16 +5257,15 @@ func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = append(m.Chain, Chain{})
-			if err := m.Chain[len(m.Chain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Percentiles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
 			}
-			var msglen int
+			m.Last = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1257,28 +5275,30 @@ func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Last |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Measured", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Measured = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Measured |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
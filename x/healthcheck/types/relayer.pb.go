@@ -0,0 +1,754 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcheck/healthcheck/relayer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RelayerStats struct {
+	Relayer              string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	PacketsDelivered     uint64 `protobuf:"varint,2,opt,name=packetsDelivered,proto3" json:"packetsDelivered,omitempty"`
+	StalePacketsRejected uint64 `protobuf:"varint,3,opt,name=stalePacketsRejected,proto3" json:"stalePacketsRejected,omitempty"`
+	ChainsServed         uint64 `protobuf:"varint,4,opt,name=chainsServed,proto3" json:"chainsServed,omitempty"`
+}
+
+func (m *RelayerStats) Reset()         { *m = RelayerStats{} }
+func (m *RelayerStats) String() string { return proto.CompactTextString(m) }
+func (*RelayerStats) ProtoMessage()    {}
+func (*RelayerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d0f76968efd863c, []int{0}
+}
+func (m *RelayerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerStats.Merge(m, src)
+}
+func (m *RelayerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerStats proto.InternalMessageInfo
+
+func (m *RelayerStats) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetPacketsDelivered() uint64 {
+	if m != nil {
+		return m.PacketsDelivered
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetStalePacketsRejected() uint64 {
+	if m != nil {
+		return m.StalePacketsRejected
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetChainsServed() uint64 {
+	if m != nil {
+		return m.ChainsServed
+	}
+	return 0
+}
+
+type ChainRelayer struct {
+	ChainId              string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Relayer              string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	PacketsDelivered     uint64 `protobuf:"varint,3,opt,name=packetsDelivered,proto3" json:"packetsDelivered,omitempty"`
+	StalePacketsRejected uint64 `protobuf:"varint,4,opt,name=stalePacketsRejected,proto3" json:"stalePacketsRejected,omitempty"`
+	LastDeliveryHeight   uint64 `protobuf:"varint,5,opt,name=lastDeliveryHeight,proto3" json:"lastDeliveryHeight,omitempty"`
+}
+
+func (m *ChainRelayer) Reset()         { *m = ChainRelayer{} }
+func (m *ChainRelayer) String() string { return proto.CompactTextString(m) }
+func (*ChainRelayer) ProtoMessage()    {}
+func (*ChainRelayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d0f76968efd863c, []int{1}
+}
+func (m *ChainRelayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainRelayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainRelayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainRelayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRelayer.Merge(m, src)
+}
+func (m *ChainRelayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainRelayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRelayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainRelayer proto.InternalMessageInfo
+
+func (m *ChainRelayer) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainRelayer) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *ChainRelayer) GetPacketsDelivered() uint64 {
+	if m != nil {
+		return m.PacketsDelivered
+	}
+	return 0
+}
+
+func (m *ChainRelayer) GetStalePacketsRejected() uint64 {
+	if m != nil {
+		return m.StalePacketsRejected
+	}
+	return 0
+}
+
+func (m *ChainRelayer) GetLastDeliveryHeight() uint64 {
+	if m != nil {
+		return m.LastDeliveryHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RelayerStats)(nil), "healthcheck.healthcheck.RelayerStats")
+	proto.RegisterType((*ChainRelayer)(nil), "healthcheck.healthcheck.ChainRelayer")
+}
+
+func init() {
+	proto.RegisterFile("healthcheck/healthcheck/relayer.proto", fileDescriptor_5d0f76968efd863c)
+}
+
+var fileDescriptor_5d0f76968efd863c = []byte{
+	// 263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x48, 0x4d, 0xcc,
+	0x29, 0xc9, 0x48, 0xce, 0x48, 0x4d, 0xce, 0xd6, 0x47, 0x66, 0x17, 0xa5, 0xe6, 0x24, 0x56, 0xa6,
+	0x16, 0xe9, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x23, 0x49, 0xe9, 0x21, 0xb1, 0x95, 0xd6,
+	0x30, 0x72, 0xf1, 0x04, 0x41, 0x94, 0x06, 0x97, 0x24, 0x96, 0x14, 0x0b, 0x49, 0x70, 0xb1, 0x43,
+	0xb5, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xb8, 0x42, 0x5a, 0x5c, 0x02, 0x05, 0x89,
+	0xc9, 0xd9, 0xa9, 0x25, 0xc5, 0x2e, 0xa9, 0x39, 0x99, 0x65, 0xa9, 0x45, 0xa9, 0x29, 0x12, 0x4c,
+	0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x18, 0xe2, 0x42, 0x46, 0x5c, 0x22, 0xc5, 0x25, 0x89, 0x39, 0xa9,
+	0x01, 0x10, 0x89, 0xa0, 0xd4, 0xac, 0xd4, 0xe4, 0x92, 0xd4, 0x14, 0x09, 0x66, 0xb0, 0x7a, 0xac,
+	0x72, 0x42, 0x4a, 0x5c, 0x3c, 0xc9, 0x19, 0x89, 0x99, 0x79, 0xc5, 0xc1, 0xa9, 0x45, 0x65, 0xa9,
+	0x29, 0x12, 0x2c, 0x60, 0xb5, 0x28, 0x62, 0x4a, 0x97, 0x18, 0xb9, 0x78, 0x9c, 0x41, 0x02, 0x50,
+	0x37, 0x83, 0x9c, 0x0b, 0x56, 0xe0, 0x99, 0x02, 0x73, 0x2e, 0x94, 0x8b, 0xec, 0x11, 0x26, 0xc2,
+	0x1e, 0x61, 0x26, 0xd1, 0x23, 0x2c, 0x78, 0x3c, 0xa2, 0xc7, 0x25, 0x94, 0x93, 0x58, 0x5c, 0x02,
+	0x35, 0xa4, 0xd2, 0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82, 0x15, 0xac, 0x03, 0x8b, 0x8c, 0x93,
+	0xe5, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
+	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xc9, 0x23, 0xc7, 0x68, 0x05,
+	0x4a, 0xfc, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xa3, 0xd7, 0x18, 0x30, 0x00, 0xea,
+	0xd6, 0x92, 0x35, 0x07, 0x02, 0x00, 0x00,
+}
+
+func (m *RelayerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainsServed != 0 {
+		i = encodeVarintRelayer(dAtA, i, uint64(m.ChainsServed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StalePacketsRejected != 0 {
+		i = encodeVarintRelayer(dAtA, i, uint64(m.StalePacketsRejected))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PacketsDelivered != 0 {
+		i = encodeVarintRelayer(dAtA, i, uint64(m.PacketsDelivered))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintRelayer(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainRelayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainRelayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainRelayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastDeliveryHeight != 0 {
+		i = encodeVarintRelayer(dAtA, i, uint64(m.LastDeliveryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StalePacketsRejected != 0 {
+		i = encodeVarintRelayer(dAtA, i, uint64(m.StalePacketsRejected))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PacketsDelivered != 0 {
+		i = encodeVarintRelayer(dAtA, i, uint64(m.PacketsDelivered))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintRelayer(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRelayer(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRelayer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRelayer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RelayerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovRelayer(uint64(l))
+	}
+	if m.PacketsDelivered != 0 {
+		n += 1 + sovRelayer(uint64(m.PacketsDelivered))
+	}
+	if m.StalePacketsRejected != 0 {
+		n += 1 + sovRelayer(uint64(m.StalePacketsRejected))
+	}
+	if m.ChainsServed != 0 {
+		n += 1 + sovRelayer(uint64(m.ChainsServed))
+	}
+	return n
+}
+
+func (m *ChainRelayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRelayer(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovRelayer(uint64(l))
+	}
+	if m.PacketsDelivered != 0 {
+		n += 1 + sovRelayer(uint64(m.PacketsDelivered))
+	}
+	if m.StalePacketsRejected != 0 {
+		n += 1 + sovRelayer(uint64(m.StalePacketsRejected))
+	}
+	if m.LastDeliveryHeight != 0 {
+		n += 1 + sovRelayer(uint64(m.LastDeliveryHeight))
+	}
+	return n
+}
+
+func sovRelayer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRelayer(x uint64) (n int) {
+	return sovRelayer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RelayerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsDelivered", wireType)
+			}
+			m.PacketsDelivered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsDelivered |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalePacketsRejected", wireType)
+			}
+			m.StalePacketsRejected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StalePacketsRejected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainsServed", wireType)
+			}
+			m.ChainsServed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainsServed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainRelayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainRelayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainRelayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsDelivered", wireType)
+			}
+			m.PacketsDelivered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsDelivered |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalePacketsRejected", wireType)
+			}
+			m.StalePacketsRejected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StalePacketsRejected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDeliveryHeight", wireType)
+			}
+			m.LastDeliveryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDeliveryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRelayer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRelayer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRelayer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRelayer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRelayer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRelayer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRelayer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRelayer = fmt.Errorf("proto: unexpected end of group")
+)
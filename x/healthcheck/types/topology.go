@@ -0,0 +1,42 @@
+package types
+
+import (
+	"sort"
+
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+// NewChainLinks aggregates the channels reported by a monitored chain per counterparty chain. Channels
+// with unknown counterparty chain are left out. Links are ordered by the counterparty chain ID.
+func NewChainLinks(connectivity ChainConnectivity) []ChainLink {
+	linksByCounterparty := make(map[string]*ChainLink)
+	for _, channel := range connectivity.Channels {
+		if channel.CounterpartyChainId == "" {
+			continue
+		}
+
+		link, found := linksByCounterparty[channel.CounterpartyChainId]
+		if !found {
+			link = &ChainLink{
+				ChainId:             connectivity.ChainId,
+				CounterpartyChainId: channel.CounterpartyChainId,
+			}
+			linksByCounterparty[channel.CounterpartyChainId] = link
+		}
+
+		link.Channels++
+		link.PendingPackets += channel.PendingPackets
+		if channel.State == channeltypes.OPEN.String() && channel.ClientStatus == ibcexported.Active.String() {
+			link.ActiveChannels++
+		}
+	}
+
+	links := make([]ChainLink, 0, len(linksByCounterparty))
+	for _, link := range linksByCounterparty {
+		links = append(links, *link)
+	}
+	sort.Slice(links, func(i, j int) bool { return links[i].CounterpartyChainId < links[j].CounterpartyChainId })
+
+	return links
+}
@@ -0,0 +1,1105 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcheck/healthcheck/topology.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "healthcheck/x/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChainConnectivity is the latest summary of the IBC channels reported by a monitored chain
+type ChainConnectivity struct {
+	ChainId             string                 `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	RegistryBlockHeight uint64                 `protobuf:"varint,2,opt,name=registryBlockHeight,proto3" json:"registryBlockHeight,omitempty"`
+	Block               uint64                 `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	Channels            []types.ChannelSummary `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels"`
+}
+
+func (m *ChainConnectivity) Reset()         { *m = ChainConnectivity{} }
+func (m *ChainConnectivity) String() string { return proto.CompactTextString(m) }
+func (*ChainConnectivity) ProtoMessage()    {}
+func (*ChainConnectivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e07fb14241b772b, []int{0}
+}
+func (m *ChainConnectivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainConnectivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainConnectivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainConnectivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainConnectivity.Merge(m, src)
+}
+func (m *ChainConnectivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainConnectivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainConnectivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainConnectivity proto.InternalMessageInfo
+
+func (m *ChainConnectivity) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainConnectivity) GetRegistryBlockHeight() uint64 {
+	if m != nil {
+		return m.RegistryBlockHeight
+	}
+	return 0
+}
+
+func (m *ChainConnectivity) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *ChainConnectivity) GetChannels() []types.ChannelSummary {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// ChainLink aggregates the channels of a monitored chain to one of its counterparty chains
+type ChainLink struct {
+	ChainId             string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	CounterpartyChainId string `protobuf:"bytes,2,opt,name=counterpartyChainId,proto3" json:"counterpartyChainId,omitempty"`
+	Channels            uint64 `protobuf:"varint,3,opt,name=channels,proto3" json:"channels,omitempty"`
+	// number of the open channels built on an active light client
+	ActiveChannels uint64 `protobuf:"varint,4,opt,name=activeChannels,proto3" json:"activeChannels,omitempty"`
+	PendingPackets uint64 `protobuf:"varint,5,opt,name=pendingPackets,proto3" json:"pendingPackets,omitempty"`
+}
+
+func (m *ChainLink) Reset()         { *m = ChainLink{} }
+func (m *ChainLink) String() string { return proto.CompactTextString(m) }
+func (*ChainLink) ProtoMessage()    {}
+func (*ChainLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e07fb14241b772b, []int{1}
+}
+func (m *ChainLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainLink.Merge(m, src)
+}
+func (m *ChainLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainLink proto.InternalMessageInfo
+
+func (m *ChainLink) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainLink) GetCounterpartyChainId() string {
+	if m != nil {
+		return m.CounterpartyChainId
+	}
+	return ""
+}
+
+func (m *ChainLink) GetChannels() uint64 {
+	if m != nil {
+		return m.Channels
+	}
+	return 0
+}
+
+func (m *ChainLink) GetActiveChannels() uint64 {
+	if m != nil {
+		return m.ActiveChannels
+	}
+	return 0
+}
+
+func (m *ChainLink) GetPendingPackets() uint64 {
+	if m != nil {
+		return m.PendingPackets
+	}
+	return 0
+}
+
+// ChainNeighbor is a chain connected to the queried chain
+type ChainNeighbor struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	// true if the neighbor is registered on the registry chain, in which case its status is known
+	Registered bool   `protobuf:"varint,2,opt,name=registered,proto3" json:"registered,omitempty"`
+	Status     uint64 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	// links reported by the queried chain and by the neighbor
+	Links []ChainLink `protobuf:"bytes,4,rep,name=links,proto3" json:"links"`
+}
+
+func (m *ChainNeighbor) Reset()         { *m = ChainNeighbor{} }
+func (m *ChainNeighbor) String() string { return proto.CompactTextString(m) }
+func (*ChainNeighbor) ProtoMessage()    {}
+func (*ChainNeighbor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e07fb14241b772b, []int{2}
+}
+func (m *ChainNeighbor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainNeighbor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainNeighbor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainNeighbor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainNeighbor.Merge(m, src)
+}
+func (m *ChainNeighbor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainNeighbor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainNeighbor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainNeighbor proto.InternalMessageInfo
+
+func (m *ChainNeighbor) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainNeighbor) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
+	}
+	return false
+}
+
+func (m *ChainNeighbor) GetStatus() uint64 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ChainNeighbor) GetLinks() []ChainLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ChainConnectivity)(nil), "healthcheck.healthcheck.ChainConnectivity")
+	proto.RegisterType((*ChainLink)(nil), "healthcheck.healthcheck.ChainLink")
+	proto.RegisterType((*ChainNeighbor)(nil), "healthcheck.healthcheck.ChainNeighbor")
+}
+
+func init() {
+	proto.RegisterFile("healthcheck/healthcheck/topology.proto", fileDescriptor_1e07fb14241b772b)
+}
+
+var fileDescriptor_1e07fb14241b772b = []byte{
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbf, 0x6e, 0xdb, 0x30,
+	0x10, 0xc6, 0x45, 0xff, 0xab, 0xcd, 0xa2, 0x05, 0xca, 0x1a, 0xad, 0xe0, 0x81, 0x76, 0x35, 0x18,
+	0x9e, 0xa4, 0xa2, 0x9d, 0xba, 0x74, 0xb0, 0x96, 0x16, 0x28, 0x8a, 0x42, 0xdd, 0xba, 0xd1, 0x34,
+	0x21, 0x11, 0x92, 0x49, 0x81, 0xa2, 0x8b, 0xe8, 0x2d, 0xf2, 0x0a, 0x79, 0x8d, 0xac, 0x59, 0x3c,
+	0x7a, 0xcc, 0x14, 0x04, 0xf6, 0x8b, 0x04, 0x22, 0x6d, 0x47, 0x4e, 0x1c, 0x6f, 0xf7, 0xdd, 0xfd,
+	0x28, 0x7d, 0xf7, 0xe1, 0xe0, 0x38, 0x61, 0x24, 0xd3, 0x09, 0x4d, 0x18, 0x4d, 0x83, 0x7a, 0xad,
+	0x65, 0x2e, 0x33, 0x19, 0x97, 0x7e, 0xae, 0xa4, 0x96, 0xe8, 0x63, 0x6d, 0xe6, 0xd7, 0xea, 0x41,
+	0x3f, 0x96, 0xb1, 0x34, 0x4c, 0x50, 0x55, 0x16, 0x1f, 0xe0, 0xa3, 0x4f, 0x95, 0x39, 0x2b, 0x82,
+	0x9c, 0xd0, 0x94, 0x69, 0x3b, 0xf7, 0xae, 0x01, 0x7c, 0x17, 0x26, 0x84, 0x8b, 0x50, 0x0a, 0xc1,
+	0xa8, 0xe6, 0xff, 0xb9, 0x2e, 0x91, 0x0b, 0x5f, 0xd1, 0xaa, 0xf9, 0x73, 0xee, 0x82, 0x11, 0x98,
+	0xf4, 0xa2, 0xbd, 0x44, 0x9f, 0xe1, 0x7b, 0xc5, 0x62, 0x5e, 0x68, 0x55, 0x4e, 0x33, 0x49, 0xd3,
+	0x1f, 0x8c, 0xc7, 0x89, 0x76, 0x1b, 0x23, 0x30, 0x69, 0x45, 0xa7, 0x46, 0xa8, 0x0f, 0xdb, 0xb3,
+	0x4a, 0xba, 0x4d, 0xc3, 0x58, 0x81, 0x42, 0xd8, 0xa5, 0x09, 0x11, 0x82, 0x65, 0x85, 0xdb, 0x1a,
+	0x35, 0x27, 0xaf, 0xbf, 0x7c, 0xaa, 0x6f, 0xe3, 0x1b, 0xab, 0x7e, 0x68, 0x91, 0xbf, 0xcb, 0xc5,
+	0x82, 0xa8, 0x72, 0xda, 0x5a, 0xdd, 0x0d, 0x9d, 0xe8, 0xf0, 0xd0, 0xbb, 0x01, 0xb0, 0x67, 0xcc,
+	0xff, 0xe2, 0x22, 0x3d, 0x6f, 0x9a, 0xca, 0xa5, 0xd0, 0x4c, 0xe5, 0x44, 0xe9, 0x32, 0xdc, 0x51,
+	0x0d, 0x43, 0x9d, 0x1a, 0xa1, 0x41, 0xcd, 0x9e, 0xf5, 0x7d, 0xd0, 0x68, 0x0c, 0xdf, 0x92, 0x2a,
+	0x28, 0x16, 0x3e, 0x2e, 0x50, 0x11, 0x4f, 0xba, 0x15, 0x97, 0x33, 0x31, 0xe7, 0x22, 0xfe, 0x63,
+	0x12, 0x2f, 0xdc, 0xb6, 0xe5, 0x8e, 0xbb, 0xde, 0x15, 0x80, 0x6f, 0xcc, 0x7f, 0x7f, 0x57, 0x81,
+	0xcd, 0xa4, 0x3a, 0xb3, 0x09, 0x86, 0xd0, 0x66, 0xcc, 0x14, 0xb3, 0x0b, 0x74, 0xa3, 0x5a, 0x07,
+	0x7d, 0x80, 0x9d, 0x42, 0x13, 0xbd, 0xdc, 0xbb, 0xde, 0x29, 0xf4, 0x1d, 0xb6, 0x33, 0x2e, 0xd2,
+	0x7d, 0xd6, 0x9e, 0xff, 0xc2, 0x15, 0xf9, 0x87, 0x38, 0x77, 0x61, 0xdb, 0x67, 0xd3, 0x6f, 0xab,
+	0x0d, 0x06, 0xeb, 0x0d, 0x06, 0xf7, 0x1b, 0x0c, 0x2e, 0xb7, 0xd8, 0x59, 0x6f, 0xb1, 0x73, 0xbb,
+	0xc5, 0xce, 0xbf, 0x61, 0xfd, 0xc0, 0x2e, 0x82, 0x67, 0xe7, 0x36, 0xeb, 0x98, 0x43, 0xfb, 0xfa,
+	0x30, 0x00, 0xd4, 0xed, 0xe9, 0x01, 0xe1, 0x02, 0x00, 0x00,
+}
+
+func (m *ChainConnectivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainConnectivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainConnectivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTopology(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Block != 0 {
+		i = encodeVarintTopology(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RegistryBlockHeight != 0 {
+		i = encodeVarintTopology(dAtA, i, uint64(m.RegistryBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTopology(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingPackets != 0 {
+		i = encodeVarintTopology(dAtA, i, uint64(m.PendingPackets))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActiveChannels != 0 {
+		i = encodeVarintTopology(dAtA, i, uint64(m.ActiveChannels))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Channels != 0 {
+		i = encodeVarintTopology(dAtA, i, uint64(m.Channels))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CounterpartyChainId) > 0 {
+		i -= len(m.CounterpartyChainId)
+		copy(dAtA[i:], m.CounterpartyChainId)
+		i = encodeVarintTopology(dAtA, i, uint64(len(m.CounterpartyChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTopology(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainNeighbor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainNeighbor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainNeighbor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTopology(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintTopology(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Registered {
+		i--
+		if m.Registered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTopology(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTopology(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTopology(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainConnectivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTopology(uint64(l))
+	}
+	if m.RegistryBlockHeight != 0 {
+		n += 1 + sovTopology(uint64(m.RegistryBlockHeight))
+	}
+	if m.Block != 0 {
+		n += 1 + sovTopology(uint64(m.Block))
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovTopology(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTopology(uint64(l))
+	}
+	l = len(m.CounterpartyChainId)
+	if l > 0 {
+		n += 1 + l + sovTopology(uint64(l))
+	}
+	if m.Channels != 0 {
+		n += 1 + sovTopology(uint64(m.Channels))
+	}
+	if m.ActiveChannels != 0 {
+		n += 1 + sovTopology(uint64(m.ActiveChannels))
+	}
+	if m.PendingPackets != 0 {
+		n += 1 + sovTopology(uint64(m.PendingPackets))
+	}
+	return n
+}
+
+func (m *ChainNeighbor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTopology(uint64(l))
+	}
+	if m.Registered {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovTopology(uint64(m.Status))
+	}
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 1 + l + sovTopology(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTopology(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTopology(x uint64) (n int) {
+	return sovTopology(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChainConnectivity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopology
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainConnectivity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainConnectivity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopology
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopology
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryBlockHeight", wireType)
+			}
+			m.RegistryBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistryBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopology
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopology
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, types.ChannelSummary{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopology(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopology
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopology
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopology
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopology
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopology
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopology
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			m.Channels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Channels |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveChannels", wireType)
+			}
+			m.ActiveChannels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveChannels |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPackets", wireType)
+			}
+			m.PendingPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopology(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopology
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainNeighbor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopology
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainNeighbor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainNeighbor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopology
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopology
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Registered = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopology
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopology
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Links = append(m.Links, ChainLink{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopology(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopology
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTopology(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTopology
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTopology
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTopology
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTopology
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTopology
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTopology        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTopology          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTopology = fmt.Errorf("proto: unexpected end of group")
+)
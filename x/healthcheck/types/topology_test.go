@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	commontypes "healthcheck/x/types"
+)
+
+func TestNewChainLinks(t *testing.T) {
+	connectivity := ChainConnectivity{
+		ChainId: "a",
+		Channels: []commontypes.ChannelSummary{
+			{ChannelId: "channel-0", CounterpartyChainId: "c", State: "STATE_OPEN", ClientStatus: "Active", PendingPackets: 1},
+			{ChannelId: "channel-1", CounterpartyChainId: "b", State: "STATE_OPEN", ClientStatus: "Expired", PendingPackets: 2},
+			{ChannelId: "channel-2", CounterpartyChainId: "b", State: "STATE_OPEN", ClientStatus: "Active", PendingPackets: 3},
+			{ChannelId: "channel-3", CounterpartyChainId: "b", State: "STATE_CLOSED", ClientStatus: "Active"},
+			{ChannelId: "channel-4", State: "STATE_OPEN", ClientStatus: "Unknown"},
+		},
+	}
+
+	require.Equal(t, []ChainLink{
+		{ChainId: "a", CounterpartyChainId: "b", Channels: 3, ActiveChannels: 1, PendingPackets: 5},
+		{ChainId: "a", CounterpartyChainId: "c", Channels: 1, ActiveChannels: 1, PendingPackets: 1},
+	}, NewChainLinks(connectivity))
+}
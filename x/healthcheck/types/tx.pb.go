@@ -31,6 +31,7 @@ type MsgCreateChain struct {
 	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	ChainId      string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
 	ConnectionId string `protobuf:"bytes,3,opt,name=connectionId,proto3" json:"connectionId,omitempty"`
+	Passive      bool   `protobuf:"varint,4,opt,name=passive,proto3" json:"passive,omitempty"`
 }
 
 func (m *MsgCreateChain) Reset()         { *m = MsgCreateChain{} }
@@ -87,6 +88,13 @@ func (m *MsgCreateChain) GetConnectionId() string {
 	return ""
 }
 
+func (m *MsgCreateChain) GetPassive() bool {
+	if m != nil {
+		return m.Passive
+	}
+	return false
+}
+
 type MsgCreateChainResponse struct {
 }
 
@@ -127,6 +135,7 @@ type MsgUpdateChain struct {
 	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	ChainId      string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
 	ConnectionId string `protobuf:"bytes,3,opt,name=connectionId,proto3" json:"connectionId,omitempty"`
+	Passive      bool   `protobuf:"varint,4,opt,name=passive,proto3" json:"passive,omitempty"`
 }
 
 func (m *MsgUpdateChain) Reset()         { *m = MsgUpdateChain{} }
@@ -183,6 +192,13 @@ func (m *MsgUpdateChain) GetConnectionId() string {
 	return ""
 }
 
+func (m *MsgUpdateChain) GetPassive() bool {
+	if m != nil {
+		return m.Passive
+	}
+	return false
+}
+
 type MsgUpdateChainResponse struct {
 }
 
@@ -307,6 +323,102 @@ func (m *MsgDeleteChainResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteChainResponse proto.InternalMessageInfo
 
+type MsgResetChain struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	NewChainId string `protobuf:"bytes,3,opt,name=newChainId,proto3" json:"newChainId,omitempty"`
+}
+
+func (m *MsgResetChain) Reset()         { *m = MsgResetChain{} }
+func (m *MsgResetChain) String() string { return proto.CompactTextString(m) }
+func (*MsgResetChain) ProtoMessage()    {}
+func (*MsgResetChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_244719d9e7f65721, []int{6}
+}
+func (m *MsgResetChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetChain.Merge(m, src)
+}
+func (m *MsgResetChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetChain proto.InternalMessageInfo
+
+func (m *MsgResetChain) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgResetChain) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MsgResetChain) GetNewChainId() string {
+	if m != nil {
+		return m.NewChainId
+	}
+	return ""
+}
+
+type MsgResetChainResponse struct {
+}
+
+func (m *MsgResetChainResponse) Reset()         { *m = MsgResetChainResponse{} }
+func (m *MsgResetChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetChainResponse) ProtoMessage()    {}
+func (*MsgResetChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_244719d9e7f65721, []int{7}
+}
+func (m *MsgResetChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetChainResponse.Merge(m, src)
+}
+func (m *MsgResetChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetChainResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateChain)(nil), "healthcheck.healthcheck.MsgCreateChain")
 	proto.RegisterType((*MsgCreateChainResponse)(nil), "healthcheck.healthcheck.MsgCreateChainResponse")
@@ -314,30 +426,36 @@ func init() {
 	proto.RegisterType((*MsgUpdateChainResponse)(nil), "healthcheck.healthcheck.MsgUpdateChainResponse")
 	proto.RegisterType((*MsgDeleteChain)(nil), "healthcheck.healthcheck.MsgDeleteChain")
 	proto.RegisterType((*MsgDeleteChainResponse)(nil), "healthcheck.healthcheck.MsgDeleteChainResponse")
+	proto.RegisterType((*MsgResetChain)(nil), "healthcheck.healthcheck.MsgResetChain")
+	proto.RegisterType((*MsgResetChainResponse)(nil), "healthcheck.healthcheck.MsgResetChainResponse")
 }
 
 func init() { proto.RegisterFile("healthcheck/healthcheck/tx.proto", fileDescriptor_244719d9e7f65721) }
 
 var fileDescriptor_244719d9e7f65721 = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x48, 0x4d, 0xcc,
-	0x29, 0xc9, 0x48, 0xce, 0x48, 0x4d, 0xce, 0xd6, 0x47, 0x66, 0x97, 0x54, 0xe8, 0x15, 0x14, 0xe5,
-	0x97, 0xe4, 0x0b, 0x89, 0x23, 0x89, 0xea, 0x21, 0xb1, 0xa5, 0x94, 0x71, 0x69, 0x4d, 0xce, 0x48,
-	0xcc, 0xcc, 0x83, 0xe8, 0x56, 0xca, 0xe0, 0xe2, 0xf3, 0x2d, 0x4e, 0x77, 0x2e, 0x4a, 0x4d, 0x2c,
-	0x49, 0x75, 0x06, 0x89, 0x0b, 0x49, 0x70, 0xb1, 0x27, 0x83, 0xb8, 0xf9, 0x45, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x9c, 0x41, 0x30, 0x2e, 0x58, 0x06, 0xa4, 0xc4, 0x33, 0x45, 0x82, 0x09, 0x2a, 0x03,
-	0xe1, 0x0a, 0x29, 0x71, 0xf1, 0x24, 0xe7, 0xe7, 0xe5, 0xa5, 0x26, 0x97, 0x64, 0xe6, 0x83, 0xa4,
-	0x99, 0xc1, 0xd2, 0x28, 0x62, 0x4a, 0x12, 0x5c, 0x62, 0xa8, 0x36, 0x05, 0xa5, 0x16, 0x17, 0xe4,
-	0xe7, 0x15, 0xa7, 0x42, 0xdd, 0x10, 0x5a, 0x90, 0x42, 0x27, 0x37, 0x20, 0xd9, 0x04, 0x77, 0x83,
-	0x0b, 0xd8, 0x0d, 0x2e, 0xa9, 0x39, 0xa9, 0x14, 0xb8, 0x01, 0x6a, 0x3e, 0x92, 0x29, 0x30, 0xf3,
-	0x8d, 0x0e, 0x30, 0x71, 0x31, 0xfb, 0x16, 0xa7, 0x0b, 0xa5, 0x73, 0x71, 0x23, 0x07, 0xb6, 0xba,
-	0x1e, 0x8e, 0xd8, 0xd3, 0x43, 0x0d, 0x2b, 0x29, 0x7d, 0x22, 0x15, 0xc2, 0x2c, 0x04, 0x59, 0x84,
-	0x1c, 0xa2, 0x78, 0x2d, 0x42, 0x52, 0x88, 0xdf, 0x22, 0x2c, 0x21, 0x07, 0xb2, 0x08, 0x39, 0xd8,
-	0xf0, 0x5a, 0x84, 0xa4, 0x10, 0xbf, 0x45, 0x58, 0x82, 0xd0, 0xc9, 0xf2, 0xc4, 0x23, 0x39, 0xc6,
-	0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39,
-	0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xe4, 0x91, 0x53, 0x77, 0x05, 0x6a, 0x36, 0xa9, 0x2c, 0x48,
-	0x2d, 0x4e, 0x62, 0x03, 0x27, 0x76, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75, 0x12, 0xc3,
-	0x5d, 0x4e, 0x03, 0x00, 0x00,
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xbf, 0x4e, 0xfb, 0x30,
+	0x10, 0xae, 0x7f, 0xfd, 0x89, 0x3f, 0xc7, 0x9f, 0x21, 0x12, 0x34, 0xca, 0x60, 0xaa, 0x20, 0x41,
+	0xa7, 0x44, 0x82, 0x89, 0x95, 0x76, 0x61, 0xe8, 0x12, 0x89, 0x85, 0x2d, 0xb8, 0xa7, 0xa4, 0xa2,
+	0x8a, 0xa3, 0xda, 0x82, 0xb2, 0xf2, 0x04, 0x3c, 0x05, 0xcf, 0xc2, 0xd8, 0x91, 0x11, 0xb5, 0x2f,
+	0x82, 0x9c, 0xd6, 0xe1, 0x32, 0x34, 0x8a, 0x60, 0x60, 0xf3, 0xdd, 0xf7, 0xdd, 0x7d, 0x9f, 0x75,
+	0xf6, 0x41, 0x37, 0xc5, 0x78, 0xa2, 0x53, 0x91, 0xa2, 0x78, 0x08, 0xe9, 0x59, 0xcf, 0x82, 0x7c,
+	0x2a, 0xb5, 0x74, 0x3a, 0x24, 0x1b, 0x90, 0xb3, 0x77, 0xba, 0xa9, 0x54, 0xa4, 0xf1, 0x38, 0x5b,
+	0x55, 0xfb, 0x2f, 0x0c, 0x0e, 0x87, 0x2a, 0xe9, 0x4f, 0x31, 0xd6, 0xd8, 0x37, 0x80, 0xe3, 0xc2,
+	0xb6, 0x30, 0xa1, 0x9c, 0xba, 0xac, 0xcb, 0x7a, 0xbb, 0x91, 0x0d, 0x0b, 0xc4, 0x50, 0x6e, 0x46,
+	0xee, 0xbf, 0x35, 0xb2, 0x0a, 0x1d, 0x1f, 0xf6, 0x85, 0xcc, 0x32, 0x14, 0x7a, 0x2c, 0x0d, 0xdc,
+	0x2e, 0xe0, 0x4a, 0xce, 0x54, 0xe7, 0xb1, 0x52, 0xe3, 0x47, 0x74, 0xff, 0x77, 0x59, 0x6f, 0x27,
+	0xb2, 0xa1, 0xef, 0xc2, 0x71, 0xd5, 0x43, 0x84, 0x2a, 0x97, 0x99, 0x42, 0x6b, 0xef, 0x36, 0x1f,
+	0xfd, 0xb9, 0x3d, 0xe2, 0xa1, 0xb4, 0x37, 0x28, 0xdc, 0x0d, 0x70, 0x82, 0xbf, 0x70, 0xb7, 0xee,
+	0x4f, 0xba, 0x94, 0xfd, 0x05, 0x1c, 0x0c, 0x55, 0x12, 0xa1, 0x42, 0xfd, 0xf3, 0xcb, 0x73, 0x80,
+	0x0c, 0x9f, 0xfa, 0x6b, 0x70, 0x75, 0x75, 0x92, 0xf1, 0x3b, 0x70, 0x54, 0x11, 0xb1, 0xea, 0x17,
+	0x6f, 0x6d, 0x68, 0x0f, 0x55, 0xe2, 0x24, 0xb0, 0x47, 0xdf, 0xc7, 0x79, 0xb0, 0xe1, 0xc5, 0x05,
+	0xd5, 0x21, 0x7a, 0x61, 0x43, 0xa2, 0x15, 0x34, 0x42, 0x74, 0xd2, 0xb5, 0x42, 0x84, 0xe8, 0x85,
+	0x0d, 0x89, 0x54, 0x88, 0x0e, 0xad, 0x56, 0x88, 0x10, 0xbd, 0xb0, 0x21, 0xb1, 0x14, 0x1a, 0x01,
+	0x90, 0xe9, 0x9d, 0xd5, 0x95, 0x7f, 0xf3, 0xbc, 0xa0, 0x19, 0xcf, 0xaa, 0x5c, 0x5f, 0xbd, 0x2f,
+	0x38, 0x9b, 0x2f, 0x38, 0xfb, 0x5c, 0x70, 0xf6, 0xba, 0xe4, 0xad, 0xf9, 0x92, 0xb7, 0x3e, 0x96,
+	0xbc, 0x75, 0x77, 0x42, 0xff, 0xfd, 0xac, 0xba, 0x40, 0x9e, 0x73, 0x54, 0xf7, 0x5b, 0xc5, 0x1a,
+	0xb8, 0xfc, 0x1a, 0x00, 0xdb, 0x22, 0x44, 0xc7, 0x68, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -355,6 +473,7 @@ type MsgClient interface {
 	CreateChain(ctx context.Context, in *MsgCreateChain, opts ...grpc.CallOption) (*MsgCreateChainResponse, error)
 	UpdateChain(ctx context.Context, in *MsgUpdateChain, opts ...grpc.CallOption) (*MsgUpdateChainResponse, error)
 	DeleteChain(ctx context.Context, in *MsgDeleteChain, opts ...grpc.CallOption) (*MsgDeleteChainResponse, error)
+	ResetChain(ctx context.Context, in *MsgResetChain, opts ...grpc.CallOption) (*MsgResetChainResponse, error)
 }
 
 type msgClient struct {
@@ -392,11 +511,21 @@ func (c *msgClient) DeleteChain(ctx context.Context, in *MsgDeleteChain, opts ..
 	return out, nil
 }
 
+func (c *msgClient) ResetChain(ctx context.Context, in *MsgResetChain, opts ...grpc.CallOption) (*MsgResetChainResponse, error) {
+	out := new(MsgResetChainResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.healthcheck.Msg/ResetChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateChain(context.Context, *MsgCreateChain) (*MsgCreateChainResponse, error)
 	UpdateChain(context.Context, *MsgUpdateChain) (*MsgUpdateChainResponse, error)
 	DeleteChain(context.Context, *MsgDeleteChain) (*MsgDeleteChainResponse, error)
+	ResetChain(context.Context, *MsgResetChain) (*MsgResetChainResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -412,6 +541,9 @@ func (*UnimplementedMsgServer) UpdateChain(ctx context.Context, req *MsgUpdateCh
 func (*UnimplementedMsgServer) DeleteChain(ctx context.Context, req *MsgDeleteChain) (*MsgDeleteChainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteChain not implemented")
 }
+func (*UnimplementedMsgServer) ResetChain(ctx context.Context, req *MsgResetChain) (*MsgResetChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetChain not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -471,6 +603,24 @@ func _Msg_DeleteChain_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ResetChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.healthcheck.Msg/ResetChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetChain(ctx, req.(*MsgResetChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "healthcheck.healthcheck.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -487,6 +637,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteChain",
 			Handler:    _Msg_DeleteChain_Handler,
 		},
+		{
+			MethodName: "ResetChain",
+			Handler:    _Msg_ResetChain_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "healthcheck/healthcheck/tx.proto",
@@ -512,6 +666,16 @@ func (m *MsgCreateChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Passive {
+		i--
+		if m.Passive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ConnectionId) > 0 {
 		i -= len(m.ConnectionId)
 		copy(dAtA[i:], m.ConnectionId)
@@ -579,6 +743,16 @@ func (m *MsgUpdateChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Passive {
+		i--
+		if m.Passive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ConnectionId) > 0 {
 		i -= len(m.ConnectionId)
 		copy(dAtA[i:], m.ConnectionId)
@@ -686,6 +860,73 @@ func (m *MsgDeleteChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgResetChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewChainId) > 0 {
+		i -= len(m.NewChainId)
+		copy(dAtA[i:], m.NewChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -715,6 +956,9 @@ func (m *MsgCreateChain) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Passive {
+		n += 2
+	}
 	return n
 }
 
@@ -745,6 +989,9 @@ func (m *MsgUpdateChain) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Passive {
+		n += 2
+	}
 	return n
 }
 
@@ -783,6 +1030,36 @@ func (m *MsgDeleteChainResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgResetChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResetChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -914,6 +1191,26 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 			}
 			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1110,6 +1407,26 @@ func (m *MsgUpdateChain) Unmarshal(dAtA []byte) error {
 			}
 			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1345,6 +1662,202 @@ func (m *MsgDeleteChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgResetChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
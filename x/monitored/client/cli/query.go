@@ -25,6 +25,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdShowLastHealthcheckAck())
+	cmd.AddCommand(CmdShowHealthcheckState())
+	cmd.AddCommand(CmdDeliveryLog())
+	cmd.AddCommand(CmdListRegistryChannel())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"healthcheck/x/monitored/types"
+)
+
+func CmdShowLastHealthcheckAck() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-last-healthcheck-ack [channel-id]",
+		Short: "shows how the registry chain recorded the last healthcheck update acknowledged through a registry channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LastHealthcheckAck(context.Background(), &types.QueryLastHealthcheckAckRequest{ChannelId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
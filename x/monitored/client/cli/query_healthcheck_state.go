@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"healthcheck/x/monitored/types"
+)
+
+func CmdShowHealthcheckState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-healthcheck-state [channel-id]",
+		Short: "shows the local state of healthcheck updates sent through a registry channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HealthcheckState(context.Background(), &types.QueryHealthcheckStateRequest{ChannelId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
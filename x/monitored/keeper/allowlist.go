@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"healthcheck/x/monitored/types"
+)
+
+// GetCounterpartyChainIDFromConnection returns the chain ID of the counterparty chain of the given connection,
+// as resolved from the light client the connection is built on
+func (k Keeper) GetCounterpartyChainIDFromConnection(ctx sdk.Context, connectionID string) (string, error) {
+	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return "", sdkerrors.Wrapf(connectiontypes.ErrConnectionNotFound, "connection-id: %s", connectionID)
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return "", sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "client-id: %s", connection.ClientId)
+	}
+
+	return k.chainIDResolvers.ResolveChainID(clientState)
+}
+
+// ValidateRegistryConnection returns an error if a registry channel can't be opened on the given connection,
+// because either the connection or the counterparty chain isn't in the allowlist
+func (k Keeper) ValidateRegistryConnection(ctx sdk.Context, connectionID string) error {
+	if allowedConnections := k.AllowedConnections(ctx); len(allowedConnections) != 0 && !contains(allowedConnections, connectionID) {
+		return sdkerrors.Wrapf(types.ErrRegistryNotAllowed, "connection %s isn't allowed", connectionID)
+	}
+
+	allowedChains := k.AllowedRegistryChains(ctx)
+	if len(allowedChains) == 0 {
+		return nil
+	}
+
+	chainID, err := k.GetCounterpartyChainIDFromConnection(ctx, connectionID)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrRegistryNotAllowed, "registry chain can't be resolved: %v", err)
+	}
+
+	if !contains(allowedChains, chainID) {
+		return sdkerrors.Wrapf(types.ErrRegistryNotAllowed, "chain %s isn't allowed", chainID)
+	}
+
+	return nil
+}
+
+// ValidateRegistryChannel returns an error if the given registry channel isn't built on an allowed connection to an
+// allowed registry chain
+func (k Keeper) ValidateRegistryChannel(ctx sdk.Context, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "channel-id: %s", channelID)
+	}
+
+	if len(channel.ConnectionHops) == 0 {
+		return sdkerrors.Wrapf(channeltypes.ErrTooManyConnectionHops, "channel %s has no connection hops", channelID)
+	}
+
+	return k.ValidateRegistryConnection(ctx, channel.ConnectionHops[0])
+}
+
+func contains(list []string, item string) bool {
+	for _, listItem := range list {
+		if listItem == item {
+			return true
+		}
+	}
+
+	return false
+}
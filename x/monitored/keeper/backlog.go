@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/monitored/types"
+)
+
+// GetSkippedUpdates returns the number of scheduled healthcheck updates that weren't sent through the registry channel
+// since the last sent one
+func (k Keeper) GetSkippedUpdates(ctx sdk.Context, channelID string) uint64 {
+	lastUpdateHeight := k.GetLastHealthcheckUpdateHeight(ctx, channelID)
+	if lastUpdateHeight == 0 {
+		return 0
+	}
+
+	elapsedPeriods := (uint64(ctx.BlockHeight()) - lastUpdateHeight) / k.GetUpdatePeriod(ctx, channelID)
+	if elapsedPeriods == 0 {
+		return 0
+	}
+
+	return elapsedPeriods - 1
+}
+
+// ShouldSkipUpdate returns true if the scheduled healthcheck update shouldn't be sent through the given channel.
+// In the latest-only mode updates are skipped while the last sent one is still unacknowledged, until the number
+// of skipped updates reaches the backlog threshold. A fresh update is then sent and only the fresh one is waited
+// for. The earlier updates stay in flight, since packets committed on a channel can't be withdrawn, so the backlog
+// still grows by one update every BacklogThreshold+1 update periods while the registry chain isn't reached.
+func (k Keeper) ShouldSkipUpdate(ctx sdk.Context, channelID string) bool {
+	if !k.LatestOnly(ctx) || !k.IsUpdatePending(ctx, channelID, k.GetLastUpdateSequence(ctx, channelID)) {
+		return false
+	}
+
+	backlogThreshold := k.BacklogThreshold(ctx)
+
+	return backlogThreshold == 0 || k.GetSkippedUpdates(ctx, channelID) < backlogThreshold
+}
+
+// GetLastUpdateSequence returns the sequence of the last healthcheck update packet sent through the registry channel
+func (k Keeper) GetLastUpdateSequence(ctx sdk.Context, channelID string) uint64 {
+	return k.getChannelUint64(ctx, types.LastUpdateSequenceKeyPrefix, channelID)
+}
+
+// AddPendingUpdate records the healthcheck update packet sent through the registry channel as the last one in flight
+func (k Keeper) AddPendingUpdate(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingUpdateKeyPrefix))
+	store.Set(types.DeliveryRecordIndexKey(channelID, sequence), []byte{1})
+
+	k.setChannelUint64(ctx, types.LastUpdateSequenceKeyPrefix, channelID, sequence)
+}
+
+// RemovePendingUpdate removes the acknowledged or timed out healthcheck update packet from the ones in flight
+func (k Keeper) RemovePendingUpdate(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingUpdateKeyPrefix))
+	store.Delete(types.DeliveryRecordIndexKey(channelID, sequence))
+}
+
+// IsUpdatePending returns true if the healthcheck update packet wasn't acknowledged nor timed out yet
+func (k Keeper) IsUpdatePending(ctx sdk.Context, channelID string, sequence uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingUpdateKeyPrefix))
+	return store.Has(types.DeliveryRecordIndexKey(channelID, sequence))
+}
+
+// GetPendingSequences returns the sequences of healthcheck update packets sent through the given channel
+// that weren't acknowledged nor timed out yet. Other packets, such as the interval change requests, aren't included.
+func (k Keeper) GetPendingSequences(ctx sdk.Context, channelID string) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingUpdateKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.RegistryChannelKey(channelID))
+
+	defer iterator.Close()
+
+	sequences := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the channel ID followed by the sequence and a separator
+		key := iterator.Key()
+		sequences = append(sequences, binary.BigEndian.Uint64(key[len(key)-9:len(key)-1]))
+	}
+
+	return sequences
+}
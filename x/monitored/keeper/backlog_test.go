@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "healthcheck/testutil/keeper"
+	"healthcheck/x/monitored/types"
+)
+
+func TestShouldSkipUpdate(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+	params := types.DefaultParams()
+	params.LatestOnly = true
+	params.BacklogThreshold = 2
+	keeper.SetParams(ctx, params)
+
+	keeper.SetLastHealthcheckUpdateHeight(ctx, "channel-0", 10)
+	keeper.AddPendingUpdate(ctx, "channel-0", 1)
+	keeper.AddPendingUpdate(ctx, "channel-0", 2)
+	require.Equal(t, []uint64{1, 2}, keeper.GetPendingSequences(ctx, "channel-0"))
+	require.Empty(t, keeper.GetPendingSequences(ctx, "channel-1"))
+
+	// the last update is in flight and the backlog threshold isn't reached yet
+	ctx = ctx.WithBlockHeight(10 + 2*types.UpdateInterval)
+	require.True(t, keeper.ShouldSkipUpdate(ctx, "channel-0"))
+
+	ctx = ctx.WithBlockHeight(10 + 3*types.UpdateInterval)
+	require.False(t, keeper.ShouldSkipUpdate(ctx, "channel-0"))
+
+	// only the last update is waited for
+	ctx = ctx.WithBlockHeight(10 + 2*types.UpdateInterval)
+	keeper.RecordDeliveryResult(ctx, "channel-0", 2, types.AckSuccess, "")
+	require.Equal(t, []uint64{1}, keeper.GetPendingSequences(ctx, "channel-0"))
+	require.False(t, keeper.ShouldSkipUpdate(ctx, "channel-0"))
+}
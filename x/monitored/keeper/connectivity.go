@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
+)
+
+// GetConnectivitySummary returns the summary of the IBC channels of the chain, including the status of the light
+// clients they're built on and the number of their pending packets. At most MaxConnectivityChannels channels are
+// summarized.
+func (k Keeper) GetConnectivitySummary(ctx sdk.Context) commontypes.ConnectivitySummary {
+	var summary commontypes.ConnectivitySummary
+	maxChannels := k.MaxConnectivityChannels(ctx)
+	k.channelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		if uint64(len(summary.Channels)) >= maxChannels {
+			return true
+		}
+
+		channelSummary := commontypes.ChannelSummary{
+			PortId:         channel.PortId,
+			ChannelId:      channel.ChannelId,
+			State:          channel.State.String(),
+			ClientStatus:   ibcexported.Unknown.String(),
+			PendingPackets: k.countPendingPackets(ctx, channel.PortId, channel.ChannelId),
+		}
+
+		clientID, clientState, err := k.channelKeeper.GetChannelClientState(ctx, channel.PortId, channel.ChannelId)
+		if err != nil {
+			k.Logger(ctx).Debug("failed to get client state of channel", "channel-id", channel.ChannelId, "error", err.Error())
+		} else {
+			if chainID, err := k.chainIDResolvers.ResolveChainID(clientState); err == nil {
+				channelSummary.CounterpartyChainId = chainID
+			}
+
+			channelSummary.ClientStatus = clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc).String()
+		}
+
+		summary.Channels = append(summary.Channels, channelSummary)
+		return false
+	})
+
+	return summary
+}
+
+// countPendingPackets counts the packet commitments of the channel, up to MaxCountedPendingPackets,
+// without collecting them
+func (k Keeper) countPendingPackets(ctx sdk.Context, portID, channelID string) (count uint64) {
+	k.channelKeeper.IteratePacketCommitmentAtChannel(ctx, portID, channelID, func(_, _ string, _ uint64, _ []byte) bool {
+		count++
+		return count >= types.MaxCountedPendingPackets
+	})
+
+	return count
+}
+
+// ShouldReportConnectivity returns true if the next healthcheck update should carry the connectivity summary,
+// which is reported every ConnectivityReportInterval updates sent through the registry channel
+func (k Keeper) ShouldReportConnectivity(ctx sdk.Context, channelID string) bool {
+	return k.isReportDue(ctx, channelID, k.ConnectivityReportInterval(ctx))
+}
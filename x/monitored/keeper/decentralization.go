@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "healthcheck/x/types"
+)
+
+// GetDecentralizationMetrics returns the decentralization metrics of the active validator set
+func (k Keeper) GetDecentralizationMetrics(ctx sdk.Context) commontypes.DecentralizationMetrics {
+	var votingPowers []uint64
+	k.stakingKeeper.IterateLastValidatorPowers(ctx, func(_ sdk.ValAddress, power int64) bool {
+		votingPowers = append(votingPowers, uint64(power))
+		return false
+	})
+
+	return commontypes.NewDecentralizationMetrics(votingPowers, k.ConcentrationTopN(ctx))
+}
+
+// ShouldReportDecentralization returns true if the next healthcheck update should carry the decentralization
+// metrics, which are reported every DecentralizationReportInterval updates sent through the registry channel
+func (k Keeper) ShouldReportDecentralization(ctx sdk.Context, channelID string) bool {
+	return k.isReportDue(ctx, channelID, k.DecentralizationReportInterval(ctx))
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/monitored/types"
+)
+
+// GetLastDeliveryResult returns the outcome of the last healthcheck update packet sent through the registry channel
+// that was acknowledged or timed out
+func (k Keeper) GetLastDeliveryResult(ctx sdk.Context, channelID string) (deliveryResult types.DeliveryResult) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastDeliveryResultKeyPrefix))
+	bz := store.Get(types.RegistryChannelKey(channelID))
+	if bz == nil {
+		return deliveryResult
+	}
+
+	k.cdc.MustUnmarshal(bz, &deliveryResult)
+	return deliveryResult
+}
+
+func (k Keeper) SetLastDeliveryResult(ctx sdk.Context, channelID string, deliveryResult types.DeliveryResult) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastDeliveryResultKeyPrefix))
+	store.Set(types.RegistryChannelKey(channelID), k.cdc.MustMarshal(&deliveryResult))
+}
+
+// GetConsecutiveFailures returns the number of healthcheck update packets sent through the registry channel
+// that failed to be delivered since the last successful delivery
+func (k Keeper) GetConsecutiveFailures(ctx sdk.Context, channelID string) uint64 {
+	return k.getChannelUint64(ctx, types.ConsecutiveFailuresKeyPrefix, channelID)
+}
+
+func (k Keeper) SetConsecutiveFailures(ctx sdk.Context, channelID string, failures uint64) {
+	k.setChannelUint64(ctx, types.ConsecutiveFailuresKeyPrefix, channelID, failures)
+}
+
+// RecordDeliveryResult stores the outcome of an acknowledged or timed out healthcheck update packet
+// and counts the consecutive failed deliveries through the channel
+func (k Keeper) RecordDeliveryResult(ctx sdk.Context, channelID string, sequence uint64, outcome types.DeliveryOutcome, errMsg string) {
+	k.SetLastDeliveryResult(ctx, channelID, types.DeliveryResult{
+		Sequence: sequence,
+		Outcome:  uint64(outcome),
+		Error:    errMsg,
+		Height:   uint64(ctx.BlockHeight()),
+	})
+
+	if deliveryRecord, found := k.GetDeliveryRecordOfPacket(ctx, channelID, sequence); found {
+		deliveryRecord.Outcome = uint64(outcome)
+		deliveryRecord.Error = errMsg
+		deliveryRecord.ResultHeight = uint64(ctx.BlockHeight())
+		k.SetDeliveryRecord(ctx, deliveryRecord)
+	}
+
+	k.RemovePendingUpdate(ctx, channelID, sequence)
+
+	if outcome == types.AckSuccess {
+		k.SetConsecutiveFailures(ctx, channelID, 0)
+	} else {
+		k.SetConsecutiveFailures(ctx, channelID, k.GetConsecutiveFailures(ctx, channelID)+1)
+	}
+}
+
+// SetDeliveryRecord set a specific deliveryRecord in the store from its index
+func (k Keeper) SetDeliveryRecord(ctx sdk.Context, deliveryRecord types.DeliveryRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeliveryRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&deliveryRecord)
+	store.Set(types.DeliveryRecordKey(
+		deliveryRecord.Index,
+	), b)
+}
+
+// GetDeliveryRecord returns a deliveryRecord from its index
+func (k Keeper) GetDeliveryRecord(
+	ctx sdk.Context,
+	index uint64,
+
+) (val types.DeliveryRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeliveryRecordKeyPrefix))
+
+	b := store.Get(types.DeliveryRecordKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetDeliveryRecordOfPacket returns the deliveryRecord of the healthcheck update packet sent through the given channel
+func (k Keeper) GetDeliveryRecordOfPacket(ctx sdk.Context, channelID string, sequence uint64) (val types.DeliveryRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeliveryRecordIndexKeyPrefix))
+
+	b := store.Get(types.DeliveryRecordIndexKey(channelID, sequence))
+	if b == nil {
+		return val, false
+	}
+
+	return k.GetDeliveryRecord(ctx, binary.BigEndian.Uint64(b))
+}
+
+// GetAllDeliveryRecord returns all deliveryRecord, from the oldest to the most recent
+func (k Keeper) GetAllDeliveryRecord(ctx sdk.Context) (list []types.DeliveryRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeliveryRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DeliveryRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetNextDeliveryRecordIndex returns the index of the next record of the delivery log,
+// which is the number of healthcheck updates sent so far
+func (k Keeper) GetNextDeliveryRecordIndex(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextDeliveryRecordIndexKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// isReportDue returns true if the next healthcheck update sent through the registry channel is due to carry
+// a report sent every reportInterval updates. Zero reportInterval disables the report.
+func (k Keeper) isReportDue(ctx sdk.Context, channelID string, reportInterval uint64) bool {
+	if reportInterval == 0 {
+		return false
+	}
+
+	return k.GetUpdatesSent(ctx, channelID)%reportInterval == 0
+}
+
+// AppendDeliveryRecord adds the healthcheck update packet sent through the given channel to the delivery log.
+// The oldest records are removed, so that the log contains at most DeliveryLogSize records.
+func (k Keeper) AppendDeliveryRecord(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	index := k.GetNextDeliveryRecordIndex(ctx)
+
+	k.SetDeliveryRecord(ctx, types.DeliveryRecord{
+		Index:     index,
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Height:    uint64(ctx.BlockHeight()),
+		Timestamp: uint64(ctx.BlockTime().UnixNano()),
+		Outcome:   uint64(types.Pending),
+	})
+
+	indexBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBz, index)
+	indexStore := prefix.NewStore(store, types.KeyPrefix(types.DeliveryRecordIndexKeyPrefix))
+	indexStore.Set(types.DeliveryRecordIndexKey(channelID, sequence), indexBz)
+
+	nextIndexBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(nextIndexBz, index+1)
+	store.Set(types.NextDeliveryRecordIndexKey, nextIndexBz)
+
+	k.SetUpdatesSent(ctx, channelID, k.GetUpdatesSent(ctx, channelID)+1)
+
+	k.pruneDeliveryLog(ctx, index+1)
+}
+
+// pruneDeliveryLog removes the oldest records that don't fit into the delivery log anymore
+func (k Keeper) pruneDeliveryLog(ctx sdk.Context, nextIndex uint64) {
+	logSize := k.DeliveryLogSize(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeliveryRecordKeyPrefix))
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeliveryRecordIndexKeyPrefix))
+
+	var pruned []types.DeliveryRecord
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DeliveryRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		// records are iterated from the oldest one
+		if val.Index+logSize >= nextIndex {
+			break
+		}
+
+		pruned = append(pruned, val)
+	}
+	iterator.Close()
+
+	for _, deliveryRecord := range pruned {
+		store.Delete(types.DeliveryRecordKey(deliveryRecord.Index))
+		indexStore.Delete(types.DeliveryRecordIndexKey(deliveryRecord.ChannelId, deliveryRecord.Sequence))
+	}
+}
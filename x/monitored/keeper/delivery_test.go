@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	testkeeper "healthcheck/testutil/keeper"
+	"healthcheck/x/monitored/types"
+)
+
+func TestRecordDeliveryResult(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+
+	keeper.RecordDeliveryResult(ctx, "channel-0", 1, types.AckError, "rejected")
+	keeper.RecordDeliveryResult(ctx, "channel-0", 2, types.TimedOut, "")
+	require.Equal(t, uint64(2), keeper.GetConsecutiveFailures(ctx, "channel-0"))
+	require.Equal(t, types.DeliveryResult{
+		Sequence: 2,
+		Outcome:  uint64(types.TimedOut),
+	}, keeper.GetLastDeliveryResult(ctx, "channel-0"))
+
+	// delivery state is kept for each registry channel
+	keeper.RecordDeliveryResult(ctx, "channel-1", 1, types.AckSuccess, "")
+	require.Equal(t, uint64(2), keeper.GetConsecutiveFailures(ctx, "channel-0"))
+
+	keeper.RecordDeliveryResult(ctx, "channel-0", 3, types.AckSuccess, "")
+	require.Zero(t, keeper.GetConsecutiveFailures(ctx, "channel-0"))
+	require.Equal(t, types.DeliveryResult{
+		Sequence: 3,
+		Outcome:  uint64(types.AckSuccess),
+	}, keeper.GetLastDeliveryResult(ctx, "channel-0"))
+}
+
+func TestDeliveryLog(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(0, 0))
+	params := types.DefaultParams()
+	params.DeliveryLogSize = 2
+	keeper.SetParams(ctx, params)
+
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		keeper.AppendDeliveryRecord(ctx, "channel-0", sequence)
+	}
+	keeper.RecordDeliveryResult(ctx, "channel-0", 3, types.AckError, "rejected")
+
+	// the oldest record didn't fit into the log
+	_, found := keeper.GetDeliveryRecordOfPacket(ctx, "channel-0", 1)
+	require.False(t, found)
+	require.Equal(t, []types.DeliveryRecord{
+		{
+			Index:     1,
+			ChannelId: "channel-0",
+			Sequence:  2,
+			Outcome:   uint64(types.Pending),
+		},
+		{
+			Index:     2,
+			ChannelId: "channel-0",
+			Sequence:  3,
+			Outcome:   uint64(types.AckError),
+			Error:     "rejected",
+		},
+	}, keeper.GetAllDeliveryRecord(ctx))
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
+)
+
+// RegisterHealthReporter adds a reporter whose indicators are included in the healthcheck updates.
+// It panics if a reporter with the same name is already registered.
+func (k Keeper) RegisterHealthReporter(reporter types.HealthReporter) {
+	if _, found := k.healthReporters[reporter.Name()]; found {
+		panic(fmt.Sprintf("health reporter %s is already registered", reporter.Name()))
+	}
+
+	k.healthReporters[reporter.Name()] = reporter
+}
+
+// CollectIndicators returns the indicators of all registered reporters, ordered by the reporter name.
+// Indicators are attributed to the reporter that contributed them. At most MaxIndicatorsPerReporter indicators
+// of each reporter, and MaxIndicators in total, are collected. Indicators larger than MaxIndicatorSize are dropped.
+func (k Keeper) CollectIndicators(ctx sdk.Context) []commontypes.Indicator {
+	names := make([]string, 0, len(k.healthReporters))
+	for name := range k.healthReporters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	maxIndicatorsPerReporter := k.MaxIndicatorsPerReporter(ctx)
+	maxIndicators := k.MaxIndicators(ctx)
+
+	var indicators []commontypes.Indicator
+	for _, name := range names {
+		var reported uint64
+		for _, indicator := range k.reportHealth(ctx, name) {
+			if uint64(len(indicators)) >= maxIndicators {
+				return indicators
+			}
+
+			if reported >= maxIndicatorsPerReporter {
+				k.Logger(ctx).Debug("health indicators above the limit are dropped", "reporter", name)
+				break
+			}
+
+			if indicator.Key == "" {
+				k.Logger(ctx).Debug("health indicator without key is ignored", "reporter", name)
+				continue
+			}
+
+			indicator.Reporter = name
+			if indicator.Size() > types.MaxIndicatorSize {
+				k.Logger(ctx).Debug("health indicator is too large", "reporter", name, "key", indicator.Key)
+				continue
+			}
+
+			indicators = append(indicators, indicator)
+			reported++
+		}
+	}
+
+	return indicators
+}
+
+// reportHealth returns the indicators of the reporter. The reporter runs on a cached context, whose state changes
+// are discarded, and a panicking reporter contributes no indicators instead of halting the chain.
+func (k Keeper) reportHealth(ctx sdk.Context, name string) (indicators []commontypes.Indicator) {
+	defer func() {
+		if r := recover(); r != nil {
+			k.Logger(ctx).Error("health reporter panicked", "reporter", name, "panic", fmt.Sprint(r))
+			indicators = nil
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+	return k.healthReporters[name].ReportHealth(cacheCtx)
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "healthcheck/testutil/keeper"
+	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
+)
+
+type staticHealthReporter struct {
+	name       string
+	indicators []commontypes.Indicator
+}
+
+func (r staticHealthReporter) Name() string {
+	return r.name
+}
+
+func (r staticHealthReporter) ReportHealth(sdk.Context) []commontypes.Indicator {
+	return r.indicators
+}
+
+func TestCollectIndicators(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+	require.Empty(t, keeper.CollectIndicators(ctx))
+
+	keeper.RegisterHealthReporter(staticHealthReporter{
+		name: "dex",
+		indicators: []commontypes.Indicator{
+			{Key: "pools", Value: &commontypes.Indicator_UintValue{UintValue: 3}},
+			{Key: "", Value: &commontypes.Indicator_BoolValue{BoolValue: true}},
+		},
+	})
+	keeper.RegisterHealthReporter(staticHealthReporter{
+		name: "bridge",
+		indicators: []commontypes.Indicator{
+			// reporters can't contribute indicators on behalf of others
+			{Reporter: "dex", Key: "paused", Value: &commontypes.Indicator_BoolValue{BoolValue: false}},
+		},
+	})
+
+	require.Panics(t, func() {
+		keeper.RegisterHealthReporter(staticHealthReporter{name: "dex"})
+	})
+
+	require.Equal(t, []commontypes.Indicator{
+		{Reporter: "bridge", Key: "paused", Value: &commontypes.Indicator_BoolValue{BoolValue: false}},
+		{Reporter: "dex", Key: "pools", Value: &commontypes.Indicator_UintValue{UintValue: 3}},
+	}, keeper.CollectIndicators(ctx))
+}
+
+type panickingHealthReporter struct{}
+
+func (panickingHealthReporter) Name() string {
+	return "faulty"
+}
+
+func (panickingHealthReporter) ReportHealth(sdk.Context) []commontypes.Indicator {
+	panic("faulty reporter")
+}
+
+func TestCollectIndicatorsPanickingReporter(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+	keeper.RegisterHealthReporter(panickingHealthReporter{})
+	keeper.RegisterHealthReporter(staticHealthReporter{
+		name: "dex",
+		indicators: []commontypes.Indicator{
+			{Key: "pools", Value: &commontypes.Indicator_UintValue{UintValue: 3}},
+		},
+	})
+
+	require.Equal(t, []commontypes.Indicator{
+		{Reporter: "dex", Key: "pools", Value: &commontypes.Indicator_UintValue{UintValue: 3}},
+	}, keeper.CollectIndicators(ctx))
+}
+
+func TestCollectIndicatorsLimits(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+	params := types.DefaultParams()
+	params.MaxIndicatorsPerReporter = 2
+	params.MaxIndicators = 3
+	keeper.SetParams(ctx, params)
+
+	indicators := []commontypes.Indicator{
+		{Key: strings.Repeat("x", types.MaxIndicatorSize), Value: &commontypes.Indicator_BoolValue{BoolValue: true}},
+		{Key: "a", Value: &commontypes.Indicator_UintValue{UintValue: 1}},
+		{Key: "b", Value: &commontypes.Indicator_UintValue{UintValue: 2}},
+		{Key: "c", Value: &commontypes.Indicator_UintValue{UintValue: 3}},
+	}
+	keeper.RegisterHealthReporter(staticHealthReporter{name: "bridge", indicators: indicators})
+	keeper.RegisterHealthReporter(staticHealthReporter{name: "dex", indicators: indicators})
+
+	// the oversized indicator is dropped, each reporter contributes two indicators and the update carries three
+	require.Equal(t, []commontypes.Indicator{
+		{Reporter: "bridge", Key: "a", Value: &commontypes.Indicator_UintValue{UintValue: 1}},
+		{Reporter: "bridge", Key: "b", Value: &commontypes.Indicator_UintValue{UintValue: 2}},
+		{Reporter: "dex", Key: "a", Value: &commontypes.Indicator_UintValue{UintValue: 1}},
+	}, keeper.CollectIndicators(ctx))
+}
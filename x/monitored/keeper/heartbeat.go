@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
+)
+
+// GetHeartbeatState returns the state of the chain reported in the last healthcheck update sent through
+// the registry channel
+func (k Keeper) GetHeartbeatState(ctx sdk.Context, channelID string) (state types.HeartbeatState, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HeartbeatStateKeyPrefix))
+	bz := store.Get(types.RegistryChannelKey(channelID))
+	if bz == nil {
+		return state, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &state)
+	return state, true
+}
+
+func (k Keeper) SetHeartbeatState(ctx sdk.Context, channelID string, state types.HeartbeatState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HeartbeatStateKeyPrefix))
+	store.Set(types.RegistryChannelKey(channelID), k.cdc.MustMarshal(&state))
+}
+
+// GetValidatorPowers returns the voting power of the active validators
+func (k Keeper) GetValidatorPowers(ctx sdk.Context) (validatorPowers []types.ValidatorPower) {
+	k.stakingKeeper.IterateLastValidatorPowers(ctx, func(operator sdk.ValAddress, power int64) bool {
+		validatorPowers = append(validatorPowers, types.ValidatorPower{
+			OperatorAddress: operator.String(),
+			Power:           uint64(power),
+		})
+		return false
+	})
+
+	return validatorPowers
+}
+
+// RecordHeartbeatState stores the current state of the chain, so that the following out-of-band heartbeats
+// are triggered only by the changes made after the healthcheck update that was just sent through the registry channel
+func (k Keeper) RecordHeartbeatState(ctx sdk.Context, channelID string) {
+	state := types.HeartbeatState{
+		ValidatorPowers: k.GetValidatorPowers(ctx),
+		Params:          k.GetParams(ctx),
+	}
+
+	if plan, found := k.upgradeKeeper.GetUpgradePlan(ctx); found {
+		state.UpgradePlanName = plan.Name
+		state.UpgradePlanHeight = plan.Height
+	}
+
+	k.SetHeartbeatState(ctx, channelID, state)
+}
+
+// GetHeartbeatReason returns the reason for an out-of-band heartbeat, if any of the enabled triggers
+// fired since the last healthcheck update sent through the registry channel. Otherwise, ScheduledHeartbeat is returned.
+func (k Keeper) GetHeartbeatReason(ctx sdk.Context, channelID string) commontypes.HeartbeatReason {
+	state, found := k.GetHeartbeatState(ctx, channelID)
+	if !found {
+		return commontypes.ScheduledHeartbeat
+	}
+
+	if params := k.GetParams(ctx); k.HeartbeatOnParamsChange(ctx) && !proto.Equal(&state.Params, &params) {
+		return commontypes.ParamsChange
+	}
+
+	if plan, found := k.upgradeKeeper.GetUpgradePlan(ctx); k.HeartbeatOnUpgradePlan(ctx) && found &&
+		(plan.Name != state.UpgradePlanName || plan.Height != state.UpgradePlanHeight) {
+		return commontypes.UpgradeScheduled
+	}
+
+	if threshold := k.ValidatorSetChangeThreshold(ctx); threshold != 0 &&
+		types.ValidatorSetChange(state.ValidatorPowers, k.GetValidatorPowers(ctx)) > threshold {
+		return commontypes.ValidatorSetChange
+	}
+
+	return commontypes.ScheduledHeartbeat
+}
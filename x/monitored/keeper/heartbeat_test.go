@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	testkeeper "healthcheck/testutil/keeper"
+	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestValidatorSetChange(t *testing.T) {
+	previous := []types.ValidatorPower{
+		{OperatorAddress: "a", Power: 50},
+		{OperatorAddress: "b", Power: 30},
+		{OperatorAddress: "c", Power: 20},
+	}
+
+	require.Equal(t, uint64(0), types.ValidatorSetChange(nil, nil))
+	require.Equal(t, uint64(0), types.ValidatorSetChange(previous, previous))
+	// 10 units of power moved from a to b
+	require.Equal(t, uint64(1000), types.ValidatorSetChange(previous, []types.ValidatorPower{
+		{OperatorAddress: "a", Power: 40},
+		{OperatorAddress: "b", Power: 40},
+		{OperatorAddress: "c", Power: 20},
+	}))
+	// the whole validator set is replaced
+	require.Equal(t, commontypes.BasisPoints, types.ValidatorSetChange(previous, []types.ValidatorPower{
+		{OperatorAddress: "d", Power: 100},
+	}))
+}
+
+func TestGetHeartbeatReason(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+
+	// nothing was reported yet
+	require.Equal(t, commontypes.ScheduledHeartbeat, keeper.GetHeartbeatReason(ctx, "channel-0"))
+
+	keeper.RecordHeartbeatState(ctx, "channel-0")
+	require.Equal(t, commontypes.ScheduledHeartbeat, keeper.GetHeartbeatReason(ctx, "channel-0"))
+
+	params := keeper.GetParams(ctx)
+	params.LatestOnly = !params.LatestOnly
+	keeper.SetParams(ctx, params)
+	require.Equal(t, commontypes.ParamsChange, keeper.GetHeartbeatReason(ctx, "channel-0"))
+
+	keeper.RecordHeartbeatState(ctx, "channel-0")
+	require.Equal(t, commontypes.ScheduledHeartbeat, keeper.GetHeartbeatReason(ctx, "channel-0"))
+
+	// disabling the trigger is a params change itself, so it is reported only when the trigger is enabled
+	params.HeartbeatOnParamsChange = false
+	keeper.SetParams(ctx, params)
+	require.Equal(t, commontypes.ScheduledHeartbeat, keeper.GetHeartbeatReason(ctx, "channel-0"))
+}
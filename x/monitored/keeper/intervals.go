@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
+)
+
+// GetChannelIntervals returns the update and timeout intervals to be negotiated on the registry channel.
+// The intervals set for the channel in the ChannelIntervals param override the UpdateInterval and
+// TimeoutInterval params.
+func (k Keeper) GetChannelIntervals(ctx sdk.Context, channelID string) (updateInterval uint64, timeoutInterval uint64) {
+	for _, intervals := range k.ChannelIntervals(ctx) {
+		if intervals.ChannelId == channelID {
+			return intervals.UpdateInterval, intervals.TimeoutInterval
+		}
+	}
+
+	return k.UpdateInterval(ctx), k.TimeoutInterval(ctx)
+}
+
+// GetLastIntervalChangeRequest returns the last interval change request sent through the registry channel
+func (k Keeper) GetLastIntervalChangeRequest(ctx sdk.Context, channelID string) (request commontypes.IntervalChangeRequest, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastIntervalChangeRequestKeyPrefix))
+	bz := store.Get(types.RegistryChannelKey(channelID))
+	if bz == nil {
+		return request, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &request)
+	return request, true
+}
+
+func (k Keeper) SetLastIntervalChangeRequest(ctx sdk.Context, channelID string, request commontypes.IntervalChangeRequest) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastIntervalChangeRequestKeyPrefix))
+	store.Set(types.RegistryChannelKey(channelID), k.cdc.MustMarshal(&request))
+}
+
+// GetIntervalChangeRequest returns the interval change request to be sent through the registry channel, if the
+// intervals set in the params differ from the negotiated ones and weren't requested yet
+func (k Keeper) GetIntervalChangeRequest(ctx sdk.Context, channelID string) (request commontypes.IntervalChangeRequest, found bool) {
+	metadata := k.GetHandshakeMetadata(ctx, channelID)
+	if !metadata.HasFeature(commontypes.FeatureIntervalChange) {
+		return request, false
+	}
+
+	request.UpdateInterval, request.TimeoutInterval = k.GetChannelIntervals(ctx, channelID)
+
+	if request.UpdateInterval == 0 || request.TimeoutInterval == 0 {
+		return request, false
+	}
+
+	if request.UpdateInterval == metadata.UpdateInterval && request.TimeoutInterval == metadata.TimeoutInterval {
+		return request, false
+	}
+
+	if lastRequest, found := k.GetLastIntervalChangeRequest(ctx, channelID); found && lastRequest == request {
+		return request, false
+	}
+
+	return request, true
+}
+
+// ApplyNegotiatedIntervals stores the intervals confirmed by registry chain
+func (k Keeper) ApplyNegotiatedIntervals(ctx sdk.Context, channelID string, updateInterval uint64, timeoutInterval uint64) {
+	registryChannel, found := k.GetRegistryChannel(ctx, channelID)
+	if !found {
+		return
+	}
+
+	registryChannel.Metadata.UpdateInterval = updateInterval
+	registryChannel.Metadata.TimeoutInterval = timeoutInterval
+	k.SetRegistryChannel(ctx, registryChannel)
+}
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -18,6 +17,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
 )
 
 type (
@@ -27,9 +27,16 @@ type (
 		memKey     storetypes.StoreKey
 		paramstore paramtypes.Subspace
 
-		channelKeeper types.ChannelKeeper
-		portKeeper    types.PortKeeper
-		scopedKeeper  exported.ScopedKeeper
+		channelKeeper    types.ChannelKeeper
+		clientKeeper     types.ClientKeeper
+		connectionKeeper types.ConnectionKeeper
+		portKeeper       types.PortKeeper
+		scopedKeeper     exported.ScopedKeeper
+		stakingKeeper    types.StakingKeeper
+		upgradeKeeper    types.UpgradeKeeper
+
+		healthReporters  map[string]types.HealthReporter
+		chainIDResolvers commontypes.ChainIDResolvers
 	}
 )
 
@@ -39,8 +46,12 @@ func NewKeeper(
 	memKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
 	channelKeeper types.ChannelKeeper,
+	clientKeeper types.ClientKeeper,
+	connectionKeeper types.ConnectionKeeper,
 	portKeeper types.PortKeeper,
 	scopedKeeper exported.ScopedKeeper,
+	stakingKeeper types.StakingKeeper,
+	upgradeKeeper types.UpgradeKeeper,
 
 ) *Keeper {
 	// set KeyTable if it has not already been set
@@ -54,9 +65,16 @@ func NewKeeper(
 		memKey:     memKey,
 		paramstore: ps,
 
-		channelKeeper: channelKeeper,
-		portKeeper:    portKeeper,
-		scopedKeeper:  scopedKeeper,
+		channelKeeper:    channelKeeper,
+		clientKeeper:     clientKeeper,
+		connectionKeeper: connectionKeeper,
+		portKeeper:       portKeeper,
+		scopedKeeper:     scopedKeeper,
+		stakingKeeper:    stakingKeeper,
+		upgradeKeeper:    upgradeKeeper,
+
+		healthReporters:  make(map[string]types.HealthReporter),
+		chainIDResolvers: commontypes.DefaultChainIDResolvers(),
 	}
 }
 
@@ -119,46 +137,19 @@ func (k Keeper) IsChannelOpen(ctx sdk.Context, channelID string) bool {
 	return channel.State == channeltypes.OPEN
 }
 
-func (k Keeper) GetRegistryChainChannelID(ctx sdk.Context) string {
-	store := ctx.KVStore(k.storeKey)
-	return string(store.Get(types.RegistryChainChannelIDKey))
-}
-
-func (k Keeper) SetRegistryChainChannelID(ctx sdk.Context, channelID string) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.RegistryChainChannelIDKey, []byte(channelID))
-}
-
-func (k Keeper) GetLastHealthcheckUpdateHeight(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.LastHealthcheckUpdateHeightKey)
-	if bz == nil {
-		return 0
-	}
-
-	return binary.BigEndian.Uint64(bz)
-}
-
-func (k Keeper) SetLastHealthcheckUpdateHeight(ctx sdk.Context, height uint64) {
-	store := ctx.KVStore(k.storeKey)
-	bz := make([]byte, 8)
-	binary.BigEndian.PutUint64(bz, height)
-	store.Set(types.LastHealthcheckUpdateHeightKey, bz)
-}
-
 func (k Keeper) SendHealthcheckUpdatePacket(
 	ctx sdk.Context,
 	portID string,
 	channelID string,
 	timeoutPeriod time.Duration,
-	packetData []byte) error {
+	packetData []byte) (uint64, error) {
 	capName := host.ChannelCapabilityPath(portID, channelID)
 	chanCap, found := k.scopedKeeper.GetCapability(ctx, capName)
 	if !found {
-		return sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "could not retrieve channel capability at: %s", capName)
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "could not retrieve channel capability at: %s", capName)
 	}
 
-	_, err := k.channelKeeper.SendPacket(
+	return k.channelKeeper.SendPacket(
 		ctx,
 		chanCap,
 		portID,
@@ -166,8 +157,12 @@ func (k Keeper) SendHealthcheckUpdatePacket(
 		clienttypes.Height{},
 		uint64(ctx.BlockTime().Add(timeoutPeriod).UnixNano()),
 		packetData)
+}
 
-	return err
+// SetChainIDResolver registers the resolver of counterparty chain IDs for the given light client type,
+// replacing the existing one
+func (k Keeper) SetChainIDResolver(clientType string, resolver commontypes.ChainIDResolver) {
+	k.chainIDResolvers[clientType] = resolver
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "healthcheck/x/monitored/migrations/v2"
+	"healthcheck/x/monitored/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The single registry channel is moved to the RegistryChannel store,
+// and the params added since version 1 are set to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+
+	params := types.DefaultParams()
+	m.keeper.paramstore.GetParamSetIfExists(ctx, &params)
+	m.keeper.SetParams(ctx, params)
+
+	return nil
+}
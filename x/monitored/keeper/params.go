@@ -7,10 +7,137 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.DeliveryLogSize(ctx),
+		k.LatestOnly(ctx),
+		k.BacklogThreshold(ctx),
+		k.UpdateInterval(ctx),
+		k.TimeoutInterval(ctx),
+		k.DecentralizationReportInterval(ctx),
+		k.ConcentrationTopN(ctx),
+		k.ConnectivityReportInterval(ctx),
+		k.ValidatorSetChangeThreshold(ctx),
+		k.HeartbeatOnUpgradePlan(ctx),
+		k.HeartbeatOnParamsChange(ctx),
+		k.ChannelIntervals(ctx),
+		k.AllowedRegistryChains(ctx),
+		k.AllowedConnections(ctx),
+		k.MaxConnectivityChannels(ctx),
+		k.MaxIndicatorsPerReporter(ctx),
+		k.MaxIndicators(ctx),
+		k.RegistryClientFreshness(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// DeliveryLogSize returns the DeliveryLogSize param
+func (k Keeper) DeliveryLogSize(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyDeliveryLogSize, &res)
+	return
+}
+
+// LatestOnly returns the LatestOnly param
+func (k Keeper) LatestOnly(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyLatestOnly, &res)
+	return
+}
+
+// BacklogThreshold returns the BacklogThreshold param
+func (k Keeper) BacklogThreshold(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyBacklogThreshold, &res)
+	return
+}
+
+// UpdateInterval returns the UpdateInterval param
+func (k Keeper) UpdateInterval(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyUpdateInterval, &res)
+	return
+}
+
+// TimeoutInterval returns the TimeoutInterval param
+func (k Keeper) TimeoutInterval(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyTimeoutInterval, &res)
+	return
+}
+
+// DecentralizationReportInterval returns the DecentralizationReportInterval param
+func (k Keeper) DecentralizationReportInterval(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyDecentralizationReportInterval, &res)
+	return
+}
+
+// ConcentrationTopN returns the ConcentrationTopN param
+func (k Keeper) ConcentrationTopN(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyConcentrationTopN, &res)
+	return
+}
+
+// ConnectivityReportInterval returns the ConnectivityReportInterval param
+func (k Keeper) ConnectivityReportInterval(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyConnectivityReportInterval, &res)
+	return
+}
+
+// ValidatorSetChangeThreshold returns the ValidatorSetChangeThreshold param
+func (k Keeper) ValidatorSetChangeThreshold(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyValidatorSetChangeThreshold, &res)
+	return
+}
+
+// HeartbeatOnUpgradePlan returns the HeartbeatOnUpgradePlan param
+func (k Keeper) HeartbeatOnUpgradePlan(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyHeartbeatOnUpgradePlan, &res)
+	return
+}
+
+// HeartbeatOnParamsChange returns the HeartbeatOnParamsChange param
+func (k Keeper) HeartbeatOnParamsChange(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyHeartbeatOnParamsChange, &res)
+	return
+}
+
+// ChannelIntervals returns the ChannelIntervals param
+func (k Keeper) ChannelIntervals(ctx sdk.Context) (res []types.ChannelIntervals) {
+	k.paramstore.Get(ctx, types.KeyChannelIntervals, &res)
+	return
+}
+
+// AllowedRegistryChains returns the AllowedRegistryChains param
+func (k Keeper) AllowedRegistryChains(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyAllowedRegistryChains, &res)
+	return
+}
+
+// AllowedConnections returns the AllowedConnections param
+func (k Keeper) AllowedConnections(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyAllowedConnections, &res)
+	return
+}
+
+// MaxConnectivityChannels returns the MaxConnectivityChannels param
+func (k Keeper) MaxConnectivityChannels(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxConnectivityChannels, &res)
+	return
+}
+
+// MaxIndicatorsPerReporter returns the MaxIndicatorsPerReporter param
+func (k Keeper) MaxIndicatorsPerReporter(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxIndicatorsPerReporter, &res)
+	return
+}
+
+// MaxIndicators returns the MaxIndicators param
+func (k Keeper) MaxIndicators(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxIndicators, &res)
+	return
+}
+
+// RegistryClientFreshness returns the RegistryClientFreshness param
+func (k Keeper) RegistryClientFreshness(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyRegistryClientFreshness, &res)
+	return
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"healthcheck/x/monitored/types"
+)
+
+// GetSigningParticipation returns the signing participation of the last commit
+func (k Keeper) GetSigningParticipation(ctx sdk.Context) (participation types.SigningParticipation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SigningParticipationKey)
+	if bz == nil {
+		return participation
+	}
+
+	k.cdc.MustUnmarshal(bz, &participation)
+	return participation
+}
+
+func (k Keeper) SetSigningParticipation(ctx sdk.Context, participation types.SigningParticipation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SigningParticipationKey, k.cdc.MustMarshal(&participation))
+}
+
+// RecordSigningParticipation stores the share of voting power that signed the last commit
+func (k Keeper) RecordSigningParticipation(ctx sdk.Context, lastCommitInfo abci.LastCommitInfo) {
+	participation := types.SigningParticipation{
+		ValidatorCount: uint64(len(lastCommitInfo.Votes)),
+		Height:         uint64(ctx.BlockHeight()),
+	}
+
+	for _, vote := range lastCommitInfo.Votes {
+		participation.TotalVotingPower += uint64(vote.Validator.Power)
+		if vote.SignedLastBlock {
+			participation.SignedVotingPower += uint64(vote.Validator.Power)
+		}
+	}
+
+	k.SetSigningParticipation(ctx, participation)
+}
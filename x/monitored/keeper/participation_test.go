@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	testkeeper "healthcheck/testutil/keeper"
+	"healthcheck/x/monitored/types"
+)
+
+func TestRecordSigningParticipation(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+	ctx = ctx.WithBlockHeight(7)
+
+	keeper.RecordSigningParticipation(ctx, abci.LastCommitInfo{
+		Votes: []abci.VoteInfo{
+			{Validator: abci.Validator{Power: 50}, SignedLastBlock: true},
+			{Validator: abci.Validator{Power: 30}, SignedLastBlock: false},
+			{Validator: abci.Validator{Power: 20}, SignedLastBlock: true},
+		},
+	})
+
+	require.Equal(t, types.SigningParticipation{
+		SignedVotingPower: 70,
+		TotalVotingPower:  100,
+		ValidatorCount:    3,
+		Height:            7,
+	}, keeper.GetSigningParticipation(ctx))
+}
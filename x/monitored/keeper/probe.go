@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/monitored/types"
+)
+
+// GetPendingProbe returns the nonce of the probe challenge to be echoed in the next healthcheck update sent through
+// the registry channel, zero if there is none
+func (k Keeper) GetPendingProbe(ctx sdk.Context, channelID string) uint64 {
+	return k.getChannelUint64(ctx, types.PendingProbeKeyPrefix, channelID)
+}
+
+// SetPendingProbe sets the nonce of the probe challenge to be echoed in the next healthcheck update sent through
+// the registry channel. Only the latest challenge is answered.
+func (k Keeper) SetPendingProbe(ctx sdk.Context, channelID string, nonce uint64) {
+	k.setChannelUint64(ctx, types.PendingProbeKeyPrefix, channelID, nonce)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"healthcheck/x/monitored/types"
+)
+
+func (k Keeper) DeliveryLog(goCtx context.Context, req *types.QueryDeliveryLogRequest) (*types.QueryDeliveryLogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var deliveryRecords []types.DeliveryRecord
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	deliveryRecordStore := prefix.NewStore(store, types.KeyPrefix(types.DeliveryRecordKeyPrefix))
+
+	pageRes, err := query.Paginate(deliveryRecordStore, req.Pagination, func(key []byte, value []byte) error {
+		var deliveryRecord types.DeliveryRecord
+		if err := k.cdc.Unmarshal(value, &deliveryRecord); err != nil {
+			return err
+		}
+
+		deliveryRecords = append(deliveryRecords, deliveryRecord)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDeliveryLogResponse{DeliveryRecord: deliveryRecords, Pagination: pageRes}, nil
+}
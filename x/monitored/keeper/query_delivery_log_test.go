@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	testkeeper "healthcheck/testutil/keeper"
+	"healthcheck/x/monitored/types"
+)
+
+func TestDeliveryLogQueryPaginated(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	for sequence := uint64(1); sequence <= 5; sequence++ {
+		keeper.AppendDeliveryRecord(ctx, "channel-0", sequence)
+	}
+	records := keeper.GetAllDeliveryRecord(ctx)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryDeliveryLogRequest {
+		return &types.QueryDeliveryLogRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(records); i += step {
+			resp, err := keeper.DeliveryLog(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.DeliveryRecord), step)
+			require.Subset(t, records, resp.DeliveryRecord)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(records); i += step {
+			resp, err := keeper.DeliveryLog(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.DeliveryRecord), step)
+			require.Subset(t, records, resp.DeliveryRecord)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.DeliveryLog(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(records), int(resp.Pagination.Total))
+		require.Equal(t, records, resp.DeliveryRecord)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.DeliveryLog(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"healthcheck/x/monitored/types"
+)
+
+func (k Keeper) LastHealthcheckAck(goCtx context.Context, req *types.QueryLastHealthcheckAckRequest) (*types.QueryLastHealthcheckAckResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	healthcheckAck, found := k.GetLastHealthcheckAck(ctx, req.ChannelId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryLastHealthcheckAckResponse{HealthcheckAck: healthcheckAck}, nil
+}
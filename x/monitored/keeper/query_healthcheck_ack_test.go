@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	testkeeper "healthcheck/testutil/keeper"
+	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestLastHealthcheckAckQuery(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	_, err := keeper.LastHealthcheckAck(wctx, &types.QueryLastHealthcheckAckRequest{ChannelId: "channel-0"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	healthcheckAck := commontypes.HealthcheckAck{
+		RegistryBlockHeight: 10,
+		Status:              1,
+		NextUpdateDeadline:  20,
+		UpdateInterval:      10,
+		TimeoutInterval:     20,
+	}
+	keeper.SetLastHealthcheckAck(ctx, "channel-0", healthcheckAck)
+
+	response, err := keeper.LastHealthcheckAck(wctx, &types.QueryLastHealthcheckAckRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryLastHealthcheckAckResponse{HealthcheckAck: healthcheckAck}, response)
+
+	_, err = keeper.LastHealthcheckAck(wctx, &types.QueryLastHealthcheckAckRequest{ChannelId: "channel-1"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = keeper.LastHealthcheckAck(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"healthcheck/x/monitored/types"
+)
+
+func (k Keeper) HealthcheckState(goCtx context.Context, req *types.QueryHealthcheckStateRequest) (*types.QueryHealthcheckStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	registryChannel, found := k.GetRegistryChannel(ctx, req.ChannelId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	lastUpdateHeight := k.GetLastHealthcheckUpdateHeight(ctx, req.ChannelId)
+	response := &types.QueryHealthcheckStateResponse{
+		RegistryChainChannelId: registryChannel.ChannelId,
+		LastUpdateHeight:       lastUpdateHeight,
+		NextUpdateHeight:       lastUpdateHeight + k.GetUpdatePeriod(ctx, req.ChannelId),
+		LastDeliveryResult:     k.GetLastDeliveryResult(ctx, req.ChannelId),
+		ConsecutiveFailures:    k.GetConsecutiveFailures(ctx, req.ChannelId),
+		SkippedUpdates:         k.GetSkippedUpdates(ctx, req.ChannelId),
+		Features:               registryChannel.Metadata.Features,
+		UpdateInterval:         registryChannel.Metadata.UpdateInterval,
+		TimeoutInterval:        registryChannel.Metadata.TimeoutInterval,
+	}
+
+	if channel, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), req.ChannelId); found {
+		response.ChannelState = channel.State.String()
+	}
+
+	response.RegistryStatus, _ = k.GetRegistryStatus(ctx, req.ChannelId)
+	response.PendingSequences = k.GetPendingSequences(ctx, req.ChannelId)
+	response.BacklogSize = uint64(len(response.PendingSequences))
+
+	return response, nil
+}
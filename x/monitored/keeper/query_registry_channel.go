@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"healthcheck/x/monitored/types"
+)
+
+func (k Keeper) RegistryChannels(goCtx context.Context, req *types.QueryRegistryChannelsRequest) (*types.QueryRegistryChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var registryChannels []types.RegistryChannel
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	registryChannelStore := prefix.NewStore(store, types.KeyPrefix(types.RegistryChannelKeyPrefix))
+
+	pageRes, err := query.Paginate(registryChannelStore, req.Pagination, func(key []byte, value []byte) error {
+		var registryChannel types.RegistryChannel
+		if err := k.cdc.Unmarshal(value, &registryChannel); err != nil {
+			return err
+		}
+
+		registryChannels = append(registryChannels, registryChannel)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRegistryChannelsResponse{RegistryChannel: registryChannels, Pagination: pageRes}, nil
+}
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
+)
+
+// SetRegistryChannel set a specific registryChannel in the store from its index
+func (k Keeper) SetRegistryChannel(ctx sdk.Context, registryChannel types.RegistryChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RegistryChannelKeyPrefix))
+	b := k.cdc.MustMarshal(&registryChannel)
+	store.Set(types.RegistryChannelKey(
+		registryChannel.ChannelId,
+	), b)
+}
+
+// GetRegistryChannel returns a registryChannel from its index
+func (k Keeper) GetRegistryChannel(
+	ctx sdk.Context,
+	channelID string,
+
+) (val types.RegistryChannel, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RegistryChannelKeyPrefix))
+
+	b := store.Get(types.RegistryChannelKey(
+		channelID,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRegistryChannel removes a registryChannel from the store
+func (k Keeper) RemoveRegistryChannel(
+	ctx sdk.Context,
+	channelID string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RegistryChannelKeyPrefix))
+	store.Delete(types.RegistryChannelKey(
+		channelID,
+	))
+}
+
+// GetAllRegistryChannel returns all registryChannel
+func (k Keeper) GetAllRegistryChannel(ctx sdk.Context) (list []types.RegistryChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RegistryChannelKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RegistryChannel
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetPendingRegistryChannel stores the registry channel whose handshake was initiated, along with the metadata
+// proposed by the monitored chain
+func (k Keeper) SetPendingRegistryChannel(ctx sdk.Context, registryChannel types.RegistryChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingRegistryChannelKeyPrefix))
+	store.Set(types.RegistryChannelKey(registryChannel.ChannelId), k.cdc.MustMarshal(&registryChannel))
+}
+
+// GetPendingRegistryChannel returns the registry channel whose handshake was initiated, but not completed yet
+func (k Keeper) GetPendingRegistryChannel(ctx sdk.Context, channelID string) (val types.RegistryChannel, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingRegistryChannelKeyPrefix))
+	b := store.Get(types.RegistryChannelKey(channelID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingRegistryChannel removes the registry channel whose handshake was initiated from the store
+func (k Keeper) RemovePendingRegistryChannel(ctx sdk.Context, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingRegistryChannelKeyPrefix))
+	store.Delete(types.RegistryChannelKey(channelID))
+}
+
+// GetProposedMetadata returns the handshake metadata the monitored chain proposed when it initiated the handshake
+// of the registry channel. Channels whose handshake was initiated before the pending registry channels were stored
+// fall back to the version of the channel, which isn't replaced with the counterparty version until the handshake
+// is acknowledged.
+func (k Keeper) GetProposedMetadata(ctx sdk.Context, channelID string) (commontypes.HandshakeMetadata, bool) {
+	if pendingChannel, found := k.GetPendingRegistryChannel(ctx, channelID); found {
+		return pendingChannel.Metadata, true
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), channelID)
+	if !found {
+		return commontypes.HandshakeMetadata{}, false
+	}
+
+	if channel.Version == commontypes.Version {
+		return commontypes.HandshakeMetadata{Version: commontypes.Version}, true
+	}
+
+	metadata, err := commontypes.DecodeHandshakeMetadata(channel.Version)
+	if err != nil {
+		return commontypes.HandshakeMetadata{}, false
+	}
+
+	return metadata, true
+}
+
+// HasRegistryChannelOnConnection returns true if one of the registry channels, including the ones whose handshake
+// wasn't completed yet, is built on the given connection
+func (k Keeper) HasRegistryChannelOnConnection(ctx sdk.Context, connectionID string) bool {
+	for _, keyPrefix := range []string{types.RegistryChannelKeyPrefix, types.PendingRegistryChannelKeyPrefix} {
+		if k.hasChannelOnConnection(ctx, keyPrefix, connectionID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (k Keeper) hasChannelOnConnection(ctx sdk.Context, keyPrefix string, connectionID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var registryChannel types.RegistryChannel
+		k.cdc.MustUnmarshal(iterator.Value(), &registryChannel)
+
+		channel, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), registryChannel.ChannelId)
+		if found && len(channel.ConnectionHops) > 0 && channel.ConnectionHops[0] == connectionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetHandshakeMetadata returns the metadata negotiated with registry chain during the handshake of the registry channel
+func (k Keeper) GetHandshakeMetadata(ctx sdk.Context, channelID string) commontypes.HandshakeMetadata {
+	registryChannel, _ := k.GetRegistryChannel(ctx, channelID)
+	return registryChannel.Metadata
+}
+
+// GetUpdatePeriod returns the number of blocks between the healthcheck updates sent through the registry channel.
+// Updates are sent twice per negotiated update interval, so that an update relayed late doesn't make the registry
+// chain consider the monitored chain inactive.
+func (k Keeper) GetUpdatePeriod(ctx sdk.Context, channelID string) uint64 {
+	updateInterval := k.GetHandshakeMetadata(ctx, channelID).UpdateInterval
+	if updateInterval == 0 {
+		return types.UpdateInterval
+	}
+
+	return (updateInterval + 1) / 2
+}
+
+func (k Keeper) GetLastHealthcheckUpdateHeight(ctx sdk.Context, channelID string) uint64 {
+	return k.getChannelUint64(ctx, types.LastHealthcheckUpdateHeightKeyPrefix, channelID)
+}
+
+func (k Keeper) SetLastHealthcheckUpdateHeight(ctx sdk.Context, channelID string, height uint64) {
+	k.setChannelUint64(ctx, types.LastHealthcheckUpdateHeightKeyPrefix, channelID, height)
+}
+
+// GetUpdatesSent returns the number of healthcheck updates sent through the registry channel
+func (k Keeper) GetUpdatesSent(ctx sdk.Context, channelID string) uint64 {
+	return k.getChannelUint64(ctx, types.UpdatesSentKeyPrefix, channelID)
+}
+
+func (k Keeper) SetUpdatesSent(ctx sdk.Context, channelID string, updatesSent uint64) {
+	k.setChannelUint64(ctx, types.UpdatesSentKeyPrefix, channelID, updatesSent)
+}
+
+// GetLastHealthcheckAck returns the last healthcheck update acknowledgement received through the registry channel
+func (k Keeper) GetLastHealthcheckAck(ctx sdk.Context, channelID string) (healthcheckAck commontypes.HealthcheckAck, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastHealthcheckAckKeyPrefix))
+	bz := store.Get(types.RegistryChannelKey(channelID))
+	if bz == nil {
+		return healthcheckAck, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &healthcheckAck)
+	return healthcheckAck, true
+}
+
+// SetLastHealthcheckAck stores the last healthcheck update acknowledgement received through the registry channel
+func (k Keeper) SetLastHealthcheckAck(ctx sdk.Context, channelID string, healthcheckAck commontypes.HealthcheckAck) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastHealthcheckAckKeyPrefix))
+	store.Set(types.RegistryChannelKey(channelID), k.cdc.MustMarshal(&healthcheckAck))
+}
+
+func (k Keeper) getChannelUint64(ctx sdk.Context, keyPrefix string, channelID string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	bz := store.Get(types.RegistryChannelKey(channelID))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setChannelUint64(ctx sdk.Context, keyPrefix string, channelID string, value uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, value)
+	store.Set(types.RegistryChannelKey(channelID), bz)
+}
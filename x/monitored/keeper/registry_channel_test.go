@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	testkeeper "healthcheck/testutil/keeper"
+	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestGetChannelIntervals(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+	params := types.DefaultParams()
+	params.ChannelIntervals = []types.ChannelIntervals{
+		{ChannelId: "channel-1", UpdateInterval: 30, TimeoutInterval: 60},
+	}
+	keeper.SetParams(ctx, params)
+
+	updateInterval, timeoutInterval := keeper.GetChannelIntervals(ctx, "channel-0")
+	require.Equal(t, params.UpdateInterval, updateInterval)
+	require.Equal(t, params.TimeoutInterval, timeoutInterval)
+
+	updateInterval, timeoutInterval = keeper.GetChannelIntervals(ctx, "channel-1")
+	require.Equal(t, uint64(30), updateInterval)
+	require.Equal(t, uint64(60), timeoutInterval)
+}
+
+func TestGetUpdatePeriod(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+
+	// registry channels of the legacy version don't negotiate the update interval
+	keeper.SetRegistryChannel(ctx, types.RegistryChannel{
+		ChannelId: "channel-0",
+		Metadata:  commontypes.HandshakeMetadata{Version: commontypes.Version},
+	})
+	require.Equal(t, uint64(types.UpdateInterval), keeper.GetUpdatePeriod(ctx, "channel-0"))
+
+	keeper.SetRegistryChannel(ctx, types.RegistryChannel{
+		ChannelId: "channel-1",
+		Metadata:  commontypes.NewHandshakeMetadata(15, 30, nil),
+	})
+	require.Equal(t, uint64(8), keeper.GetUpdatePeriod(ctx, "channel-1"))
+}
+
+func TestRegistryChannelsQuery(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	registryChannels := []types.RegistryChannel{
+		{ChannelId: "channel-0", Metadata: commontypes.NewHandshakeMetadata(10, 20, nil)},
+		{ChannelId: "channel-1", Metadata: commontypes.NewHandshakeMetadata(30, 60, nil)},
+	}
+	for _, registryChannel := range registryChannels {
+		keeper.SetRegistryChannel(ctx, registryChannel)
+	}
+
+	response, err := keeper.RegistryChannels(wctx, &types.QueryRegistryChannelsRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), response.Pagination.Total)
+	require.Equal(t, registryChannels, response.RegistryChannel)
+
+	// closed registry channels are removed
+	keeper.RemoveRegistryChannel(ctx, "channel-0")
+	require.Equal(t, registryChannels[1:], keeper.GetAllRegistryChannel(ctx))
+
+	_, err = keeper.RegistryChannels(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+
+	"healthcheck/x/monitored/types"
+)
+
+// SetRegistryStatus set the liveness of the registry chain reached through the registry channel
+func (k Keeper) SetRegistryStatus(ctx sdk.Context, registryStatus types.RegistryStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RegistryStatusKeyPrefix))
+	b := k.cdc.MustMarshal(&registryStatus)
+	store.Set(types.RegistryChannelKey(registryStatus.ChannelId), b)
+}
+
+// GetRegistryStatus returns the liveness of the registry chain reached through the registry channel
+func (k Keeper) GetRegistryStatus(ctx sdk.Context, channelID string) (val types.RegistryStatus, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RegistryStatusKeyPrefix))
+
+	b := store.Get(types.RegistryChannelKey(channelID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRegistryStatus removes the liveness of the registry chain reached through the registry channel
+func (k Keeper) RemoveRegistryStatus(ctx sdk.Context, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RegistryStatusKeyPrefix))
+	store.Delete(types.RegistryChannelKey(channelID))
+}
+
+// InitRegistryStatus considers the registry chain active once the handshake of the registry channel is completed
+func (k Keeper) InitRegistryStatus(ctx sdk.Context, channelID string) {
+	registryStatus := types.RegistryStatus{
+		ChannelId:          channelID,
+		Status:             uint64(types.RegistryActive),
+		LastEvidenceHeight: uint64(ctx.BlockHeight()),
+		EvidenceSource:     uint64(types.RegistryHandshake),
+	}
+	k.trackRegistryClientProgress(ctx, &registryStatus)
+	k.SetRegistryStatus(ctx, registryStatus)
+}
+
+// GetRegistryInactivityInterval returns the number of blocks without any evidence of the registry chain liveness
+// after which the registry chain is considered inactive. It matches the update interval after which the registry
+// chain considers the monitored chain inactive.
+func (k Keeper) GetRegistryInactivityInterval(ctx sdk.Context, channelID string) uint64 {
+	updateInterval := k.GetHandshakeMetadata(ctx, channelID).UpdateInterval
+	if updateInterval == 0 {
+		return 2 * types.UpdateInterval
+	}
+
+	return updateInterval
+}
+
+// RecordRegistryAcknowledgement records the acknowledgement of a packet sent through the registry channel as
+// the evidence of the registry chain liveness. Registry block height is zero for acknowledgements without
+// the registry view.
+func (k Keeper) RecordRegistryAcknowledgement(ctx sdk.Context, channelID string, registryBlockHeight uint64) {
+	registryStatus, found := k.GetRegistryStatus(ctx, channelID)
+	if !found {
+		return
+	}
+
+	if registryBlockHeight > registryStatus.RegistryBlockHeight {
+		registryStatus.RegistryBlockHeight = registryBlockHeight
+	}
+
+	k.recordRegistryEvidence(ctx, &registryStatus, types.RegistryAcknowledgement)
+	k.SetRegistryStatus(ctx, registryStatus)
+}
+
+// RecordRegistryPacket records a packet received through the registry channel as the evidence of the registry
+// chain liveness
+func (k Keeper) RecordRegistryPacket(ctx sdk.Context, channelID string) {
+	registryStatus, found := k.GetRegistryStatus(ctx, channelID)
+	if !found {
+		return
+	}
+
+	k.recordRegistryEvidence(ctx, &registryStatus, types.RegistryPacket)
+	k.SetRegistryStatus(ctx, registryStatus)
+}
+
+// UpdateRegistryStatus tracks the light client of the registry chain and marks the registry chain inactive once
+// there was no evidence of its liveness for the inactivity interval
+func (k Keeper) UpdateRegistryStatus(ctx sdk.Context, channelID string) {
+	registryStatus, found := k.GetRegistryStatus(ctx, channelID)
+	if !found {
+		// registry channels opened before the registry status was tracked
+		k.InitRegistryStatus(ctx, channelID)
+		return
+	}
+
+	if k.trackRegistryClientProgress(ctx, &registryStatus) {
+		k.recordRegistryEvidence(ctx, &registryStatus, types.RegistryLightClient)
+	}
+
+	inactivationHeight := registryStatus.LastEvidenceHeight + k.GetRegistryInactivityInterval(ctx, channelID)
+	if registryStatus.Status == uint64(types.RegistryActive) && uint64(ctx.BlockHeight()) > inactivationHeight {
+		registryStatus.Status = uint64(types.RegistryInactive)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRegistryInactive,
+				sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+				sdk.NewAttribute(types.AttributeKeyLastEvidenceHeight, strconv.FormatUint(registryStatus.LastEvidenceHeight, 10)),
+				sdk.NewAttribute(types.AttributeKeyRegistryBlockHeight, strconv.FormatUint(registryStatus.RegistryBlockHeight, 10)),
+			),
+		)
+	}
+
+	k.SetRegistryStatus(ctx, registryStatus)
+}
+
+// recordRegistryEvidence marks the registry chain active, since there is a new evidence of its liveness
+func (k Keeper) recordRegistryEvidence(ctx sdk.Context, registryStatus *types.RegistryStatus, source types.RegistryEvidenceSource) {
+	registryStatus.LastEvidenceHeight = uint64(ctx.BlockHeight())
+	registryStatus.EvidenceSource = uint64(source)
+
+	if registryStatus.Status == uint64(types.RegistryActive) {
+		return
+	}
+
+	registryStatus.Status = uint64(types.RegistryActive)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegistryActive,
+			sdk.NewAttribute(types.AttributeKeyChannelID, registryStatus.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyEvidenceSource, strconv.FormatUint(registryStatus.EvidenceSource, 10)),
+		),
+	)
+}
+
+// trackRegistryClientProgress stores the latest height and timestamp of the light client tracking the registry
+// chain. It returns true if the light client was updated to a newer height with a consensus state recent enough
+// to prove the registry chain is still producing blocks, since a client updated with old headers does not.
+func (k Keeper) trackRegistryClientProgress(ctx sdk.Context, registryStatus *types.RegistryStatus) bool {
+	clientID, clientState, err := k.channelKeeper.GetChannelClientState(ctx, k.GetPort(ctx), registryStatus.ChannelId)
+	if err != nil {
+		return false
+	}
+
+	latestHeight := clientState.GetLatestHeight()
+	trackedHeight := clienttypes.NewHeight(registryStatus.ClientRevisionNumber, registryStatus.ClientLatestHeight)
+	if !latestHeight.GT(trackedHeight) {
+		return false
+	}
+
+	registryStatus.ClientRevisionNumber = latestHeight.GetRevisionNumber()
+	registryStatus.ClientLatestHeight = latestHeight.GetRevisionHeight()
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, latestHeight)
+	if !found {
+		return false
+	}
+
+	registryStatus.ClientTimestamp = consensusState.GetTimestamp()
+	return k.isRegistryClientFresh(ctx, registryStatus.ClientTimestamp)
+}
+
+// isRegistryClientFresh checks the consensus state timestamp of the registry chain light client is not older than
+// the registry client freshness
+func (k Keeper) isRegistryClientFresh(ctx sdk.Context, timestamp uint64) bool {
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	if timestamp >= blockTime {
+		return true
+	}
+
+	freshness := time.Duration(k.RegistryClientFreshness(ctx)) * time.Second
+	return blockTime-timestamp <= uint64(freshness)
+}
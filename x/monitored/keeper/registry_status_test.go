@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	testkeeper "healthcheck/testutil/keeper"
+	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestUpdateRegistryStatus(t *testing.T) {
+	keeper, ctx := testkeeper.MonitoredKeeper(t)
+	keeper.SetRegistryChannel(ctx, types.RegistryChannel{
+		ChannelId: "channel-0",
+		Metadata:  commontypes.NewHandshakeMetadata(10, 20, nil),
+	})
+
+	ctx = ctx.WithBlockHeight(5)
+	keeper.InitRegistryStatus(ctx, "channel-0")
+
+	registryStatus, found := keeper.GetRegistryStatus(ctx, "channel-0")
+	require.True(t, found)
+	require.Equal(t, uint64(types.RegistryActive), registryStatus.Status)
+	require.Equal(t, uint64(types.RegistryHandshake), registryStatus.EvidenceSource)
+
+	// the registry chain stays active within the update interval
+	ctx = ctx.WithBlockHeight(15)
+	keeper.UpdateRegistryStatus(ctx, "channel-0")
+	registryStatus, _ = keeper.GetRegistryStatus(ctx, "channel-0")
+	require.Equal(t, uint64(types.RegistryActive), registryStatus.Status)
+
+	ctx = ctx.WithBlockHeight(16)
+	keeper.UpdateRegistryStatus(ctx, "channel-0")
+	registryStatus, _ = keeper.GetRegistryStatus(ctx, "channel-0")
+	require.Equal(t, uint64(types.RegistryInactive), registryStatus.Status)
+
+	// an acknowledgement brings the registry chain back
+	ctx = ctx.WithBlockHeight(17)
+	keeper.RecordRegistryAcknowledgement(ctx, "channel-0", 100)
+	registryStatus, _ = keeper.GetRegistryStatus(ctx, "channel-0")
+	require.Equal(t, types.RegistryStatus{
+		ChannelId:           "channel-0",
+		Status:              uint64(types.RegistryActive),
+		LastEvidenceHeight:  17,
+		EvidenceSource:      uint64(types.RegistryAcknowledgement),
+		RegistryBlockHeight: 100,
+	}, registryStatus)
+
+	// acknowledgements without the registry view keep the last known registry block height
+	keeper.RecordRegistryAcknowledgement(ctx, "channel-0", 0)
+	registryStatus, _ = keeper.GetRegistryStatus(ctx, "channel-0")
+	require.Equal(t, uint64(100), registryStatus.RegistryBlockHeight)
+}
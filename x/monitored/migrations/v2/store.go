@@ -0,0 +1,91 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
+)
+
+var (
+	// RegistryChainChannelIDKey is the v1 key of the channel ID used to send healthcheck updates to registry chain
+	RegistryChainChannelIDKey = types.KeyPrefix("RegistryChainChannelID")
+
+	// HandshakeMetadataKey is the v1 key of the metadata negotiated with registry chain during the channel handshake
+	HandshakeMetadataKey = types.KeyPrefix("HandshakeMetadata")
+
+	// LastHealthcheckUpdateHeightKey is the v1 key of the last block height for which the healthcheck status
+	// was sent to registry chain
+	LastHealthcheckUpdateHeightKey = types.KeyPrefix("LastHealthcheckUpdateHeight")
+
+	// LastHealthcheckAckKey is the v1 key of the last healthcheck update acknowledgement received from registry chain
+	LastHealthcheckAckKey = types.KeyPrefix("LastHealthcheckAck")
+
+	// LastDeliveryResultKey is the v1 key of the outcome of the last healthcheck update packet
+	// that was acknowledged or timed out
+	LastDeliveryResultKey = types.KeyPrefix("LastDeliveryResult")
+
+	// ConsecutiveFailuresKey is the v1 key of the number of healthcheck update packets that failed
+	// to be delivered since the last successful delivery
+	ConsecutiveFailuresKey = types.KeyPrefix("ConsecutiveFailures")
+
+	// LastIntervalChangeRequestKey is the v1 key of the last interval change request sent to registry chain
+	LastIntervalChangeRequestKey = types.KeyPrefix("LastIntervalChangeRequest")
+
+	// HeartbeatStateKey is the v1 key of the state of the chain reported in the last healthcheck update
+	HeartbeatStateKey = types.KeyPrefix("HeartbeatState")
+)
+
+// channelKeys maps the v1 keys of the single registry channel to the v2 prefixes of the per channel state.
+// Values are encoded the same way in both versions, so they are moved as they are.
+var channelKeys = []struct {
+	key    []byte
+	prefix string
+}{
+	{LastHealthcheckUpdateHeightKey, types.LastHealthcheckUpdateHeightKeyPrefix},
+	{LastHealthcheckAckKey, types.LastHealthcheckAckKeyPrefix},
+	{LastDeliveryResultKey, types.LastDeliveryResultKeyPrefix},
+	{ConsecutiveFailuresKey, types.ConsecutiveFailuresKeyPrefix},
+	{LastIntervalChangeRequestKey, types.LastIntervalChangeRequestKeyPrefix},
+	{HeartbeatStateKey, types.HeartbeatStateKeyPrefix},
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2. The single registry channel of v1 and its
+// handshake metadata are moved to the RegistryChannel store, and the state kept for it is moved under the
+// per channel prefixes, so that the monitored chain keeps sending the healthcheck updates through the channel.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	channelID := string(store.Get(RegistryChainChannelIDKey))
+	if channelID != "" {
+		registryChannel := types.RegistryChannel{ChannelId: channelID}
+		if bz := store.Get(HandshakeMetadataKey); bz != nil {
+			var metadata commontypes.HandshakeMetadata
+			if err := cdc.Unmarshal(bz, &metadata); err != nil {
+				return err
+			}
+			registryChannel.Metadata = metadata
+		}
+
+		registryChannelStore := prefix.NewStore(store, types.KeyPrefix(types.RegistryChannelKeyPrefix))
+		registryChannelStore.Set(types.RegistryChannelKey(channelID), cdc.MustMarshal(&registryChannel))
+
+		for _, channelKey := range channelKeys {
+			if bz := store.Get(channelKey.key); bz != nil {
+				channelStore := prefix.NewStore(store, types.KeyPrefix(channelKey.prefix))
+				channelStore.Set(types.RegistryChannelKey(channelID), bz)
+			}
+		}
+	}
+
+	store.Delete(RegistryChainChannelIDKey)
+	store.Delete(HandshakeMetadataKey)
+	for _, channelKey := range channelKeys {
+		store.Delete(channelKey.key)
+	}
+
+	return nil
+}
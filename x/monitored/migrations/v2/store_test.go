@@ -0,0 +1,76 @@
+package v2_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "healthcheck/x/monitored/migrations/v2"
+	"healthcheck/x/monitored/types"
+	commontypes "healthcheck/x/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	metadata := commontypes.HandshakeMetadata{
+		Version:         commontypes.MetadataVersion,
+		UpdateInterval:  10,
+		TimeoutInterval: 20,
+	}
+	healthcheckAck := commontypes.HealthcheckAck{RegistryBlockHeight: 7}
+	lastUpdateHeight := make([]byte, 8)
+	binary.BigEndian.PutUint64(lastUpdateHeight, 42)
+
+	store.Set(v2.RegistryChainChannelIDKey, []byte("channel-0"))
+	store.Set(v2.HandshakeMetadataKey, cdc.MustMarshal(&metadata))
+	store.Set(v2.LastHealthcheckUpdateHeightKey, lastUpdateHeight)
+	store.Set(v2.LastHealthcheckAckKey, cdc.MustMarshal(&healthcheckAck))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	var registryChannel types.RegistryChannel
+	bz := prefix.NewStore(store, types.KeyPrefix(types.RegistryChannelKeyPrefix)).Get(types.RegistryChannelKey("channel-0"))
+	require.NotNil(t, bz)
+	cdc.MustUnmarshal(bz, &registryChannel)
+	require.Equal(t, types.RegistryChannel{ChannelId: "channel-0", Metadata: metadata}, registryChannel)
+
+	bz = prefix.NewStore(store, types.KeyPrefix(types.LastHealthcheckUpdateHeightKeyPrefix)).Get(types.RegistryChannelKey("channel-0"))
+	require.Equal(t, lastUpdateHeight, bz)
+
+	var migratedAck commontypes.HealthcheckAck
+	bz = prefix.NewStore(store, types.KeyPrefix(types.LastHealthcheckAckKeyPrefix)).Get(types.RegistryChannelKey("channel-0"))
+	require.NotNil(t, bz)
+	cdc.MustUnmarshal(bz, &migratedAck)
+	require.Equal(t, healthcheckAck, migratedAck)
+
+	for _, key := range [][]byte{
+		v2.RegistryChainChannelIDKey,
+		v2.HandshakeMetadataKey,
+		v2.LastHealthcheckUpdateHeightKey,
+		v2.LastHealthcheckAckKey,
+	} {
+		require.False(t, store.Has(key))
+	}
+}
+
+func TestMigrateStoreWithoutChannel(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(storeKey), types.KeyPrefix(types.RegistryChannelKeyPrefix))
+	defer iterator.Close()
+	require.False(t, iterator.Valid())
+}
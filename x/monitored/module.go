@@ -131,6 +131,11 @@ func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -154,29 +159,61 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the module. It should be incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty versions, the initial version should be set to 1
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block
-func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	am.keeper.RecordSigningParticipation(ctx, req.LastCommitInfo)
+}
 
 // EndBlock contains the logic that is automatically triggered at the end of each block
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	RegistryStatusEndBlock(ctx, am.keeper)
 	HealthcheckUpdatesEndBlock(ctx, am.keeper)
+	IntervalChangeEndBlock(ctx, am.keeper)
 
 	return []abci.ValidatorUpdate{}
 }
 
+// RegistryStatusEndBlock tracks the liveness of each registry chain
+func RegistryStatusEndBlock(ctx sdk.Context, keeper keeper.Keeper) {
+	for _, registryChannel := range keeper.GetAllRegistryChannel(ctx) {
+		keeper.UpdateRegistryStatus(ctx, registryChannel.ChannelId)
+	}
+}
+
+// HealthcheckUpdatesEndBlock sends the healthcheck updates through each registry channel on its own schedule
 func HealthcheckUpdatesEndBlock(ctx sdk.Context, keeper keeper.Keeper) {
-	lastUpdateHeight := int64(keeper.GetLastHealthcheckUpdateHeight(ctx))
+	for _, registryChannel := range keeper.GetAllRegistryChannel(ctx) {
+		sendHealthcheckUpdate(ctx, keeper, registryChannel.ChannelId)
+	}
+}
+
+// sendHealthcheckUpdate sends the healthcheck update through the registry channel, if it's due according to
+// the update period of the channel or an out-of-band heartbeat was triggered
+func sendHealthcheckUpdate(ctx sdk.Context, keeper keeper.Keeper, channelID string) {
+	lastUpdateHeight := keeper.GetLastHealthcheckUpdateHeight(ctx, channelID)
 	currentHeight := ctx.BlockHeight()
 
-	if currentHeight-lastUpdateHeight < types.UpdateInterval {
+	scheduled := uint64(currentHeight)-lastUpdateHeight >= keeper.GetUpdatePeriod(ctx, channelID)
+	reason := keeper.GetHeartbeatReason(ctx, channelID)
+	if !scheduled && reason == commontypes.ScheduledHeartbeat {
+		return
+	}
+
+	if !keeper.IsChannelOpen(ctx, channelID) {
+		// registry channel is being closed
 		return
 	}
 
-	channelID := keeper.GetRegistryChainChannelID(ctx)
-	if channelID == "" || !keeper.IsChannelOpen(ctx, channelID) {
-		// IBC channel for sending healthcheck updates isn't established yet
+	// registry chains removed from the allowlist by governance aren't sent any updates
+	if err := keeper.ValidateRegistryChannel(ctx, channelID); err != nil {
+		keeper.Logger(ctx).Debug("registry channel isn't allowed", "channel", channelID, "error", err)
+		return
+	}
+
+	// out-of-band heartbeats are sent regardless of the delivery backlog
+	if reason == commontypes.ScheduledHeartbeat && keeper.ShouldSkipUpdate(ctx, channelID) {
 		return
 	}
 
@@ -189,17 +226,92 @@ func HealthcheckUpdatesEndBlock(ctx sdk.Context, keeper keeper.Keeper) {
 		},
 	}
 
-	packetData, err := types.ModuleCdc.MarshalJSON(&packet)
+	// registry chains that didn't negotiate the extended payload reject the fields they don't know
+	var (
+		packetData []byte
+		err        error
+	)
+	if keeper.GetHandshakeMetadata(ctx, channelID).HasFeature(commontypes.FeatureExtendedPayload) {
+		addExtendedPayload(ctx, keeper, channelID, packet.GetData(), reason)
+		packetData, err = types.ModuleCdc.MarshalJSON(&packet)
+	} else {
+		packetData, err = types.MarshalLegacyPacketData(&packet)
+	}
 	if err != nil {
 		keeper.Logger(ctx).Debug("failed to marshal healthcheck update IBC packet")
 		return
 	}
 
-	err = keeper.SendHealthcheckUpdatePacket(ctx, keeper.GetPort(ctx), channelID, types.DefaultTimeoutPeriod, packetData)
+	sequence, err := keeper.SendHealthcheckUpdatePacket(ctx, keeper.GetPort(ctx), channelID, types.DefaultTimeoutPeriod, packetData)
 	if err != nil {
 		keeper.Logger(ctx).Debug("failed to send healthcheck update IBC packet")
 		return
 	}
 
-	keeper.SetLastHealthcheckUpdateHeight(ctx, uint64(currentHeight))
+	keeper.AppendDeliveryRecord(ctx, channelID, sequence)
+	keeper.AddPendingUpdate(ctx, channelID, sequence)
+
+	keeper.SetPendingProbe(ctx, channelID, 0)
+	keeper.RecordHeartbeatState(ctx, channelID)
+	keeper.SetLastHealthcheckUpdateHeight(ctx, channelID, uint64(currentHeight))
+}
+
+// addExtendedPayload adds the fields that follow the block height and timestamp to the healthcheck update
+func addExtendedPayload(ctx sdk.Context, keeper keeper.Keeper, channelID string, update *commontypes.HealthcheckUpdateData, reason commontypes.HeartbeatReason) {
+	participation := keeper.GetSigningParticipation(ctx)
+	update.SignedVotingPower = participation.SignedVotingPower
+	update.TotalVotingPower = participation.TotalVotingPower
+	update.ValidatorCount = participation.ValidatorCount
+	update.Indicators = keeper.CollectIndicators(ctx)
+	update.Reason = uint64(reason)
+	update.ProbeNonce = keeper.GetPendingProbe(ctx, channelID)
+
+	if keeper.ShouldReportDecentralization(ctx, channelID) {
+		metrics := keeper.GetDecentralizationMetrics(ctx)
+		update.Decentralization = &metrics
+	}
+
+	if keeper.ShouldReportConnectivity(ctx, channelID) {
+		connectivity := keeper.GetConnectivitySummary(ctx)
+		update.Connectivity = &connectivity
+	}
+}
+
+// IntervalChangeEndBlock asks registry chain to apply the intervals set in the params, once they differ
+// from the negotiated ones
+func IntervalChangeEndBlock(ctx sdk.Context, keeper keeper.Keeper) {
+	for _, registryChannel := range keeper.GetAllRegistryChannel(ctx) {
+		requestIntervalChange(ctx, keeper, registryChannel.ChannelId)
+	}
+}
+
+// requestIntervalChange asks the registry chain to apply the intervals set for the registry channel
+func requestIntervalChange(ctx sdk.Context, keeper keeper.Keeper, channelID string) {
+	if !keeper.IsChannelOpen(ctx, channelID) || keeper.ValidateRegistryChannel(ctx, channelID) != nil {
+		return
+	}
+
+	request, found := keeper.GetIntervalChangeRequest(ctx, channelID)
+	if !found {
+		return
+	}
+
+	packet := commontypes.HealthcheckPacketData{
+		Packet: &commontypes.HealthcheckPacketData_IntervalChangeRequest{
+			IntervalChangeRequest: &request,
+		},
+	}
+
+	packetData, err := types.ModuleCdc.MarshalJSON(&packet)
+	if err != nil {
+		keeper.Logger(ctx).Debug("failed to marshal interval change IBC packet")
+		return
+	}
+
+	if _, err := keeper.SendHealthcheckUpdatePacket(ctx, keeper.GetPort(ctx), channelID, types.DefaultTimeoutPeriod, packetData); err != nil {
+		keeper.Logger(ctx).Debug("failed to send interval change IBC packet")
+		return
+	}
+
+	keeper.SetLastIntervalChangeRequest(ctx, channelID, request)
 }
@@ -38,8 +38,8 @@ func (im IBCModule) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) (string, error) {
-	if order != channeltypes.ORDERED {
-		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.ORDERED, order)
+	if err := commontypes.ValidateChannelOrdering(order); err != nil {
+		return "", err
 	}
 
 	// Require portID is the portID module is bound to
@@ -48,17 +48,35 @@ func (im IBCModule) OnChanOpenInit(
 		return "", sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
 	}
 
-	if version != commontypes.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	// empty version proposes all supported features, while the legacy version doesn't support any
+	var features []string
+	switch version {
+	case "":
+		features = commontypes.SupportedFeatures()
+	case commontypes.Version:
+	default:
+		proposedMetadata, err := commontypes.DecodeHandshakeMetadata(version)
+		if err != nil || proposedMetadata.IsLegacy() {
+			return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s or %s metadata", version, commontypes.Version, commontypes.MetadataVersion)
+		}
+
+		features = commontypes.IntersectFeatures(proposedMetadata.Features, commontypes.SupportedFeatures())
 	}
 
 	if counterparty.PortId != commontypes.HealthcheckPortID {
 		return "", sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid counterparty port: %s, expected %s", counterparty.PortId, commontypes.HealthcheckPortID)
 	}
 
-	registryChainChannelID := im.keeper.GetRegistryChainChannelID(ctx)
-	if registryChainChannelID != "" {
-		return "", types.ErrHealthcheckChannelAlreadySet
+	// each registry chain is reached through its own connection
+	if len(connectionHops) > 0 && im.keeper.HasRegistryChannelOnConnection(ctx, connectionHops[0]) {
+		return "", sdkerrors.Wrapf(types.ErrHealthcheckChannelAlreadySet, "connection: %s", connectionHops[0])
+	}
+
+	// only the registry chains allowed by governance may receive the healthcheck updates
+	if len(connectionHops) > 0 {
+		if err := im.keeper.ValidateRegistryConnection(ctx, connectionHops[0]); err != nil {
+			return "", err
+		}
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -66,19 +84,19 @@ func (im IBCModule) OnChanOpenInit(
 		return "", err
 	}
 
-	// TODO: can move "Intervals" into genesis and store in state, if we want different values for different chains
-	metadata := commontypes.HandshakeMetadata{
-		Version:         version,
-		UpdateInterval:  types.MaxUpdateInterval,
-		TimeoutInterval: types.MaxTimeoutInterval,
+	updateInterval, timeoutInterval := im.keeper.GetChannelIntervals(ctx, channelID)
+	metadata := commontypes.NewHandshakeMetadata(updateInterval, timeoutInterval, features)
+	if version == commontypes.Version {
+		metadata.Version = commontypes.Version
 	}
 
-	metadataBz, err := metadata.Marshal()
-	if err != nil {
-		return "", err
-	}
+	// the channel occupies the connection until its handshake is completed
+	im.keeper.SetPendingRegistryChannel(ctx, types.RegistryChannel{
+		ChannelId: channelID,
+		Metadata:  metadata,
+	})
 
-	return string(metadataBz), nil
+	return metadata.Encode()
 }
 
 // OnChanOpenTry implements the IBCModule interface
@@ -103,11 +121,38 @@ func (im IBCModule) OnChanOpenAck(
 	_,
 	counterpartyVersion string,
 ) error {
+	// registry chain acknowledges the legacy metadata with the plain legacy version
+	metadata := commontypes.HandshakeMetadata{Version: commontypes.Version}
 	if counterpartyVersion != commontypes.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+		var err error
+		metadata, err = commontypes.DecodeHandshakeMetadata(counterpartyVersion)
+		if err != nil || metadata.IsLegacy() {
+			return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s or %s metadata", counterpartyVersion, commontypes.Version, commontypes.MetadataVersion)
+		}
+
+		// registry chain may only turn off the features proposed by the monitored chain
+		proposedMetadata, found := im.keeper.GetProposedMetadata(ctx, channelID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInvalidVersion, "no handshake metadata was proposed for channel %s", channelID)
+		}
+
+		negotiatedFeatures := commontypes.IntersectFeatures(metadata.Features, proposedMetadata.Features)
+		if len(negotiatedFeatures) != len(metadata.Features) {
+			return sdkerrors.Wrapf(types.ErrInvalidVersion, "counterparty negotiated features that weren't proposed: %v, proposed %v", metadata.Features, proposedMetadata.Features)
+		}
+	}
+
+	// the allowlist may have changed since the handshake was initiated
+	if err := im.keeper.ValidateRegistryChannel(ctx, channelID); err != nil {
+		return err
 	}
 
-	im.keeper.SetRegistryChainChannelID(ctx, channelID)
+	im.keeper.RemovePendingRegistryChannel(ctx, channelID)
+	im.keeper.SetRegistryChannel(ctx, types.RegistryChannel{
+		ChannelId: channelID,
+		Metadata:  metadata,
+	})
+	im.keeper.InitRegistryStatus(ctx, channelID)
 
 	return nil
 }
@@ -137,13 +182,15 @@ func (im IBCModule) OnChanCloseConfirm(
 	portID,
 	channelID string,
 ) error {
-	registryChainChannelID := im.keeper.GetRegistryChainChannelID(ctx)
-	if registryChainChannelID != channelID {
-		// should not happen since only one monitored-healthcheck channel is allowed
-		return sdkerrors.Wrap(types.ErrUnexpectedChannelID, fmt.Sprintf("expected: %s, got: %s", registryChainChannelID, channelID))
+	_, found := im.keeper.GetRegistryChannel(ctx, channelID)
+	if _, pending := im.keeper.GetPendingRegistryChannel(ctx, channelID); !found && !pending {
+		// should not happen since only registry channels can be opened on the monitored port
+		return sdkerrors.Wrap(types.ErrUnexpectedChannelID, fmt.Sprintf("channel %s isn't a registry channel", channelID))
 	}
 
-	im.keeper.SetRegistryChainChannelID(ctx, "")
+	im.keeper.RemoveRegistryChannel(ctx, channelID)
+	im.keeper.RemovePendingRegistryChannel(ctx, channelID)
+	im.keeper.RemoveRegistryStatus(ctx, channelID)
 
 	return nil
 }
@@ -154,7 +201,31 @@ func (im IBCModule) OnRecvPacket(
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	return channeltypes.NewErrorAcknowledgement(fmt.Errorf("can not send packets on port: %v", &modulePacket.SourcePort))
+	var modulePacketData commontypes.HealthcheckPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(modulePacket.GetData(), &modulePacketData); err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error()))
+	}
+
+	// registry chain sends probe challenges only
+	probeChallenge := modulePacketData.GetProbeChallenge()
+	if probeChallenge == nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("can not send packets other than probe challenges on port: %v", modulePacket.SourcePort))
+	}
+
+	registryChannel, found := im.keeper.GetRegistryChannel(ctx, modulePacket.DestinationChannel)
+	if !found {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(types.ErrUnexpectedChannelID, "channel %s isn't a registry channel", modulePacket.DestinationChannel))
+	}
+
+	if !registryChannel.Metadata.HasFeature(commontypes.FeatureProbe) {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(types.ErrInvalidVersion, "probe wasn't negotiated on channel %s", modulePacket.DestinationChannel))
+	}
+
+	// the nonce is echoed in the next healthcheck update
+	im.keeper.SetPendingProbe(ctx, modulePacket.DestinationChannel, probeChallenge.Nonce)
+	im.keeper.RecordRegistryPacket(ctx, modulePacket.DestinationChannel)
+
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -169,6 +240,11 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
 	}
 
+	var modulePacketData commontypes.HealthcheckPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(modulePacket.GetData(), &modulePacketData); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %v", err)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			commontypes.EventTypePacket,
@@ -177,12 +253,40 @@ func (im IBCModule) OnAcknowledgementPacket(
 		),
 	)
 
+	// any acknowledgement, even an error one, proves that the registry chain processes packets
+	var registryBlockHeight uint64
+	if result := ack.GetResult(); result != nil {
+		var healthcheckAck commontypes.HealthcheckAck
+		if err := healthcheckAck.Unmarshal(result); err == nil {
+			registryBlockHeight = healthcheckAck.RegistryBlockHeight
+		}
+	}
+	im.keeper.RecordRegistryAcknowledgement(ctx, modulePacket.SourceChannel, registryBlockHeight)
+
+	if _, ok := modulePacketData.Packet.(*commontypes.HealthcheckPacketData_IntervalChangeRequest); ok {
+		im.onIntervalChangeAcknowledgement(ctx, modulePacket.SourceChannel, ack)
+		return nil
+	}
+
 	switch resp := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Result:
+		ackResult := string(resp.Result)
+
+		var healthcheckAck commontypes.HealthcheckAck
+		if err := healthcheckAck.Unmarshal(resp.Result); err != nil {
+			// registry chains that don't report their view acknowledge updates with an opaque result
+			im.keeper.Logger(ctx).Debug("healthcheck acknowledgement result has no registry view", "error", err.Error())
+		} else {
+			im.keeper.SetLastHealthcheckAck(ctx, modulePacket.SourceChannel, healthcheckAck)
+			ackResult = healthcheckAck.String()
+		}
+
+		im.keeper.RecordDeliveryResult(ctx, modulePacket.SourceChannel, modulePacket.Sequence, types.AckSuccess, "")
+
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				commontypes.EventTypePacket,
-				sdk.NewAttribute(types.AttributeKeyAckSuccess, string(resp.Result)),
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, ackResult),
 			),
 		)
 	case *channeltypes.Acknowledgement_Error:
@@ -192,6 +296,8 @@ func (im IBCModule) OnAcknowledgementPacket(
 				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
 			),
 		)
+
+		im.keeper.RecordDeliveryResult(ctx, modulePacket.SourceChannel, modulePacket.Sequence, types.AckError, resp.Error)
 	}
 
 	return nil
@@ -203,6 +309,17 @@ func (im IBCModule) OnTimeoutPacket(
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
+	var modulePacketData commontypes.HealthcheckPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(modulePacket.GetData(), &modulePacketData); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %v", err)
+	}
+
+	// only healthcheck updates are tracked in the delivery log; a timed out interval change is requested again
+	// once the params change
+	if _, ok := modulePacketData.Packet.(*commontypes.HealthcheckPacketData_Data); ok {
+		im.keeper.RecordDeliveryResult(ctx, modulePacket.SourceChannel, modulePacket.Sequence, types.TimedOut, "")
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			commontypes.EventTypeTimeout,
@@ -213,3 +330,31 @@ func (im IBCModule) OnTimeoutPacket(
 
 	return nil
 }
+
+// onIntervalChangeAcknowledgement stores the intervals confirmed by registry chain for the registry channel
+func (im IBCModule) onIntervalChangeAcknowledgement(ctx sdk.Context, channelID string, ack channeltypes.Acknowledgement) {
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		var healthcheckAck commontypes.HealthcheckAck
+		if err := healthcheckAck.Unmarshal(resp.Result); err != nil {
+			im.keeper.Logger(ctx).Error("interval change acknowledgement result has no registry view", "error", err.Error())
+			return
+		}
+
+		im.keeper.ApplyNegotiatedIntervals(ctx, channelID, healthcheckAck.UpdateInterval, healthcheckAck.TimeoutInterval)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				commontypes.EventTypePacket,
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, healthcheckAck.String()),
+			),
+		)
+	case *channeltypes.Acknowledgement_Error:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				commontypes.EventTypePacket,
+				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
+			),
+		)
+	}
+}
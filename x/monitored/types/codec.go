@@ -1,10 +1,15 @@
 package types
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	// this line is used by starport scaffolding # 1
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/gogo/protobuf/jsonpb"
+
+	commontypes "healthcheck/x/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -21,3 +26,15 @@ var (
 	Amino     = codec.NewLegacyAmino()
 	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
 )
+
+// MarshalLegacyPacketData encodes the packet data sent to the registry chains that negotiated the legacy version.
+// Fields with default values are omitted, since those registry chains reject the fields they don't know.
+func MarshalLegacyPacketData(packet *commontypes.HealthcheckPacketData) ([]byte, error) {
+	var buf bytes.Buffer
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	if err := marshaler.Marshal(&buf, packet); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
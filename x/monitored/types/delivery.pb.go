@@ -0,0 +1,862 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcheck/monitored/delivery.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DeliveryResult is the outcome of a healthcheck update packet sent to the registry chain
+type DeliveryResult struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Outcome  uint64 `protobuf:"varint,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Error    string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Height   uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DeliveryResult) Reset()         { *m = DeliveryResult{} }
+func (m *DeliveryResult) String() string { return proto.CompactTextString(m) }
+func (*DeliveryResult) ProtoMessage()    {}
+func (*DeliveryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a838ae381fa4f8f, []int{0}
+}
+func (m *DeliveryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliveryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliveryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveryResult.Merge(m, src)
+}
+func (m *DeliveryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveryResult proto.InternalMessageInfo
+
+func (m *DeliveryResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *DeliveryResult) GetOutcome() uint64 {
+	if m != nil {
+		return m.Outcome
+	}
+	return 0
+}
+
+func (m *DeliveryResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DeliveryResult) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// DeliveryRecord is an entry of the delivery audit log of healthcheck update packets
+type DeliveryRecord struct {
+	Index        uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	ChannelId    string `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Sequence     uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Height       uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp    uint64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Outcome      uint64 `protobuf:"varint,6,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Error        string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	ResultHeight uint64 `protobuf:"varint,8,opt,name=resultHeight,proto3" json:"resultHeight,omitempty"`
+}
+
+func (m *DeliveryRecord) Reset()         { *m = DeliveryRecord{} }
+func (m *DeliveryRecord) String() string { return proto.CompactTextString(m) }
+func (*DeliveryRecord) ProtoMessage()    {}
+func (*DeliveryRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a838ae381fa4f8f, []int{1}
+}
+func (m *DeliveryRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliveryRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliveryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveryRecord.Merge(m, src)
+}
+func (m *DeliveryRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveryRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveryRecord proto.InternalMessageInfo
+
+func (m *DeliveryRecord) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DeliveryRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *DeliveryRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *DeliveryRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DeliveryRecord) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *DeliveryRecord) GetOutcome() uint64 {
+	if m != nil {
+		return m.Outcome
+	}
+	return 0
+}
+
+func (m *DeliveryRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DeliveryRecord) GetResultHeight() uint64 {
+	if m != nil {
+		return m.ResultHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DeliveryResult)(nil), "healthcheck.monitored.DeliveryResult")
+	proto.RegisterType((*DeliveryRecord)(nil), "healthcheck.monitored.DeliveryRecord")
+}
+
+func init() {
+	proto.RegisterFile("healthcheck/monitored/delivery.proto", fileDescriptor_3a838ae381fa4f8f)
+}
+
+var fileDescriptor_3a838ae381fa4f8f = []byte{
+	// 280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xb1, 0x4e, 0xc3, 0x30,
+	0x14, 0x8c, 0x69, 0x9b, 0x36, 0x16, 0x62, 0xb0, 0x00, 0x59, 0x08, 0xac, 0x2a, 0x62, 0xe8, 0xd4,
+	0x0e, 0x0c, 0xec, 0x88, 0x01, 0xd6, 0x8c, 0x6c, 0xc1, 0x79, 0xc2, 0x11, 0x49, 0x1c, 0x9c, 0x17,
+	0xd4, 0xfe, 0x05, 0x9f, 0xc5, 0xd8, 0x91, 0x11, 0x25, 0x1b, 0x5f, 0x81, 0xea, 0x84, 0xd6, 0x45,
+	0xea, 0x78, 0xf7, 0xce, 0xef, 0xde, 0xf9, 0xe8, 0xb5, 0x82, 0x38, 0x43, 0x25, 0x15, 0xc8, 0xd7,
+	0x45, 0xae, 0x8b, 0x14, 0xb5, 0x81, 0x64, 0x91, 0x40, 0x96, 0xbe, 0x83, 0x59, 0xcd, 0x4b, 0xa3,
+	0x51, 0xb3, 0x33, 0x47, 0x35, 0xdf, 0xaa, 0x42, 0xa4, 0x27, 0xf7, 0xbd, 0x30, 0x82, 0xaa, 0xce,
+	0x90, 0x5d, 0xd0, 0x49, 0x05, 0x6f, 0x35, 0x14, 0x12, 0x38, 0x99, 0x92, 0xd9, 0x30, 0xda, 0x62,
+	0xc6, 0xe9, 0x58, 0xd7, 0x28, 0x75, 0x0e, 0xfc, 0xc8, 0x8e, 0xfe, 0x20, 0x3b, 0xa5, 0x23, 0x30,
+	0x46, 0x1b, 0x3e, 0x98, 0x92, 0x59, 0x10, 0x75, 0x80, 0x9d, 0x53, 0x5f, 0x41, 0xfa, 0xa2, 0x90,
+	0x0f, 0xad, 0xbc, 0x47, 0xe1, 0x0f, 0x71, 0x6d, 0xa5, 0x36, 0xc9, 0x66, 0x41, 0x5a, 0x24, 0xb0,
+	0xec, 0x3d, 0x3b, 0xc0, 0x2e, 0x69, 0x20, 0x55, 0x5c, 0x14, 0x90, 0x3d, 0x26, 0xd6, 0x32, 0x88,
+	0x76, 0xc4, 0xde, 0xa9, 0x83, 0x7f, 0xa7, 0x1e, 0xb0, 0xde, 0x6c, 0xc4, 0x34, 0x87, 0x0a, 0xe3,
+	0xbc, 0xe4, 0x23, 0x3b, 0xda, 0x11, 0x6e, 0x40, 0xff, 0x40, 0xc0, 0xb1, 0x1b, 0x30, 0xa4, 0xc7,
+	0xc6, 0x7e, 0xdb, 0x43, 0xe7, 0x35, 0xb1, 0x8f, 0xf6, 0xb8, 0xbb, 0xdb, 0xcf, 0x46, 0x90, 0x75,
+	0x23, 0xc8, 0x77, 0x23, 0xc8, 0x47, 0x2b, 0xbc, 0x75, 0x2b, 0xbc, 0xaf, 0x56, 0x78, 0x4f, 0x57,
+	0x6e, 0x73, 0x4b, 0xa7, 0x3b, 0x5c, 0x95, 0x50, 0x3d, 0xfb, 0xb6, 0xb9, 0x9b, 0xdf, 0x01, 0x00,
+	0xc7, 0xc2, 0x97, 0xff, 0xe1, 0x01, 0x00, 0x00,
+}
+
+func (m *DeliveryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliveryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliveryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDelivery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintDelivery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintDelivery(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintDelivery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliveryRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliveryRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliveryRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResultHeight != 0 {
+		i = encodeVarintDelivery(dAtA, i, uint64(m.ResultHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintDelivery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintDelivery(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintDelivery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintDelivery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintDelivery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintDelivery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintDelivery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelivery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelivery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DeliveryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovDelivery(uint64(m.Sequence))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovDelivery(uint64(m.Outcome))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDelivery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDelivery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DeliveryRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovDelivery(uint64(m.Index))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovDelivery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovDelivery(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDelivery(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovDelivery(uint64(m.Timestamp))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovDelivery(uint64(m.Outcome))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDelivery(uint64(l))
+	}
+	if m.ResultHeight != 0 {
+		n += 1 + sovDelivery(uint64(m.ResultHeight))
+	}
+	return n
+}
+
+func sovDelivery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelivery(x uint64) (n int) {
+	return sovDelivery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DeliveryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelivery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliveryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelivery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelivery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelivery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelivery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeliveryRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelivery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliveryRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliveryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelivery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelivery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelivery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelivery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultHeight", wireType)
+			}
+			m.ResultHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResultHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelivery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelivery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDelivery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDelivery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelivery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDelivery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDelivery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDelivery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDelivery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDelivery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDelivery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -12,6 +12,7 @@ var (
 	ErrInvalidPacketTimeout         = sdkerrors.Register(ModuleName, 1500, "invalid packet timeout")
 	ErrInvalidVersion               = sdkerrors.Register(ModuleName, 1501, "invalid version")
 	ErrInvalidChannelFlow           = sdkerrors.Register(ModuleName, 1502, "invalid message sent to channel end")
-	ErrHealthcheckChannelAlreadySet = sdkerrors.Register(ModuleName, 1503, "channel for sending healthcheck updates is already set")
+	ErrHealthcheckChannelAlreadySet = sdkerrors.Register(ModuleName, 1503, "registry channel is already set on the connection")
 	ErrUnexpectedChannelID          = sdkerrors.Register(ModuleName, 1504, "unexpected channel ID")
+	ErrRegistryNotAllowed           = sdkerrors.Register(ModuleName, 1505, "registry chain is not allowed")
 )
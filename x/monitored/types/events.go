@@ -0,0 +1,12 @@
+package types
+
+// monitored module event types
+const (
+	EventTypeRegistryActive   = "monitored_registry_active"
+	EventTypeRegistryInactive = "monitored_registry_inactive"
+
+	AttributeKeyChannelID           = "channel_id"
+	AttributeKeyLastEvidenceHeight  = "last_evidence_height"
+	AttributeKeyRegistryBlockHeight = "registry_block_height"
+	AttributeKeyEvidenceSource      = "evidence_source"
+)
@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -16,3 +17,13 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	// Methods imported from bank should be defined here
 }
+
+// StakingKeeper defines the expected interface needed to retrieve the voting power of the active validators.
+type StakingKeeper interface {
+	IterateLastValidatorPowers(ctx sdk.Context, handler func(operator sdk.ValAddress, power int64) (stop bool))
+}
+
+// UpgradeKeeper defines the expected interface needed to retrieve the scheduled upgrade plan.
+type UpgradeKeeper interface {
+	GetUpgradePlan(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool)
+}
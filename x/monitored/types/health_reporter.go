@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "healthcheck/x/types"
+)
+
+// HealthReporter is implemented by the modules of a monitored app that contribute health indicators
+// to the healthcheck updates. Reporters are registered in the keeper by their name.
+type HealthReporter interface {
+	// Name returns the name of the reporter, which namespaces its indicators
+	Name() string
+	// ReportHealth returns the indicators included in the next healthcheck update
+	ReportHealth(ctx sdk.Context) []commontypes.Indicator
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "healthcheck/x/types"
+)
+
+// ValidatorSetChange returns the share of voting power (in basis points) that changed between the two
+// validator sets. Replacing the whole validator set is a change of the whole voting power.
+func ValidatorSetChange(previous []ValidatorPower, current []ValidatorPower) uint64 {
+	powerChanges := make(map[string]int64)
+	totalPower := sdk.ZeroInt()
+	for _, validator := range previous {
+		powerChanges[validator.OperatorAddress] -= int64(validator.Power)
+		totalPower = totalPower.Add(sdk.NewIntFromUint64(validator.Power))
+	}
+	for _, validator := range current {
+		powerChanges[validator.OperatorAddress] += int64(validator.Power)
+		totalPower = totalPower.Add(sdk.NewIntFromUint64(validator.Power))
+	}
+
+	if totalPower.IsZero() {
+		return 0
+	}
+
+	changedPower := sdk.ZeroInt()
+	for _, change := range powerChanges {
+		changedPower = changedPower.Add(sdk.NewInt(change).Abs())
+	}
+
+	return changedPower.Mul(sdk.NewIntFromUint64(commontypes.BasisPoints)).Quo(totalPower).Uint64()
+}
@@ -0,0 +1,684 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcheck/monitored/heartbeat.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HeartbeatState is the state of the monitored chain reported in the last healthcheck update,
+// which out-of-band heartbeats are triggered against
+type HeartbeatState struct {
+	ValidatorPowers   []ValidatorPower `protobuf:"bytes,1,rep,name=validatorPowers,proto3" json:"validatorPowers"`
+	UpgradePlanName   string           `protobuf:"bytes,2,opt,name=upgradePlanName,proto3" json:"upgradePlanName,omitempty"`
+	UpgradePlanHeight int64            `protobuf:"varint,3,opt,name=upgradePlanHeight,proto3" json:"upgradePlanHeight,omitempty"`
+	Params            Params           `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+}
+
+func (m *HeartbeatState) Reset()         { *m = HeartbeatState{} }
+func (m *HeartbeatState) String() string { return proto.CompactTextString(m) }
+func (*HeartbeatState) ProtoMessage()    {}
+func (*HeartbeatState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33a7b053781c7b02, []int{0}
+}
+func (m *HeartbeatState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeartbeatState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeartbeatState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeartbeatState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeartbeatState.Merge(m, src)
+}
+func (m *HeartbeatState) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeartbeatState) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeartbeatState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeartbeatState proto.InternalMessageInfo
+
+func (m *HeartbeatState) GetValidatorPowers() []ValidatorPower {
+	if m != nil {
+		return m.ValidatorPowers
+	}
+	return nil
+}
+
+func (m *HeartbeatState) GetUpgradePlanName() string {
+	if m != nil {
+		return m.UpgradePlanName
+	}
+	return ""
+}
+
+func (m *HeartbeatState) GetUpgradePlanHeight() int64 {
+	if m != nil {
+		return m.UpgradePlanHeight
+	}
+	return 0
+}
+
+func (m *HeartbeatState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type ValidatorPower struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operatorAddress,proto3" json:"operatorAddress,omitempty"`
+	Power           uint64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ValidatorPower) Reset()         { *m = ValidatorPower{} }
+func (m *ValidatorPower) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPower) ProtoMessage()    {}
+func (*ValidatorPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33a7b053781c7b02, []int{1}
+}
+func (m *ValidatorPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPower.Merge(m, src)
+}
+func (m *ValidatorPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPower proto.InternalMessageInfo
+
+func (m *ValidatorPower) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorPower) GetPower() uint64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*HeartbeatState)(nil), "healthcheck.monitored.HeartbeatState")
+	proto.RegisterType((*ValidatorPower)(nil), "healthcheck.monitored.ValidatorPower")
+}
+
+func init() {
+	proto.RegisterFile("healthcheck/monitored/heartbeat.proto", fileDescriptor_33a7b053781c7b02)
+}
+
+var fileDescriptor_33a7b053781c7b02 = []byte{
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc6, 0x73, 0x36, 0x16, 0xbc, 0x42, 0x8b, 0x47, 0x85, 0x50, 0xe8, 0x19, 0x02, 0x85, 0x0c,
+	0x92, 0x40, 0x1d, 0x1c, 0x9c, 0xec, 0xd4, 0x49, 0x42, 0x44, 0x07, 0xb7, 0x6b, 0xf3, 0x92, 0x04,
+	0x93, 0xde, 0x71, 0x39, 0xff, 0x7d, 0x0b, 0x3f, 0x56, 0xc7, 0x8e, 0x4e, 0x22, 0xc9, 0xd7, 0x70,
+	0x90, 0x24, 0x55, 0xd2, 0x58, 0xb7, 0xe4, 0xb9, 0xdf, 0xfb, 0xbe, 0xcf, 0xc3, 0x83, 0x27, 0x11,
+	0xb0, 0x44, 0x45, 0xcb, 0x08, 0x96, 0x0f, 0x6e, 0xca, 0x57, 0xb1, 0xe2, 0x12, 0x02, 0x37, 0x02,
+	0x26, 0xd5, 0x02, 0x98, 0x72, 0x84, 0xe4, 0x8a, 0x93, 0x93, 0x06, 0xe6, 0xfc, 0x62, 0xa3, 0x61,
+	0xc8, 0x43, 0x5e, 0x11, 0x6e, 0xf9, 0x55, 0xc3, 0x23, 0x6b, 0xff, 0x4e, 0xc1, 0x24, 0x4b, 0xb3,
+	0x9a, 0xb1, 0xbe, 0x10, 0xee, 0xcf, 0x7f, 0x8e, 0xdc, 0x28, 0xa6, 0x80, 0xdc, 0xe2, 0xc1, 0x13,
+	0x4b, 0xe2, 0x80, 0x29, 0x2e, 0x3d, 0xfe, 0x0c, 0x32, 0x33, 0x90, 0xd9, 0xb1, 0x7b, 0xd3, 0x89,
+	0xb3, 0xf7, 0xba, 0x73, 0xb7, 0x43, 0xcf, 0xf4, 0xf5, 0xc7, 0xa9, 0xe6, 0xb7, 0x77, 0x10, 0x1b,
+	0x0f, 0x1e, 0x45, 0x28, 0x59, 0x00, 0x5e, 0xc2, 0x56, 0xd7, 0x2c, 0x05, 0xe3, 0xc0, 0x44, 0xf6,
+	0x91, 0xdf, 0x96, 0xc9, 0x19, 0x3e, 0x6e, 0x48, 0x73, 0x88, 0xc3, 0x48, 0x19, 0x1d, 0x13, 0xd9,
+	0x1d, 0xff, 0xef, 0x03, 0xb9, 0xc4, 0xdd, 0x3a, 0x91, 0xa1, 0x9b, 0xc8, 0xee, 0x4d, 0xc7, 0xff,
+	0xb8, 0xf4, 0x2a, 0x68, 0xeb, 0x6e, 0x3b, 0x62, 0x79, 0xb8, 0xbf, 0xeb, 0xbe, 0xb4, 0xc9, 0x05,
+	0xc8, 0x52, 0xb8, 0x0a, 0x02, 0x09, 0x59, 0x99, 0xbe, 0xb2, 0xd9, 0x92, 0xc9, 0x10, 0x1f, 0x8a,
+	0x72, 0xa4, 0x8a, 0xa1, 0xfb, 0xf5, 0xcf, 0xec, 0x62, 0x9d, 0x53, 0xb4, 0xc9, 0x29, 0xfa, 0xcc,
+	0x29, 0x7a, 0x2b, 0xa8, 0xb6, 0x29, 0xa8, 0xf6, 0x5e, 0x50, 0xed, 0x7e, 0xdc, 0xac, 0xe3, 0xa5,
+	0x51, 0x88, 0x7a, 0x15, 0x90, 0x2d, 0xba, 0x55, 0x21, 0xe7, 0xdf, 0x03, 0x00, 0x55, 0x03, 0x6e,
+	0xbb, 0x0a, 0x02, 0x00, 0x00,
+}
+
+func (m *HeartbeatState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeartbeatState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHeartbeat(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.UpgradePlanHeight != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.UpgradePlanHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UpgradePlanName) > 0 {
+		i -= len(m.UpgradePlanName)
+		copy(dAtA[i:], m.UpgradePlanName)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.UpgradePlanName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorPowers) > 0 {
+		for iNdEx := len(m.ValidatorPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHeartbeat(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHeartbeat(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHeartbeat(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HeartbeatState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorPowers) > 0 {
+		for _, e := range m.ValidatorPowers {
+			l = e.Size()
+			n += 1 + l + sovHeartbeat(uint64(l))
+		}
+	}
+	l = len(m.UpgradePlanName)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	if m.UpgradePlanHeight != 0 {
+		n += 1 + sovHeartbeat(uint64(m.UpgradePlanHeight))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovHeartbeat(uint64(l))
+	return n
+}
+
+func (m *ValidatorPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovHeartbeat(uint64(m.Power))
+	}
+	return n
+}
+
+func sovHeartbeat(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHeartbeat(x uint64) (n int) {
+	return sovHeartbeat(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HeartbeatState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeartbeat
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPowers = append(m.ValidatorPowers, ValidatorPower{})
+			if err := m.ValidatorPowers[len(m.ValidatorPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradePlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePlanHeight", wireType)
+			}
+			m.UpgradePlanHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradePlanHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeartbeat(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeartbeat
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeartbeat(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHeartbeat(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHeartbeat
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHeartbeat
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHeartbeat
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHeartbeat
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHeartbeat        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHeartbeat          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHeartbeat = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,48 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// DeliveryRecordKeyPrefix is the prefix to retrieve all DeliveryRecord
+	DeliveryRecordKeyPrefix = "DeliveryRecord/value/"
+
+	// DeliveryRecordIndexKeyPrefix is the prefix to retrieve the index of the DeliveryRecord of a packet
+	DeliveryRecordIndexKeyPrefix = "DeliveryRecordIndex/value/"
+
+	// PendingUpdateKeyPrefix is the prefix to retrieve the healthcheck update packets in flight
+	PendingUpdateKeyPrefix = "PendingUpdate/value/"
+)
+
+var (
+	// NextDeliveryRecordIndexKey defines the key to store the index of the next DeliveryRecord
+	NextDeliveryRecordIndexKey = KeyPrefix("NextDeliveryRecordIndex")
+)
+
+// DeliveryRecordKey returns the store key to retrieve a DeliveryRecord from the index field
+func DeliveryRecordKey(
+	index uint64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, index)
+
+	return append(key, []byte("/")...)
+}
+
+// DeliveryRecordIndexKey returns the store key to retrieve the index of the DeliveryRecord of a packet.
+// The same key is used for the healthcheck update packets in flight.
+func DeliveryRecordIndexKey(
+	channelID string,
+	sequence uint64,
+) []byte {
+	var key []byte
+
+	key = append(key, []byte(channelID)...)
+	key = append(key, []byte("/")...)
+
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	key = append(key, sequenceBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,24 @@
+package types
+
+const (
+	// RegistryChannelKeyPrefix is the prefix to retrieve all RegistryChannel
+	RegistryChannelKeyPrefix = "RegistryChannel/value/"
+
+	// PendingRegistryChannelKeyPrefix is the prefix to retrieve the registry channels whose handshake
+	// was initiated, but not completed yet
+	PendingRegistryChannelKeyPrefix = "PendingRegistryChannel/value/"
+)
+
+// RegistryChannelKey returns the store key to retrieve a RegistryChannel, or any other state kept
+// for a registry channel, from the index fields
+func RegistryChannelKey(
+	channelID string,
+) []byte {
+	var key []byte
+
+	channelIDBytes := []byte(channelID)
+	key = append(key, channelIDBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
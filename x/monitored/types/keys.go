@@ -21,7 +21,8 @@ const (
 	// PortID is the default port id that module binds to
 	PortID = "monitored"
 
-	// each UpdateInterval blocks monitored chain sends its healthcheck update message to registry chain
+	// each UpdateInterval blocks monitored chain sends its healthcheck update message through the registry channels
+	// that didn't negotiate the update interval
 	UpdateInterval = 5
 
 	MaxUpdateInterval = 10
@@ -29,19 +30,96 @@ const (
 	MaxTimeoutInterval = 20
 
 	DefaultTimeoutPeriod = 7 * 24 * time.Hour
+
+	// MaxCountedPendingPackets is the number of pending packets of a channel after which the connectivity summary
+	// stops counting them
+	MaxCountedPendingPackets = 1000
+
+	// MaxIndicatorSize is the maximum size (in bytes) of an encoded health indicator, including the reporter name
+	MaxIndicatorSize = 256
+)
+
+const (
+	// LastHealthcheckUpdateHeightKeyPrefix defines the prefix to store the last block height
+	// for which the healthcheck status was sent through a registry channel
+	LastHealthcheckUpdateHeightKeyPrefix = "LastHealthcheckUpdateHeight/value/"
+
+	// UpdatesSentKeyPrefix defines the prefix to store the number of healthcheck updates
+	// sent through a registry channel
+	UpdatesSentKeyPrefix = "UpdatesSent/value/"
+
+	// LastHealthcheckAckKeyPrefix defines the prefix to store the last healthcheck update
+	// acknowledgement received through a registry channel
+	LastHealthcheckAckKeyPrefix = "LastHealthcheckAck/value/"
+
+	// LastDeliveryResultKeyPrefix defines the prefix to store the outcome of the last healthcheck
+	// update packet sent through a registry channel that was acknowledged or timed out
+	LastDeliveryResultKeyPrefix = "LastDeliveryResult/value/"
+
+	// ConsecutiveFailuresKeyPrefix defines the prefix to store the number of healthcheck update packets
+	// sent through a registry channel that failed to be delivered since the last successful delivery
+	ConsecutiveFailuresKeyPrefix = "ConsecutiveFailures/value/"
+
+	// LastIntervalChangeRequestKeyPrefix defines the prefix to store the last interval change
+	// request sent through a registry channel
+	LastIntervalChangeRequestKeyPrefix = "LastIntervalChangeRequest/value/"
+
+	// HeartbeatStateKeyPrefix defines the prefix to store the state of the chain reported
+	// in the last healthcheck update sent through a registry channel
+	HeartbeatStateKeyPrefix = "HeartbeatState/value/"
+
+	// LastUpdateSequenceKeyPrefix defines the prefix to store the sequence of the last healthcheck update packet
+	// sent through a registry channel
+	LastUpdateSequenceKeyPrefix = "LastUpdateSequence/value/"
+
+	// PendingProbeKeyPrefix defines the prefix to store the nonce of the probe challenge received through
+	// a registry channel, which has to be echoed in the next healthcheck update
+	PendingProbeKeyPrefix = "PendingProbe/value/"
+
+	// RegistryStatusKeyPrefix defines the prefix to store the liveness of the registry chain
+	// reached through a registry channel
+	RegistryStatusKeyPrefix = "RegistryStatus/value/"
 )
 
 var (
 	// PortKey defines the key to store the port ID in store
 	PortKey = KeyPrefix("monitored-port-")
 
-	// RegistryChainChannelIDKey defines the key to store the channel ID
-	// that is used to send healthcheck updates to registry chain
-	RegistryChainChannelIDKey = KeyPrefix("RegistryChainChannelID")
+	// SigningParticipationKey defines the key to store the signing participation
+	// of the validators in the last commit
+	SigningParticipationKey = KeyPrefix("SigningParticipation")
+)
+
+type DeliveryOutcome uint64
+
+const (
+	Pending DeliveryOutcome = iota
+	AckSuccess
+	AckError
+	TimedOut
+)
+
+// RegistryLiveness is the status of a registry chain as seen by the monitored chain
+type RegistryLiveness uint64
+
+const (
+	RegistryInactive RegistryLiveness = iota
+	RegistryActive
+)
+
+// RegistryEvidenceSource defines what was used as the evidence of the registry chain's liveness
+type RegistryEvidenceSource uint64
 
-	// LastHealthcheckUpdateHeightKey defines the key to store the last block height
-	// for which the healthcheck status was sent to registry chain
-	LastHealthcheckUpdateHeightKey = KeyPrefix("LastHealthcheckUpdateHeight")
+const (
+	NoRegistryEvidence RegistryEvidenceSource = iota
+	// RegistryHandshake is used for registry channels whose handshake was just completed
+	RegistryHandshake
+	// RegistryAcknowledgement means that the registry chain acknowledged a packet sent through the registry channel
+	RegistryAcknowledgement
+	// RegistryPacket means that a packet sent by the registry chain was received
+	RegistryPacket
+	// RegistryLightClient means that the light client tracking the registry chain was updated to a newer height
+	RegistryLightClient
 )
 
 func KeyPrefix(p string) []byte {
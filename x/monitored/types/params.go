@@ -1,34 +1,283 @@
 package types
 
 import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"gopkg.in/yaml.v2"
+
+	commontypes "healthcheck/x/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyDeliveryLogSize = []byte("DeliveryLogSize")
+	// DefaultDeliveryLogSize is the number of the most recent healthcheck update packets kept in the delivery log
+	DefaultDeliveryLogSize uint64 = 100
+
+	KeyLatestOnly = []byte("LatestOnly")
+	// DefaultLatestOnly disables the latest-only mode, so a healthcheck update is sent every UpdateInterval blocks,
+	// even if earlier updates weren't acknowledged yet
+	DefaultLatestOnly = false
+
+	KeyBacklogThreshold = []byte("BacklogThreshold")
+	// DefaultBacklogThreshold is the number of updates skipped in the latest-only mode after which a fresh update
+	// is sent, even though earlier updates weren't acknowledged yet. Zero means updates are skipped indefinitely.
+	DefaultBacklogThreshold uint64 = 3
+
+	KeyUpdateInterval = []byte("UpdateInterval")
+	// DefaultUpdateInterval is the update interval (in blocks) negotiated with registry chain. When it's changed
+	// on an open channel, registry chain is asked to apply it. Zero keeps the interval negotiated by the registry chain.
+	DefaultUpdateInterval uint64 = MaxUpdateInterval
+
+	KeyTimeoutInterval = []byte("TimeoutInterval")
+	// DefaultTimeoutInterval is the timeout interval (in blocks) negotiated with registry chain. When it's changed
+	// on an open channel, registry chain is asked to apply it. Zero keeps the interval negotiated by the registry chain.
+	DefaultTimeoutInterval uint64 = MaxTimeoutInterval
+
+	KeyDecentralizationReportInterval = []byte("DecentralizationReportInterval")
+	// DefaultDecentralizationReportInterval is the number of healthcheck updates after which the decentralization
+	// metrics are reported again. Zero disables the reporting.
+	DefaultDecentralizationReportInterval uint64 = 10
+
+	KeyConcentrationTopN = []byte("ConcentrationTopN")
+	// DefaultConcentrationTopN is the number of the largest validators whose share of voting power is reported
+	DefaultConcentrationTopN uint64 = 10
+
+	KeyConnectivityReportInterval = []byte("ConnectivityReportInterval")
+	// DefaultConnectivityReportInterval is the number of healthcheck updates after which the summary of the IBC
+	// channels is reported again. Zero disables the reporting.
+	DefaultConnectivityReportInterval uint64 = 10
+
+	KeyValidatorSetChangeThreshold = []byte("ValidatorSetChangeThreshold")
+	// DefaultValidatorSetChangeThreshold is the share of voting power (in basis points) that has to change
+	// since the last healthcheck update to trigger an out-of-band heartbeat. Zero disables the trigger.
+	DefaultValidatorSetChangeThreshold uint64 = 1000
+
+	KeyHeartbeatOnUpgradePlan = []byte("HeartbeatOnUpgradePlan")
+	// DefaultHeartbeatOnUpgradePlan enables the out-of-band heartbeat when an upgrade plan is scheduled
+	DefaultHeartbeatOnUpgradePlan = true
+
+	KeyHeartbeatOnParamsChange = []byte("HeartbeatOnParamsChange")
+	// DefaultHeartbeatOnParamsChange enables the out-of-band heartbeat when the healthcheck params are changed
+	DefaultHeartbeatOnParamsChange = true
+
+	KeyChannelIntervals = []byte("ChannelIntervals")
+	// DefaultChannelIntervals doesn't override the intervals of any registry channel, so all of them negotiate
+	// UpdateInterval and TimeoutInterval
+	DefaultChannelIntervals []ChannelIntervals = nil
+
+	KeyAllowedRegistryChains = []byte("AllowedRegistryChains")
+	// DefaultAllowedRegistryChains allows registry channels to any chain. Otherwise, registry channels can be opened
+	// only to the listed chains, identified by the chain ID resolved from the counterparty client.
+	DefaultAllowedRegistryChains []string = nil
+
+	KeyAllowedConnections = []byte("AllowedConnections")
+	// DefaultAllowedConnections allows registry channels on any connection. Otherwise, registry channels can be opened
+	// only on the listed connections.
+	DefaultAllowedConnections []string = nil
+
+	KeyMaxConnectivityChannels = []byte("MaxConnectivityChannels")
+	// DefaultMaxConnectivityChannels is the maximum number of IBC channels included in the connectivity summary,
+	// so that reporting it takes bounded work regardless of the number of channels of the chain
+	DefaultMaxConnectivityChannels uint64 = 50
+
+	KeyMaxIndicatorsPerReporter = []byte("MaxIndicatorsPerReporter")
+	// DefaultMaxIndicatorsPerReporter is the maximum number of indicators of a health reporter included in a healthcheck
+	// update. The indicators reported above the limit are dropped.
+	DefaultMaxIndicatorsPerReporter uint64 = 10
+
+	KeyMaxIndicators = []byte("MaxIndicators")
+	// DefaultMaxIndicators is the maximum number of indicators included in a healthcheck update
+	DefaultMaxIndicators uint64 = 50
+
+	KeyRegistryClientFreshness = []byte("RegistryClientFreshness")
+	// DefaultRegistryClientFreshness is the maximum age in seconds of the registry chain light client consensus state
+	// for a light client update to count as an evidence of the registry chain liveness
+	DefaultRegistryClientFreshness uint64 = 600
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	deliveryLogSize uint64,
+	latestOnly bool,
+	backlogThreshold uint64,
+	updateInterval uint64,
+	timeoutInterval uint64,
+	decentralizationReportInterval uint64,
+	concentrationTopN uint64,
+	connectivityReportInterval uint64,
+	validatorSetChangeThreshold uint64,
+	heartbeatOnUpgradePlan bool,
+	heartbeatOnParamsChange bool,
+	channelIntervals []ChannelIntervals,
+	allowedRegistryChains []string,
+	allowedConnections []string,
+	maxConnectivityChannels uint64,
+	maxIndicatorsPerReporter uint64,
+	maxIndicators uint64,
+	registryClientFreshness uint64,
+) Params {
+	return Params{
+		DeliveryLogSize:  deliveryLogSize,
+		LatestOnly:       latestOnly,
+		BacklogThreshold: backlogThreshold,
+		UpdateInterval:   updateInterval,
+		TimeoutInterval:  timeoutInterval,
+
+		DecentralizationReportInterval: decentralizationReportInterval,
+		ConcentrationTopN:              concentrationTopN,
+		ConnectivityReportInterval:     connectivityReportInterval,
+
+		ValidatorSetChangeThreshold: validatorSetChangeThreshold,
+		HeartbeatOnUpgradePlan:      heartbeatOnUpgradePlan,
+		HeartbeatOnParamsChange:     heartbeatOnParamsChange,
+
+		ChannelIntervals: channelIntervals,
+
+		AllowedRegistryChains: allowedRegistryChains,
+		AllowedConnections:    allowedConnections,
+
+		MaxConnectivityChannels: maxConnectivityChannels,
+
+		MaxIndicatorsPerReporter: maxIndicatorsPerReporter,
+		MaxIndicators:            maxIndicators,
+		RegistryClientFreshness:  registryClientFreshness,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultDeliveryLogSize,
+		DefaultLatestOnly,
+		DefaultBacklogThreshold,
+		DefaultUpdateInterval,
+		DefaultTimeoutInterval,
+		DefaultDecentralizationReportInterval,
+		DefaultConcentrationTopN,
+		DefaultConnectivityReportInterval,
+		DefaultValidatorSetChangeThreshold,
+		DefaultHeartbeatOnUpgradePlan,
+		DefaultHeartbeatOnParamsChange,
+		DefaultChannelIntervals,
+		DefaultAllowedRegistryChains,
+		DefaultAllowedConnections,
+		DefaultMaxConnectivityChannels,
+		DefaultMaxIndicatorsPerReporter,
+		DefaultMaxIndicators,
+		DefaultRegistryClientFreshness,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDeliveryLogSize, &p.DeliveryLogSize, validateDeliveryLogSize),
+		paramtypes.NewParamSetPair(KeyLatestOnly, &p.LatestOnly, validateLatestOnly),
+		paramtypes.NewParamSetPair(KeyBacklogThreshold, &p.BacklogThreshold, validateBacklogThreshold),
+		paramtypes.NewParamSetPair(KeyUpdateInterval, &p.UpdateInterval, validateUpdateInterval),
+		paramtypes.NewParamSetPair(KeyTimeoutInterval, &p.TimeoutInterval, validateTimeoutInterval),
+		paramtypes.NewParamSetPair(KeyDecentralizationReportInterval, &p.DecentralizationReportInterval, validateDecentralizationReportInterval),
+		paramtypes.NewParamSetPair(KeyConcentrationTopN, &p.ConcentrationTopN, validateConcentrationTopN),
+		paramtypes.NewParamSetPair(KeyConnectivityReportInterval, &p.ConnectivityReportInterval, validateConnectivityReportInterval),
+		paramtypes.NewParamSetPair(KeyValidatorSetChangeThreshold, &p.ValidatorSetChangeThreshold, validateValidatorSetChangeThreshold),
+		paramtypes.NewParamSetPair(KeyHeartbeatOnUpgradePlan, &p.HeartbeatOnUpgradePlan, validateHeartbeatOnUpgradePlan),
+		paramtypes.NewParamSetPair(KeyHeartbeatOnParamsChange, &p.HeartbeatOnParamsChange, validateHeartbeatOnParamsChange),
+		paramtypes.NewParamSetPair(KeyChannelIntervals, &p.ChannelIntervals, validateChannelIntervals),
+		paramtypes.NewParamSetPair(KeyAllowedRegistryChains, &p.AllowedRegistryChains, validateAllowedRegistryChains),
+		paramtypes.NewParamSetPair(KeyAllowedConnections, &p.AllowedConnections, validateAllowedConnections),
+		paramtypes.NewParamSetPair(KeyMaxConnectivityChannels, &p.MaxConnectivityChannels, validateMaxConnectivityChannels),
+		paramtypes.NewParamSetPair(KeyMaxIndicatorsPerReporter, &p.MaxIndicatorsPerReporter, validateMaxIndicatorsPerReporter),
+		paramtypes.NewParamSetPair(KeyMaxIndicators, &p.MaxIndicators, validateMaxIndicators),
+		paramtypes.NewParamSetPair(KeyRegistryClientFreshness, &p.RegistryClientFreshness, validateRegistryClientFreshness),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateDeliveryLogSize(p.DeliveryLogSize); err != nil {
+		return err
+	}
+
+	if err := validateLatestOnly(p.LatestOnly); err != nil {
+		return err
+	}
+
+	if err := validateBacklogThreshold(p.BacklogThreshold); err != nil {
+		return err
+	}
+
+	if err := validateUpdateInterval(p.UpdateInterval); err != nil {
+		return err
+	}
+
+	if err := validateTimeoutInterval(p.TimeoutInterval); err != nil {
+		return err
+	}
+
+	if err := validateDecentralizationReportInterval(p.DecentralizationReportInterval); err != nil {
+		return err
+	}
+
+	if err := validateConcentrationTopN(p.ConcentrationTopN); err != nil {
+		return err
+	}
+
+	if err := validateConnectivityReportInterval(p.ConnectivityReportInterval); err != nil {
+		return err
+	}
+
+	if err := validateValidatorSetChangeThreshold(p.ValidatorSetChangeThreshold); err != nil {
+		return err
+	}
+
+	if err := validateHeartbeatOnUpgradePlan(p.HeartbeatOnUpgradePlan); err != nil {
+		return err
+	}
+
+	if err := validateHeartbeatOnParamsChange(p.HeartbeatOnParamsChange); err != nil {
+		return err
+	}
+
+	if err := validateChannelIntervals(p.ChannelIntervals); err != nil {
+		return err
+	}
+
+	if err := validateAllowedRegistryChains(p.AllowedRegistryChains); err != nil {
+		return err
+	}
+
+	if err := validateAllowedConnections(p.AllowedConnections); err != nil {
+		return err
+	}
+
+	if err := validateMaxConnectivityChannels(p.MaxConnectivityChannels); err != nil {
+		return err
+	}
+
+	if err := validateMaxIndicatorsPerReporter(p.MaxIndicatorsPerReporter); err != nil {
+		return err
+	}
+
+	if err := validateMaxIndicators(p.MaxIndicators); err != nil {
+		return err
+	}
+
+	if err := validateRegistryClientFreshness(p.RegistryClientFreshness); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -37,3 +286,226 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// validateDeliveryLogSize validates the DeliveryLogSize param
+func validateDeliveryLogSize(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateLatestOnly validates the LatestOnly param
+func validateLatestOnly(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateBacklogThreshold validates the BacklogThreshold param
+func validateBacklogThreshold(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateUpdateInterval validates the UpdateInterval param
+func validateUpdateInterval(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateTimeoutInterval validates the TimeoutInterval param
+func validateTimeoutInterval(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateDecentralizationReportInterval validates the DecentralizationReportInterval param
+func validateDecentralizationReportInterval(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateConcentrationTopN validates the ConcentrationTopN param
+func validateConcentrationTopN(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateConnectivityReportInterval validates the ConnectivityReportInterval param
+func validateConnectivityReportInterval(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateValidatorSetChangeThreshold validates the ValidatorSetChangeThreshold param
+func validateValidatorSetChangeThreshold(v interface{}) error {
+	threshold, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if threshold > commontypes.BasisPoints {
+		return fmt.Errorf("validator set change threshold must not exceed %d basis points: %d", commontypes.BasisPoints, threshold)
+	}
+
+	return nil
+}
+
+// validateHeartbeatOnUpgradePlan validates the HeartbeatOnUpgradePlan param
+func validateHeartbeatOnUpgradePlan(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateHeartbeatOnParamsChange validates the HeartbeatOnParamsChange param
+func validateHeartbeatOnParamsChange(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateChannelIntervals validates the ChannelIntervals param
+func validateChannelIntervals(v interface{}) error {
+	channelIntervals, ok := v.([]ChannelIntervals)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	channels := make(map[string]struct{})
+	for _, intervals := range channelIntervals {
+		if err := host.ChannelIdentifierValidator(intervals.ChannelId); err != nil {
+			return err
+		}
+
+		if _, ok := channels[intervals.ChannelId]; ok {
+			return fmt.Errorf("duplicated intervals for channel %s", intervals.ChannelId)
+		}
+		channels[intervals.ChannelId] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateAllowedRegistryChains validates the AllowedRegistryChains param
+func validateAllowedRegistryChains(v interface{}) error {
+	chainIDs, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	allowed := make(map[string]struct{})
+	for _, chainID := range chainIDs {
+		if strings.TrimSpace(chainID) == "" {
+			return fmt.Errorf("allowed registry chain ID cannot be blank")
+		}
+
+		if _, ok := allowed[chainID]; ok {
+			return fmt.Errorf("duplicated allowed registry chain %s", chainID)
+		}
+		allowed[chainID] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateAllowedConnections validates the AllowedConnections param
+func validateAllowedConnections(v interface{}) error {
+	connectionIDs, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	allowed := make(map[string]struct{})
+	for _, connectionID := range connectionIDs {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return err
+		}
+
+		if _, ok := allowed[connectionID]; ok {
+			return fmt.Errorf("duplicated allowed connection %s", connectionID)
+		}
+		allowed[connectionID] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateMaxConnectivityChannels validates the MaxConnectivityChannels param
+func validateMaxConnectivityChannels(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxIndicatorsPerReporter validates the MaxIndicatorsPerReporter param
+func validateMaxIndicatorsPerReporter(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxIndicators validates the MaxIndicators param
+func validateMaxIndicators(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateRegistryClientFreshness validates the RegistryClientFreshness param
+func validateRegistryClientFreshness(v interface{}) error {
+	value, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if value > uint64(math.MaxInt64/int64(time.Second)) {
+		return fmt.Errorf("registry client freshness overflows a duration: %d", value)
+	}
+
+	return nil
+}
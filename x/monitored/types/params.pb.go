@@ -25,6 +25,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	DeliveryLogSize                uint64             `protobuf:"varint,1,opt,name=deliveryLogSize,proto3" json:"deliveryLogSize,omitempty" yaml:"delivery_log_size"`
+	LatestOnly                     bool               `protobuf:"varint,2,opt,name=latestOnly,proto3" json:"latestOnly,omitempty" yaml:"latest_only"`
+	BacklogThreshold               uint64             `protobuf:"varint,3,opt,name=backlogThreshold,proto3" json:"backlogThreshold,omitempty" yaml:"backlog_threshold"`
+	UpdateInterval                 uint64             `protobuf:"varint,4,opt,name=updateInterval,proto3" json:"updateInterval,omitempty" yaml:"update_interval"`
+	TimeoutInterval                uint64             `protobuf:"varint,5,opt,name=timeoutInterval,proto3" json:"timeoutInterval,omitempty" yaml:"timeout_interval"`
+	DecentralizationReportInterval uint64             `protobuf:"varint,6,opt,name=decentralizationReportInterval,proto3" json:"decentralizationReportInterval,omitempty" yaml:"decentralization_report_interval"`
+	ConcentrationTopN              uint64             `protobuf:"varint,7,opt,name=concentrationTopN,proto3" json:"concentrationTopN,omitempty" yaml:"concentration_top_n"`
+	ConnectivityReportInterval     uint64             `protobuf:"varint,8,opt,name=connectivityReportInterval,proto3" json:"connectivityReportInterval,omitempty" yaml:"connectivity_report_interval"`
+	ValidatorSetChangeThreshold    uint64             `protobuf:"varint,9,opt,name=validatorSetChangeThreshold,proto3" json:"validatorSetChangeThreshold,omitempty" yaml:"validator_set_change_threshold"`
+	HeartbeatOnUpgradePlan         bool               `protobuf:"varint,10,opt,name=heartbeatOnUpgradePlan,proto3" json:"heartbeatOnUpgradePlan,omitempty" yaml:"heartbeat_on_upgrade_plan"`
+	HeartbeatOnParamsChange        bool               `protobuf:"varint,11,opt,name=heartbeatOnParamsChange,proto3" json:"heartbeatOnParamsChange,omitempty" yaml:"heartbeat_on_params_change"`
+	ChannelIntervals               []ChannelIntervals `protobuf:"bytes,12,rep,name=channelIntervals,proto3" json:"channelIntervals" yaml:"channel_intervals"`
+	AllowedRegistryChains          []string           `protobuf:"bytes,13,rep,name=allowedRegistryChains,proto3" json:"allowedRegistryChains,omitempty" yaml:"allowed_registry_chains"`
+	AllowedConnections             []string           `protobuf:"bytes,14,rep,name=allowedConnections,proto3" json:"allowedConnections,omitempty" yaml:"allowed_connections"`
+	MaxConnectivityChannels        uint64             `protobuf:"varint,15,opt,name=maxConnectivityChannels,proto3" json:"maxConnectivityChannels,omitempty" yaml:"max_connectivity_channels"`
+	MaxIndicatorsPerReporter       uint64             `protobuf:"varint,16,opt,name=maxIndicatorsPerReporter,proto3" json:"maxIndicatorsPerReporter,omitempty" yaml:"max_indicators_per_reporter"`
+	MaxIndicators                  uint64             `protobuf:"varint,17,opt,name=maxIndicators,proto3" json:"maxIndicators,omitempty" yaml:"max_indicators"`
+	RegistryClientFreshness        uint64             `protobuf:"varint,18,opt,name=registryClientFreshness,proto3" json:"registryClientFreshness,omitempty" yaml:"registry_client_freshness"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +77,132 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDeliveryLogSize() uint64 {
+	if m != nil {
+		return m.DeliveryLogSize
+	}
+	return 0
+}
+
+func (m *Params) GetLatestOnly() bool {
+	if m != nil {
+		return m.LatestOnly
+	}
+	return false
+}
+
+func (m *Params) GetBacklogThreshold() uint64 {
+	if m != nil {
+		return m.BacklogThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetUpdateInterval() uint64 {
+	if m != nil {
+		return m.UpdateInterval
+	}
+	return 0
+}
+
+func (m *Params) GetTimeoutInterval() uint64 {
+	if m != nil {
+		return m.TimeoutInterval
+	}
+	return 0
+}
+
+func (m *Params) GetDecentralizationReportInterval() uint64 {
+	if m != nil {
+		return m.DecentralizationReportInterval
+	}
+	return 0
+}
+
+func (m *Params) GetConcentrationTopN() uint64 {
+	if m != nil {
+		return m.ConcentrationTopN
+	}
+	return 0
+}
+
+func (m *Params) GetConnectivityReportInterval() uint64 {
+	if m != nil {
+		return m.ConnectivityReportInterval
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorSetChangeThreshold() uint64 {
+	if m != nil {
+		return m.ValidatorSetChangeThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetHeartbeatOnUpgradePlan() bool {
+	if m != nil {
+		return m.HeartbeatOnUpgradePlan
+	}
+	return false
+}
+
+func (m *Params) GetHeartbeatOnParamsChange() bool {
+	if m != nil {
+		return m.HeartbeatOnParamsChange
+	}
+	return false
+}
+
+func (m *Params) GetChannelIntervals() []ChannelIntervals {
+	if m != nil {
+		return m.ChannelIntervals
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedRegistryChains() []string {
+	if m != nil {
+		return m.AllowedRegistryChains
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedConnections() []string {
+	if m != nil {
+		return m.AllowedConnections
+	}
+	return nil
+}
+
+func (m *Params) GetMaxConnectivityChannels() uint64 {
+	if m != nil {
+		return m.MaxConnectivityChannels
+	}
+	return 0
+}
+
+func (m *Params) GetMaxIndicatorsPerReporter() uint64 {
+	if m != nil {
+		return m.MaxIndicatorsPerReporter
+	}
+	return 0
+}
+
+func (m *Params) GetMaxIndicators() uint64 {
+	if m != nil {
+		return m.MaxIndicators
+	}
+	return 0
+}
+
+func (m *Params) GetRegistryClientFreshness() uint64 {
+	if m != nil {
+		return m.RegistryClientFreshness
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "healthcheck.monitored.Params")
 }
@@ -68,16 +212,57 @@ func init() {
 }
 
 var fileDescriptor_a8c36847901c2e37 = []byte{
-	// 138 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0x48, 0x4d, 0xcc,
-	0x29, 0xc9, 0x48, 0xce, 0x48, 0x4d, 0xce, 0xd6, 0xcf, 0xcd, 0xcf, 0xcb, 0x2c, 0xc9, 0x2f, 0x4a,
-	0x4d, 0xd1, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x45, 0x52, 0xa3, 0x07, 0x57, 0x23, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa1, 0x0f, 0x62,
-	0x41, 0x14, 0x2b, 0xf1, 0x71, 0xb1, 0x05, 0x80, 0x35, 0x5b, 0xb1, 0xcc, 0x58, 0x20, 0xcf, 0xe0,
-	0x64, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xb2, 0xc8, 0x56, 0x57,
-	0x20, 0x59, 0x5e, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x36, 0xcf, 0x18, 0x10, 0x00, 0x00,
-	0xff, 0xff, 0x5e, 0xf8, 0x24, 0xb1, 0xa2, 0x00, 0x00, 0x00,
+	// 792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xdd, 0x6e, 0xdc, 0x44,
+	0x14, 0xc7, 0x77, 0x49, 0x08, 0xed, 0xf4, 0x23, 0xe9, 0x88, 0x24, 0x66, 0x0b, 0xf6, 0x32, 0x50,
+	0x12, 0x04, 0x4a, 0x24, 0x90, 0x40, 0xea, 0x0d, 0xd2, 0xae, 0xa8, 0xa8, 0x54, 0xb5, 0x91, 0x5b,
+	0x24, 0x84, 0x10, 0xa3, 0x89, 0x7d, 0xb0, 0x47, 0x99, 0x9d, 0xb1, 0x66, 0x26, 0x21, 0x9b, 0xa7,
+	0xe0, 0x92, 0x4b, 0x5e, 0x85, 0xbb, 0x5e, 0xf6, 0x92, 0x2b, 0x0b, 0x25, 0x6f, 0xe0, 0x27, 0x40,
+	0x3b, 0xe3, 0xf5, 0x7a, 0xbf, 0xca, 0xdd, 0x4a, 0xe7, 0x77, 0x7e, 0x67, 0xf6, 0xf8, 0x3f, 0x36,
+	0x22, 0x39, 0x30, 0x61, 0xf3, 0x24, 0x87, 0xe4, 0xec, 0x78, 0xa4, 0x24, 0xb7, 0x4a, 0x43, 0x7a,
+	0x5c, 0x30, 0xcd, 0x46, 0xe6, 0xa8, 0xd0, 0xca, 0x2a, 0xbc, 0xdb, 0x62, 0x8e, 0x1a, 0xa6, 0xf7,
+	0x7e, 0xa6, 0x32, 0xe5, 0x88, 0xe3, 0xc9, 0x2f, 0x0f, 0xf7, 0xbe, 0x5c, 0x2d, 0xd4, 0x90, 0x71,
+	0x63, 0xf5, 0x98, 0x26, 0x39, 0x93, 0x12, 0x84, 0xa7, 0xc9, 0xdf, 0x77, 0xd1, 0xd6, 0x89, 0x9b,
+	0x85, 0x9f, 0xa0, 0xed, 0x14, 0x04, 0xbf, 0x00, 0x3d, 0x7e, 0xa6, 0xb2, 0x97, 0xfc, 0x0a, 0x82,
+	0x6e, 0xbf, 0x7b, 0xb8, 0x39, 0xf8, 0xb0, 0x2a, 0xa3, 0x60, 0xcc, 0x46, 0xe2, 0x31, 0x99, 0x02,
+	0x54, 0xa8, 0x8c, 0x1a, 0x7e, 0x05, 0x24, 0x5e, 0x6c, 0xc2, 0xdf, 0x20, 0x24, 0x98, 0x05, 0x63,
+	0x5f, 0x48, 0x31, 0x0e, 0xde, 0xe9, 0x77, 0x0f, 0x6f, 0x0d, 0xf6, 0xaa, 0x32, 0xc2, 0x5e, 0xe1,
+	0x6b, 0x54, 0x49, 0x31, 0x26, 0x71, 0x8b, 0xc4, 0x3f, 0xa0, 0x9d, 0x53, 0x96, 0x9c, 0x09, 0x95,
+	0xbd, 0xca, 0x35, 0x98, 0x5c, 0x89, 0x34, 0xd8, 0x58, 0x3c, 0x40, 0x4d, 0x50, 0x3b, 0x45, 0x48,
+	0xbc, 0xd4, 0x85, 0x07, 0xe8, 0xfe, 0x79, 0x91, 0x32, 0x0b, 0x4f, 0xa5, 0x05, 0x7d, 0xc1, 0x44,
+	0xb0, 0xe9, 0x3c, 0xbd, 0xaa, 0x8c, 0xf6, 0xbc, 0xc7, 0xd7, 0x29, 0xaf, 0x01, 0x12, 0x2f, 0x74,
+	0xe0, 0xef, 0xd1, 0xb6, 0xe5, 0x23, 0x50, 0xe7, 0xb6, 0x91, 0xbc, 0xeb, 0x24, 0x0f, 0xab, 0x32,
+	0xda, 0xf7, 0x92, 0x1a, 0x68, 0x59, 0x16, 0x7b, 0xb0, 0x41, 0x61, 0x0a, 0x09, 0x48, 0xab, 0x99,
+	0xe0, 0x57, 0xcc, 0x72, 0x25, 0x63, 0x28, 0x94, 0x9e, 0x59, 0xb7, 0x9c, 0xf5, 0x8b, 0xaa, 0x8c,
+	0x0e, 0xa6, 0x3b, 0x9e, 0xe7, 0xa9, 0x76, 0x0d, 0xad, 0x29, 0xff, 0xa3, 0xc4, 0xcf, 0xd0, 0x83,
+	0x44, 0x49, 0x8f, 0x4c, 0xca, 0xaf, 0x54, 0xf1, 0x3c, 0x78, 0xcf, 0xcd, 0x09, 0xab, 0x32, 0xea,
+	0xf9, 0x39, 0x73, 0x08, 0xb5, 0xaa, 0xa0, 0x92, 0xc4, 0xcb, 0x8d, 0x38, 0x43, 0xbd, 0x44, 0x49,
+	0x09, 0x89, 0xe5, 0x17, 0xdc, 0x8e, 0x17, 0x8e, 0x7f, 0xcb, 0x69, 0x0f, 0xaa, 0x32, 0xfa, 0xa4,
+	0xd1, 0x36, 0xec, 0xf2, 0xd1, 0xdf, 0xa2, 0xc2, 0x67, 0xe8, 0xe1, 0x05, 0x13, 0x3c, 0x65, 0x56,
+	0xe9, 0x97, 0x60, 0x87, 0x39, 0x93, 0x19, 0xcc, 0xb2, 0x70, 0xdb, 0x4d, 0xfa, 0xbc, 0x2a, 0xa3,
+	0x47, 0x7e, 0x52, 0x03, 0x53, 0x03, 0xd6, 0xe5, 0x3a, 0x83, 0x76, 0x30, 0xde, 0x66, 0xc3, 0xbf,
+	0xa0, 0xbd, 0x1c, 0x98, 0xb6, 0xa7, 0xc0, 0xec, 0x0b, 0xf9, 0x63, 0x91, 0x69, 0x96, 0xc2, 0x89,
+	0x60, 0x32, 0x40, 0x2e, 0xb1, 0x9f, 0x56, 0x65, 0xd4, 0xf7, 0x73, 0x1a, 0x8e, 0x2a, 0x49, 0xcf,
+	0x3d, 0x49, 0x0b, 0xc1, 0x24, 0x89, 0xd7, 0x38, 0x30, 0x45, 0xfb, 0xad, 0x8a, 0xbf, 0x60, 0xfe,
+	0x04, 0xc1, 0x1d, 0xa7, 0x7f, 0x54, 0x95, 0xd1, 0xc7, 0x2b, 0xf4, 0xfe, 0xde, 0xd7, 0x7f, 0x86,
+	0xc4, 0xeb, 0x2c, 0xf8, 0x1c, 0xed, 0xd4, 0x17, 0x79, 0xba, 0x3e, 0x13, 0xdc, 0xed, 0x6f, 0x1c,
+	0xde, 0xf9, 0xea, 0xe0, 0x68, 0xe5, 0xdb, 0xe2, 0x68, 0xb8, 0x80, 0x0f, 0xfa, 0xaf, 0xcb, 0xa8,
+	0x33, 0xbb, 0x59, 0xb5, 0xae, 0x79, 0x56, 0x86, 0xc4, 0x4b, 0x23, 0xf0, 0x4f, 0x68, 0x97, 0x09,
+	0xa1, 0x7e, 0x87, 0x34, 0xae, 0xdf, 0x27, 0xc3, 0x9c, 0x71, 0x69, 0x82, 0x7b, 0xfd, 0x8d, 0xc3,
+	0xdb, 0x03, 0x52, 0x95, 0x51, 0xe8, 0x75, 0x35, 0x46, 0xdb, 0xef, 0x1d, 0x2e, 0x0d, 0x89, 0x57,
+	0x0b, 0xf0, 0x73, 0x84, 0xeb, 0xc2, 0xb0, 0x4e, 0x88, 0x92, 0x26, 0xb8, 0xef, 0xb4, 0xad, 0xd0,
+	0x4e, 0xb5, 0xc9, 0x0c, 0x22, 0xf1, 0x8a, 0x4e, 0xfc, 0x2b, 0xda, 0x1f, 0xb1, 0xcb, 0x61, 0x2b,
+	0x6d, 0xf5, 0x02, 0x4c, 0xb0, 0xed, 0x82, 0xd4, 0x7a, 0xc0, 0x23, 0x76, 0x49, 0xe7, 0x62, 0x5b,
+	0xff, 0x6f, 0x43, 0xe2, 0x75, 0x12, 0x7c, 0x8a, 0x82, 0x11, 0xbb, 0x7c, 0x2a, 0x53, 0x9e, 0x4c,
+	0x12, 0x66, 0x4e, 0x40, 0xfb, 0x38, 0x83, 0x0e, 0x76, 0xdc, 0x80, 0xcf, 0xaa, 0x32, 0x22, 0xb3,
+	0x01, 0xbc, 0x41, 0x69, 0x01, 0xba, 0xbe, 0x19, 0xa0, 0x49, 0xbc, 0xd6, 0x83, 0xbf, 0x43, 0xf7,
+	0xe6, 0x6a, 0xc1, 0x03, 0x27, 0xfe, 0xa0, 0x2a, 0xa3, 0xdd, 0x55, 0x62, 0x12, 0xcf, 0xf3, 0x93,
+	0x25, 0x4c, 0xf7, 0x3f, 0x14, 0x1c, 0xa4, 0x7d, 0x32, 0x89, 0xbf, 0x04, 0x63, 0x02, 0xbc, 0xb8,
+	0x84, 0xd9, 0x83, 0x72, 0x24, 0xfd, 0x6d, 0x8a, 0x92, 0x78, 0x9d, 0xe4, 0xf1, 0xe6, 0x9f, 0x7f,
+	0x45, 0x9d, 0xc1, 0xb7, 0xaf, 0xaf, 0xc3, 0xee, 0x9b, 0xeb, 0xb0, 0xfb, 0xef, 0x75, 0xd8, 0xfd,
+	0xe3, 0x26, 0xec, 0xbc, 0xb9, 0x09, 0x3b, 0xff, 0xdc, 0x84, 0x9d, 0x9f, 0x3f, 0x6a, 0x7f, 0x8b,
+	0x2e, 0x5b, 0x5f, 0x23, 0x3b, 0x2e, 0xc0, 0x9c, 0x6e, 0xb9, 0x6f, 0xd0, 0xd7, 0xff, 0x0d, 0x00,
+	0x43, 0x44, 0x8e, 0x2e, 0x04, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -100,6 +285,134 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RegistryClientFreshness != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RegistryClientFreshness))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxIndicators != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxIndicators))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxIndicatorsPerReporter != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxIndicatorsPerReporter))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxConnectivityChannels != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxConnectivityChannels))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.AllowedConnections) > 0 {
+		for iNdEx := len(m.AllowedConnections) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedConnections[iNdEx])
+			copy(dAtA[i:], m.AllowedConnections[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedConnections[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AllowedRegistryChains) > 0 {
+		for iNdEx := len(m.AllowedRegistryChains) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRegistryChains[iNdEx])
+			copy(dAtA[i:], m.AllowedRegistryChains[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedRegistryChains[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ChannelIntervals) > 0 {
+		for iNdEx := len(m.ChannelIntervals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelIntervals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.HeartbeatOnParamsChange {
+		i--
+		if m.HeartbeatOnParamsChange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.HeartbeatOnUpgradePlan {
+		i--
+		if m.HeartbeatOnUpgradePlan {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ValidatorSetChangeThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ValidatorSetChangeThreshold))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ConnectivityReportInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ConnectivityReportInterval))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ConcentrationTopN != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ConcentrationTopN))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DecentralizationReportInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DecentralizationReportInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimeoutInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TimeoutInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UpdateInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UpdateInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BacklogThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BacklogThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LatestOnly {
+		i--
+		if m.LatestOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DeliveryLogSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DeliveryLogSize))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -120,6 +433,69 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.DeliveryLogSize != 0 {
+		n += 1 + sovParams(uint64(m.DeliveryLogSize))
+	}
+	if m.LatestOnly {
+		n += 2
+	}
+	if m.BacklogThreshold != 0 {
+		n += 1 + sovParams(uint64(m.BacklogThreshold))
+	}
+	if m.UpdateInterval != 0 {
+		n += 1 + sovParams(uint64(m.UpdateInterval))
+	}
+	if m.TimeoutInterval != 0 {
+		n += 1 + sovParams(uint64(m.TimeoutInterval))
+	}
+	if m.DecentralizationReportInterval != 0 {
+		n += 1 + sovParams(uint64(m.DecentralizationReportInterval))
+	}
+	if m.ConcentrationTopN != 0 {
+		n += 1 + sovParams(uint64(m.ConcentrationTopN))
+	}
+	if m.ConnectivityReportInterval != 0 {
+		n += 1 + sovParams(uint64(m.ConnectivityReportInterval))
+	}
+	if m.ValidatorSetChangeThreshold != 0 {
+		n += 1 + sovParams(uint64(m.ValidatorSetChangeThreshold))
+	}
+	if m.HeartbeatOnUpgradePlan {
+		n += 2
+	}
+	if m.HeartbeatOnParamsChange {
+		n += 2
+	}
+	if len(m.ChannelIntervals) > 0 {
+		for _, e := range m.ChannelIntervals {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedRegistryChains) > 0 {
+		for _, s := range m.AllowedRegistryChains {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedConnections) > 0 {
+		for _, s := range m.AllowedConnections {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxConnectivityChannels != 0 {
+		n += 1 + sovParams(uint64(m.MaxConnectivityChannels))
+	}
+	if m.MaxIndicatorsPerReporter != 0 {
+		n += 2 + sovParams(uint64(m.MaxIndicatorsPerReporter))
+	}
+	if m.MaxIndicators != 0 {
+		n += 2 + sovParams(uint64(m.MaxIndicators))
+	}
+	if m.RegistryClientFreshness != 0 {
+		n += 2 + sovParams(uint64(m.RegistryClientFreshness))
+	}
 	return n
 }
 
@@ -158,6 +534,392 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryLogSize", wireType)
+			}
+			m.DeliveryLogSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveryLogSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LatestOnly = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogThreshold", wireType)
+			}
+			m.BacklogThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInterval", wireType)
+			}
+			m.UpdateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutInterval", wireType)
+			}
+			m.TimeoutInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecentralizationReportInterval", wireType)
+			}
+			m.DecentralizationReportInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecentralizationReportInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentrationTopN", wireType)
+			}
+			m.ConcentrationTopN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcentrationTopN |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectivityReportInterval", wireType)
+			}
+			m.ConnectivityReportInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectivityReportInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetChangeThreshold", wireType)
+			}
+			m.ValidatorSetChangeThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetChangeThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatOnUpgradePlan", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HeartbeatOnUpgradePlan = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatOnParamsChange", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HeartbeatOnParamsChange = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIntervals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelIntervals = append(m.ChannelIntervals, ChannelIntervals{})
+			if err := m.ChannelIntervals[len(m.ChannelIntervals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRegistryChains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRegistryChains = append(m.AllowedRegistryChains, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedConnections", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedConnections = append(m.AllowedConnections, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConnectivityChannels", wireType)
+			}
+			m.MaxConnectivityChannels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConnectivityChannels |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIndicatorsPerReporter", wireType)
+			}
+			m.MaxIndicatorsPerReporter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIndicatorsPerReporter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIndicators", wireType)
+			}
+			m.MaxIndicators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIndicators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryClientFreshness", wireType)
+			}
+			m.RegistryClientFreshness = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistryClientFreshness |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,410 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcheck/monitored/participation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SigningParticipation describes how the active validators participated in signing the last commit
+type SigningParticipation struct {
+	SignedVotingPower uint64 `protobuf:"varint,1,opt,name=signedVotingPower,proto3" json:"signedVotingPower,omitempty"`
+	TotalVotingPower  uint64 `protobuf:"varint,2,opt,name=totalVotingPower,proto3" json:"totalVotingPower,omitempty"`
+	ValidatorCount    uint64 `protobuf:"varint,3,opt,name=validatorCount,proto3" json:"validatorCount,omitempty"`
+	Height            uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SigningParticipation) Reset()         { *m = SigningParticipation{} }
+func (m *SigningParticipation) String() string { return proto.CompactTextString(m) }
+func (*SigningParticipation) ProtoMessage()    {}
+func (*SigningParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6758e5a263470a93, []int{0}
+}
+func (m *SigningParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningParticipation.Merge(m, src)
+}
+func (m *SigningParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningParticipation proto.InternalMessageInfo
+
+func (m *SigningParticipation) GetSignedVotingPower() uint64 {
+	if m != nil {
+		return m.SignedVotingPower
+	}
+	return 0
+}
+
+func (m *SigningParticipation) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *SigningParticipation) GetValidatorCount() uint64 {
+	if m != nil {
+		return m.ValidatorCount
+	}
+	return 0
+}
+
+func (m *SigningParticipation) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SigningParticipation)(nil), "healthcheck.monitored.SigningParticipation")
+}
+
+func init() {
+	proto.RegisterFile("healthcheck/monitored/participation.proto", fileDescriptor_6758e5a263470a93)
+}
+
+var fileDescriptor_6758e5a263470a93 = []byte{
+	// 216 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x48, 0x4d, 0xcc,
+	0x29, 0xc9, 0x48, 0xce, 0x48, 0x4d, 0xce, 0xd6, 0xcf, 0xcd, 0xcf, 0xcb, 0x2c, 0xc9, 0x2f, 0x4a,
+	0x4d, 0xd1, 0x2f, 0x48, 0x2c, 0x2a, 0xc9, 0x4c, 0xce, 0x2c, 0x48, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
+	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x45, 0x52, 0xaa, 0x07, 0x57, 0xaa, 0xb4, 0x81, 0x91,
+	0x4b, 0x24, 0x38, 0x33, 0x3d, 0x2f, 0x33, 0x2f, 0x3d, 0x00, 0x59, 0x97, 0x90, 0x0e, 0x97, 0x60,
+	0x71, 0x66, 0x7a, 0x5e, 0x6a, 0x4a, 0x58, 0x7e, 0x09, 0x48, 0x32, 0xbf, 0x3c, 0xb5, 0x48, 0x82,
+	0x51, 0x81, 0x51, 0x83, 0x25, 0x08, 0x53, 0x42, 0x48, 0x8b, 0x4b, 0xa0, 0x24, 0xbf, 0x24, 0x31,
+	0x07, 0x59, 0x31, 0x13, 0x58, 0x31, 0x86, 0xb8, 0x90, 0x1a, 0x17, 0x5f, 0x59, 0x62, 0x4e, 0x66,
+	0x4a, 0x62, 0x49, 0x7e, 0x91, 0x73, 0x7e, 0x69, 0x5e, 0x89, 0x04, 0x33, 0x58, 0x25, 0x9a, 0xa8,
+	0x90, 0x18, 0x17, 0x5b, 0x46, 0x6a, 0x66, 0x7a, 0x46, 0x89, 0x04, 0x0b, 0x58, 0x1e, 0xca, 0x73,
+	0x32, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
+	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x59, 0xe4, 0xe0, 0xa8,
+	0x40, 0x0a, 0x90, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x48, 0x18, 0x03, 0x06, 0x00,
+	0xec, 0xd2, 0x52, 0xbd, 0x36, 0x01, 0x00, 0x00,
+}
+
+func (m *SigningParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ValidatorCount != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.ValidatorCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignedVotingPower != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.SignedVotingPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParticipation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParticipation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SigningParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedVotingPower != 0 {
+		n += 1 + sovParticipation(uint64(m.SignedVotingPower))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovParticipation(uint64(m.TotalVotingPower))
+	}
+	if m.ValidatorCount != 0 {
+		n += 1 + sovParticipation(uint64(m.ValidatorCount))
+	}
+	if m.Height != 0 {
+		n += 1 + sovParticipation(uint64(m.Height))
+	}
+	return n
+}
+
+func sovParticipation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParticipation(x uint64) (n int) {
+	return sovParticipation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SigningParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedVotingPower", wireType)
+			}
+			m.SignedVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+			}
+			m.ValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParticipation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParticipation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParticipation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParticipation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParticipation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParticipation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParticipation = fmt.Errorf("proto: unexpected end of group")
+)
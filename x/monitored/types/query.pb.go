@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -14,6 +14,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	types "healthcheck/x/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -113,212 +114,2419 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "healthcheck.monitored.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "healthcheck.monitored.QueryParamsResponse")
+type QueryLastHealthcheckAckRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
 }
 
-func init() { proto.RegisterFile("healthcheck/monitored/query.proto", fileDescriptor_613cb4511e88ad2f) }
+func (m *QueryLastHealthcheckAckRequest) Reset()         { *m = QueryLastHealthcheckAckRequest{} }
+func (m *QueryLastHealthcheckAckRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastHealthcheckAckRequest) ProtoMessage()    {}
+func (*QueryLastHealthcheckAckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_613cb4511e88ad2f, []int{2}
+}
+func (m *QueryLastHealthcheckAckRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastHealthcheckAckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastHealthcheckAckRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastHealthcheckAckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastHealthcheckAckRequest.Merge(m, src)
+}
+func (m *QueryLastHealthcheckAckRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastHealthcheckAckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastHealthcheckAckRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_613cb4511e88ad2f = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0x31, 0x4b, 0xc4, 0x30,
-	0x18, 0x86, 0x1b, 0xd1, 0x0e, 0x71, 0x8b, 0x27, 0x48, 0xb1, 0x39, 0x2d, 0x08, 0x7a, 0x43, 0xc3,
-	0x9d, 0x83, 0x83, 0xdb, 0xfd, 0x02, 0xed, 0xe8, 0x96, 0xd6, 0xd0, 0x16, 0xaf, 0xf9, 0x72, 0x4d,
-	0x4e, 0xbc, 0xd5, 0xc1, 0xf9, 0xc0, 0x3f, 0x75, 0xe3, 0x81, 0x8b, 0x93, 0x48, 0xeb, 0x0f, 0x91,
-	0x6b, 0x8a, 0x54, 0x3c, 0xc5, 0x2d, 0x7c, 0x79, 0x9e, 0x37, 0x6f, 0x3e, 0x7c, 0x9c, 0x09, 0x3e,
-	0x31, 0x59, 0x92, 0x89, 0xe4, 0x8e, 0x15, 0x20, 0x73, 0x03, 0xa5, 0xb8, 0x65, 0xd3, 0x99, 0x28,
-	0xe7, 0xa1, 0x2a, 0xc1, 0x00, 0xd9, 0xef, 0x20, 0xe1, 0x17, 0xe2, 0xf5, 0x52, 0x48, 0xa1, 0x21,
-	0xd8, 0xfa, 0x64, 0x61, 0xef, 0x30, 0x05, 0x48, 0x27, 0x82, 0x71, 0x95, 0x33, 0x2e, 0x25, 0x18,
-	0x6e, 0x72, 0x90, 0xba, 0xbd, 0x1d, 0x24, 0xa0, 0x0b, 0xd0, 0x2c, 0xe6, 0x5a, 0xd8, 0x37, 0xd8,
-	0xfd, 0x30, 0x16, 0x86, 0x0f, 0x99, 0xe2, 0x69, 0x2e, 0x1b, 0xb8, 0x65, 0x83, 0xcd, 0xcd, 0x14,
-	0x2f, 0x79, 0xd1, 0xe6, 0x05, 0x3d, 0x4c, 0xae, 0xd7, 0x29, 0x57, 0xcd, 0x30, 0x12, 0xd3, 0x99,
-	0xd0, 0x26, 0x88, 0xf0, 0xde, 0xb7, 0xa9, 0x56, 0x20, 0xb5, 0x20, 0x97, 0xd8, 0xb5, 0xf2, 0x01,
-	0x3a, 0x42, 0xa7, 0xbb, 0x23, 0x3f, 0xdc, 0xf8, 0xb1, 0xd0, 0x6a, 0xe3, 0xed, 0xe5, 0x5b, 0xdf,
-	0x89, 0x5a, 0x65, 0xb4, 0x40, 0x78, 0xa7, 0x09, 0x25, 0x4f, 0x08, 0xbb, 0x16, 0x21, 0x67, 0xbf,
-	0x24, 0xfc, 0xec, 0xe4, 0x0d, 0xfe, 0x83, 0xda, 0xa2, 0xc1, 0xc9, 0xe3, 0xcb, 0xc7, 0xf3, 0x56,
-	0x9f, 0xf8, 0xec, 0xaf, 0x15, 0x8c, 0x2f, 0x96, 0x15, 0x45, 0xab, 0x8a, 0xa2, 0xf7, 0x8a, 0xa2,
-	0x45, 0x4d, 0x9d, 0x55, 0x4d, 0x9d, 0xd7, 0x9a, 0x3a, 0x37, 0x7e, 0xd7, 0x7b, 0xe8, 0x98, 0x66,
-	0xae, 0x84, 0x8e, 0xdd, 0x66, 0x79, 0xe7, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x7c, 0x7c,
-	0x31, 0xfc, 0x01, 0x00, 0x00,
+var xxx_messageInfo_QueryLastHealthcheckAckRequest proto.InternalMessageInfo
+
+func (m *QueryLastHealthcheckAckRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+type QueryLastHealthcheckAckResponse struct {
+	HealthcheckAck types.HealthcheckAck `protobuf:"bytes,1,opt,name=healthcheckAck,proto3" json:"healthcheckAck"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryLastHealthcheckAckResponse) Reset()         { *m = QueryLastHealthcheckAckResponse{} }
+func (m *QueryLastHealthcheckAckResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastHealthcheckAckResponse) ProtoMessage()    {}
+func (*QueryLastHealthcheckAckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_613cb4511e88ad2f, []int{3}
+}
+func (m *QueryLastHealthcheckAckResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastHealthcheckAckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastHealthcheckAckResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastHealthcheckAckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastHealthcheckAckResponse.Merge(m, src)
+}
+func (m *QueryLastHealthcheckAckResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastHealthcheckAckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastHealthcheckAckResponse.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryLastHealthcheckAckResponse proto.InternalMessageInfo
+
+func (m *QueryLastHealthcheckAckResponse) GetHealthcheckAck() types.HealthcheckAck {
+	if m != nil {
+		return m.HealthcheckAck
+	}
+	return types.HealthcheckAck{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryHealthcheckStateRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryHealthcheckStateRequest) Reset()         { *m = QueryHealthcheckStateRequest{} }
+func (m *QueryHealthcheckStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthcheckStateRequest) ProtoMessage()    {}
+func (*QueryHealthcheckStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_613cb4511e88ad2f, []int{4}
+}
+func (m *QueryHealthcheckStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthcheckStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthcheckStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthcheckStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthcheckStateRequest.Merge(m, src)
+}
+func (m *QueryHealthcheckStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthcheckStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthcheckStateRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/healthcheck.monitored.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHealthcheckStateRequest proto.InternalMessageInfo
+
+func (m *QueryHealthcheckStateRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+type QueryHealthcheckStateResponse struct {
+	RegistryChainChannelId string         `protobuf:"bytes,1,opt,name=registryChainChannelId,proto3" json:"registryChainChannelId,omitempty"`
+	ChannelState           string         `protobuf:"bytes,2,opt,name=channelState,proto3" json:"channelState,omitempty"`
+	LastUpdateHeight       uint64         `protobuf:"varint,3,opt,name=lastUpdateHeight,proto3" json:"lastUpdateHeight,omitempty"`
+	NextUpdateHeight       uint64         `protobuf:"varint,4,opt,name=nextUpdateHeight,proto3" json:"nextUpdateHeight,omitempty"`
+	LastDeliveryResult     DeliveryResult `protobuf:"bytes,5,opt,name=lastDeliveryResult,proto3" json:"lastDeliveryResult"`
+	ConsecutiveFailures    uint64         `protobuf:"varint,6,opt,name=consecutiveFailures,proto3" json:"consecutiveFailures,omitempty"`
+	PendingSequences       []uint64       `protobuf:"varint,7,rep,packed,name=pendingSequences,proto3" json:"pendingSequences,omitempty"`
+	BacklogSize            uint64         `protobuf:"varint,8,opt,name=backlogSize,proto3" json:"backlogSize,omitempty"`
+	SkippedUpdates         uint64         `protobuf:"varint,9,opt,name=skippedUpdates,proto3" json:"skippedUpdates,omitempty"`
+	Features               []string       `protobuf:"bytes,10,rep,name=features,proto3" json:"features,omitempty"`
+	UpdateInterval         uint64         `protobuf:"varint,11,opt,name=updateInterval,proto3" json:"updateInterval,omitempty"`
+	TimeoutInterval        uint64         `protobuf:"varint,12,opt,name=timeoutInterval,proto3" json:"timeoutInterval,omitempty"`
+	RegistryStatus         RegistryStatus `protobuf:"bytes,13,opt,name=registryStatus,proto3" json:"registryStatus"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryHealthcheckStateResponse) Reset()         { *m = QueryHealthcheckStateResponse{} }
+func (m *QueryHealthcheckStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthcheckStateResponse) ProtoMessage()    {}
+func (*QueryHealthcheckStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_613cb4511e88ad2f, []int{5}
+}
+func (m *QueryHealthcheckStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthcheckStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthcheckStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthcheckStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthcheckStateResponse.Merge(m, src)
+}
+func (m *QueryHealthcheckStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthcheckStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthcheckStateResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryHealthcheckStateResponse proto.InternalMessageInfo
+
+func (m *QueryHealthcheckStateResponse) GetRegistryChainChannelId() string {
+	if m != nil {
+		return m.RegistryChainChannelId
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryHealthcheckStateResponse) GetChannelState() string {
+	if m != nil {
+		return m.ChannelState
+	}
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryHealthcheckStateResponse) GetLastUpdateHeight() uint64 {
+	if m != nil {
+		return m.LastUpdateHeight
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return 0
+}
+
+func (m *QueryHealthcheckStateResponse) GetNextUpdateHeight() uint64 {
+	if m != nil {
+		return m.NextUpdateHeight
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/healthcheck.monitored.Query/Params",
+	return 0
+}
+
+func (m *QueryHealthcheckStateResponse) GetLastDeliveryResult() DeliveryResult {
+	if m != nil {
+		return m.LastDeliveryResult
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return DeliveryResult{}
+}
+
+func (m *QueryHealthcheckStateResponse) GetConsecutiveFailures() uint64 {
+	if m != nil {
+		return m.ConsecutiveFailures
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "healthcheck.monitored.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "healthcheck/monitored/query.proto",
+func (m *QueryHealthcheckStateResponse) GetPendingSequences() []uint64 {
+	if m != nil {
+		return m.PendingSequences
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHealthcheckStateResponse) GetBacklogSize() uint64 {
+	if m != nil {
+		return m.BacklogSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHealthcheckStateResponse) GetSkippedUpdates() uint64 {
+	if m != nil {
+		return m.SkippedUpdates
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryHealthcheckStateResponse) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHealthcheckStateResponse) GetUpdateInterval() uint64 {
+	if m != nil {
+		return m.UpdateInterval
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHealthcheckStateResponse) GetTimeoutInterval() uint64 {
+	if m != nil {
+		return m.TimeoutInterval
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryHealthcheckStateResponse) GetRegistryStatus() RegistryStatus {
+	if m != nil {
+		return m.RegistryStatus
+	}
+	return RegistryStatus{}
+}
+
+type QueryRegistryChannelsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRegistryChannelsRequest) Reset()         { *m = QueryRegistryChannelsRequest{} }
+func (m *QueryRegistryChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegistryChannelsRequest) ProtoMessage()    {}
+func (*QueryRegistryChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_613cb4511e88ad2f, []int{6}
+}
+func (m *QueryRegistryChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegistryChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegistryChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegistryChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegistryChannelsRequest.Merge(m, src)
+}
+func (m *QueryRegistryChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegistryChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegistryChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegistryChannelsRequest proto.InternalMessageInfo
+
+func (m *QueryRegistryChannelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRegistryChannelsResponse struct {
+	RegistryChannel []RegistryChannel   `protobuf:"bytes,1,rep,name=registryChannel,proto3" json:"registryChannel"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRegistryChannelsResponse) Reset()         { *m = QueryRegistryChannelsResponse{} }
+func (m *QueryRegistryChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegistryChannelsResponse) ProtoMessage()    {}
+func (*QueryRegistryChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_613cb4511e88ad2f, []int{7}
+}
+func (m *QueryRegistryChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegistryChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegistryChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegistryChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegistryChannelsResponse.Merge(m, src)
+}
+func (m *QueryRegistryChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegistryChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegistryChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegistryChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryRegistryChannelsResponse) GetRegistryChannel() []RegistryChannel {
+	if m != nil {
+		return m.RegistryChannel
+	}
+	return nil
+}
+
+func (m *QueryRegistryChannelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryDeliveryLogRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeliveryLogRequest) Reset()         { *m = QueryDeliveryLogRequest{} }
+func (m *QueryDeliveryLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeliveryLogRequest) ProtoMessage()    {}
+func (*QueryDeliveryLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_613cb4511e88ad2f, []int{8}
+}
+func (m *QueryDeliveryLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeliveryLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeliveryLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeliveryLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeliveryLogRequest.Merge(m, src)
+}
+func (m *QueryDeliveryLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeliveryLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeliveryLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeliveryLogRequest proto.InternalMessageInfo
+
+func (m *QueryDeliveryLogRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryDeliveryLogResponse struct {
+	DeliveryRecord []DeliveryRecord    `protobuf:"bytes,1,rep,name=deliveryRecord,proto3" json:"deliveryRecord"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeliveryLogResponse) Reset()         { *m = QueryDeliveryLogResponse{} }
+func (m *QueryDeliveryLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeliveryLogResponse) ProtoMessage()    {}
+func (*QueryDeliveryLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_613cb4511e88ad2f, []int{9}
+}
+func (m *QueryDeliveryLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeliveryLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeliveryLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeliveryLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeliveryLogResponse.Merge(m, src)
+}
+func (m *QueryDeliveryLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeliveryLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeliveryLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeliveryLogResponse proto.InternalMessageInfo
+
+func (m *QueryDeliveryLogResponse) GetDeliveryRecord() []DeliveryRecord {
+	if m != nil {
+		return m.DeliveryRecord
+	}
+	return nil
+}
+
+func (m *QueryDeliveryLogResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "healthcheck.monitored.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "healthcheck.monitored.QueryParamsResponse")
+	proto.RegisterType((*QueryLastHealthcheckAckRequest)(nil), "healthcheck.monitored.QueryLastHealthcheckAckRequest")
+	proto.RegisterType((*QueryLastHealthcheckAckResponse)(nil), "healthcheck.monitored.QueryLastHealthcheckAckResponse")
+	proto.RegisterType((*QueryHealthcheckStateRequest)(nil), "healthcheck.monitored.QueryHealthcheckStateRequest")
+	proto.RegisterType((*QueryHealthcheckStateResponse)(nil), "healthcheck.monitored.QueryHealthcheckStateResponse")
+	proto.RegisterType((*QueryRegistryChannelsRequest)(nil), "healthcheck.monitored.QueryRegistryChannelsRequest")
+	proto.RegisterType((*QueryRegistryChannelsResponse)(nil), "healthcheck.monitored.QueryRegistryChannelsResponse")
+	proto.RegisterType((*QueryDeliveryLogRequest)(nil), "healthcheck.monitored.QueryDeliveryLogRequest")
+	proto.RegisterType((*QueryDeliveryLogResponse)(nil), "healthcheck.monitored.QueryDeliveryLogResponse")
+}
+
+func init() { proto.RegisterFile("healthcheck/monitored/query.proto", fileDescriptor_613cb4511e88ad2f) }
+
+var fileDescriptor_613cb4511e88ad2f = []byte{
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0xd7, 0xfb, 0x23, 0x74, 0xdf, 0x96, 0xed, 0x6a, 0x5a, 0xc0, 0xb2, 0x36, 0xde, 0xd4,
+	0xb0, 0x25, 0x2c, 0xc8, 0xee, 0x8f, 0x65, 0x2b, 0x44, 0x05, 0xa2, 0x45, 0xa5, 0x95, 0x2a, 0x51,
+	0x1c, 0xc1, 0x01, 0x0e, 0xd1, 0xc4, 0x9e, 0x3a, 0x56, 0x1c, 0x8f, 0xeb, 0x19, 0x47, 0x5d, 0x10,
+	0x17, 0x0e, 0x9c, 0x91, 0x38, 0xf6, 0x8f, 0xe0, 0x8a, 0xc4, 0x85, 0x03, 0x87, 0x1e, 0x2b, 0x71,
+	0xe1, 0x84, 0xd0, 0x2e, 0xfc, 0x1f, 0xc8, 0xe3, 0x71, 0x62, 0x3b, 0xb1, 0x9b, 0x95, 0x7a, 0x4b,
+	0xde, 0x7c, 0xdf, 0x7b, 0x9f, 0xf7, 0x3c, 0xf3, 0x66, 0xe0, 0xf2, 0x90, 0xe0, 0x80, 0x0f, 0x9d,
+	0x21, 0x71, 0x46, 0xd6, 0x98, 0x86, 0x3e, 0xa7, 0x31, 0x71, 0xad, 0xc7, 0x09, 0x89, 0x8f, 0xcd,
+	0x28, 0xa6, 0x9c, 0xa2, 0xd7, 0x0a, 0x12, 0x73, 0x2a, 0xd1, 0x2e, 0x79, 0xd4, 0xa3, 0x42, 0x61,
+	0xa5, 0xbf, 0x32, 0xb1, 0xb6, 0xeb, 0x51, 0xea, 0x05, 0xc4, 0xc2, 0x91, 0x6f, 0xe1, 0x30, 0xa4,
+	0x1c, 0x73, 0x9f, 0x86, 0x4c, 0xae, 0x1e, 0x38, 0x94, 0x8d, 0x29, 0xb3, 0x06, 0x98, 0x91, 0x2c,
+	0x87, 0x35, 0xb9, 0x36, 0x20, 0x1c, 0x5f, 0xb3, 0x22, 0xec, 0xf9, 0xa1, 0x10, 0x4b, 0xad, 0xb1,
+	0x98, 0x2c, 0xc2, 0x31, 0x1e, 0xe7, 0xf1, 0xde, 0x5a, 0xac, 0x71, 0x49, 0xe0, 0x4f, 0xa6, 0x05,
+	0x68, 0xef, 0x2d, 0x56, 0xc5, 0xc4, 0xf3, 0x19, 0x8f, 0x8f, 0xfb, 0xce, 0x10, 0x87, 0x21, 0x09,
+	0xa4, 0x5a, 0x2f, 0xaa, 0xf9, 0x71, 0x44, 0x98, 0x15, 0x61, 0x67, 0x44, 0x78, 0xb6, 0x6e, 0x5c,
+	0x02, 0xf4, 0x45, 0x4a, 0xfe, 0x50, 0x80, 0xd8, 0xe4, 0x71, 0x42, 0x18, 0x37, 0x6c, 0xb8, 0x58,
+	0xb2, 0xb2, 0x88, 0x86, 0x8c, 0xa0, 0x0f, 0xa1, 0x95, 0x01, 0xab, 0x4a, 0x47, 0xe9, 0x6e, 0x5d,
+	0x6f, 0x9b, 0x0b, 0x9b, 0x69, 0x66, 0x6e, 0xb7, 0xd7, 0x9f, 0xfd, 0xbd, 0xb7, 0x62, 0x4b, 0x17,
+	0xe3, 0x23, 0xd0, 0x45, 0xcc, 0x07, 0x98, 0xf1, 0x7b, 0x33, 0xb7, 0x4f, 0x9c, 0x91, 0xcc, 0x8a,
+	0x76, 0x61, 0x53, 0xc2, 0xdf, 0x77, 0x45, 0x86, 0x4d, 0x7b, 0x66, 0x30, 0x62, 0xd8, 0xab, 0xf5,
+	0x97, 0x7c, 0x9f, 0xc3, 0xf6, 0xb0, 0xb4, 0x22, 0x39, 0x2f, 0x97, 0x38, 0x45, 0x17, 0xcc, 0x72,
+	0x08, 0xc9, 0x5a, 0x71, 0x37, 0x6e, 0xc1, 0xae, 0xc8, 0x59, 0x10, 0xf7, 0x38, 0xe6, 0x64, 0x39,
+	0xe2, 0xa7, 0x1b, 0xd0, 0xae, 0x71, 0x97, 0xc0, 0x47, 0xf0, 0x7a, 0xfe, 0xdd, 0xee, 0x0c, 0xb1,
+	0x1f, 0xde, 0xa9, 0x04, 0xab, 0x59, 0x45, 0x06, 0x9c, 0x97, 0x69, 0x44, 0x3c, 0x75, 0x55, 0xa8,
+	0x4b, 0x36, 0x74, 0x00, 0x3b, 0x01, 0x66, 0xfc, 0xcb, 0xc8, 0xc5, 0x9c, 0xdc, 0x23, 0xbe, 0x37,
+	0xe4, 0xea, 0x5a, 0x47, 0xe9, 0xae, 0xdb, 0x73, 0xf6, 0x54, 0x1b, 0x92, 0x27, 0x65, 0xed, 0x7a,
+	0xa6, 0xad, 0xda, 0xd1, 0x37, 0x80, 0x52, 0xff, 0x4f, 0xe5, 0xae, 0xb4, 0x09, 0x4b, 0x02, 0xae,
+	0x6e, 0x88, 0x46, 0xef, 0xd7, 0x6c, 0x88, 0xb2, 0x58, 0x36, 0x7b, 0x41, 0x18, 0x74, 0x15, 0x2e,
+	0x3a, 0x69, 0x67, 0x9c, 0x84, 0xfb, 0x13, 0x72, 0x17, 0xfb, 0x41, 0x12, 0x13, 0xa6, 0xb6, 0x04,
+	0xcb, 0xa2, 0xa5, 0x14, 0x3d, 0x22, 0xa1, 0xeb, 0x87, 0x5e, 0x2f, 0xfd, 0x28, 0xa1, 0x43, 0x98,
+	0xfa, 0x4a, 0x67, 0x2d, 0x45, 0xaf, 0xda, 0x51, 0x07, 0xb6, 0x06, 0xd8, 0x19, 0x05, 0xd4, 0xeb,
+	0xf9, 0xdf, 0x12, 0xf5, 0x9c, 0x88, 0x5a, 0x34, 0xa1, 0x2b, 0xb0, 0xcd, 0x46, 0x7e, 0x14, 0x11,
+	0x37, 0xab, 0x99, 0xa9, 0x9b, 0x42, 0x54, 0xb1, 0x22, 0x0d, 0xce, 0x3d, 0x22, 0x98, 0x0b, 0x38,
+	0xe8, 0xac, 0x75, 0x37, 0xed, 0xe9, 0xff, 0x34, 0x46, 0x22, 0x64, 0xf7, 0x43, 0x4e, 0xe2, 0x09,
+	0x0e, 0xd4, 0xad, 0x2c, 0x46, 0xd9, 0x8a, 0xba, 0x70, 0x81, 0xfb, 0x63, 0x42, 0x13, 0x3e, 0x15,
+	0x9e, 0x17, 0xc2, 0xaa, 0x19, 0xf5, 0x60, 0x3b, 0xdf, 0x08, 0xe9, 0xb7, 0x4d, 0x98, 0xfa, 0x6a,
+	0x63, 0xbb, 0xed, 0x92, 0x38, 0xdf, 0xdb, 0xe5, 0x10, 0xc6, 0x23, 0xb9, 0xb7, 0xed, 0xd9, 0x16,
+	0x4b, 0x37, 0x4f, 0x3e, 0x03, 0xd0, 0x5d, 0x80, 0xd9, 0x14, 0x93, 0x07, 0xe9, 0x8a, 0x99, 0x8d,
+	0x3c, 0x33, 0x1d, 0x79, 0x66, 0x36, 0x56, 0xe5, 0xc8, 0x33, 0x1f, 0x62, 0x2f, 0x3f, 0x17, 0x76,
+	0xc1, 0xd3, 0xf8, 0x5d, 0x81, 0x76, 0x4d, 0x22, 0x79, 0x0a, 0xbe, 0x82, 0x0b, 0x71, 0x79, 0x4d,
+	0x55, 0x3a, 0x6b, 0x22, 0x5d, 0x73, 0x7d, 0x52, 0x2d, 0x0b, 0xac, 0x06, 0x41, 0x9f, 0x95, 0x2a,
+	0x58, 0x15, 0x15, 0xbc, 0xfd, 0xc2, 0x0a, 0x32, 0xa8, 0x52, 0x09, 0x18, 0xde, 0x10, 0x15, 0xe4,
+	0x9b, 0xf5, 0x01, 0xf5, 0x5e, 0x76, 0x97, 0x7e, 0x55, 0x40, 0x9d, 0xcf, 0x21, 0x1b, 0xd4, 0x83,
+	0x6d, 0x77, 0x7a, 0x4e, 0x1c, 0x1a, 0xbb, 0xb2, 0x3f, 0x2f, 0x3e, 0x6e, 0xa9, 0x38, 0xff, 0xfe,
+	0xe5, 0x10, 0x2f, 0xad, 0x3b, 0xd7, 0xff, 0x6b, 0xc1, 0x86, 0x40, 0x47, 0x3f, 0x2a, 0xd0, 0xca,
+	0x66, 0x3f, 0x7a, 0xa7, 0x06, 0x6d, 0xfe, 0xb2, 0xd1, 0x0e, 0x96, 0x91, 0x66, 0x79, 0x8d, 0xfd,
+	0x1f, 0xfe, 0xfc, 0xf7, 0xe7, 0xd5, 0x3d, 0xd4, 0xb6, 0x9a, 0xee, 0x53, 0xf4, 0x87, 0x02, 0x68,
+	0xfe, 0x9e, 0x40, 0xef, 0x37, 0x65, 0xaa, 0xbd, 0x97, 0xb4, 0xa3, 0xb3, 0xba, 0x49, 0xd8, 0x8f,
+	0x05, 0xec, 0x07, 0xe8, 0x66, 0x0d, 0x6c, 0x3a, 0xff, 0xfa, 0x85, 0xa5, 0x3e, 0x76, 0x46, 0xd6,
+	0x77, 0xd3, 0xfb, 0xe3, 0x7b, 0xf4, 0x9b, 0x02, 0x3b, 0xd5, 0xbb, 0x03, 0xdd, 0x68, 0xa2, 0xa9,
+	0xb9, 0xa8, 0xb4, 0xc3, 0xb3, 0x39, 0xc9, 0x02, 0x6e, 0x89, 0x02, 0x8e, 0xd0, 0x61, 0x4d, 0x01,
+	0x45, 0x76, 0x96, 0x7a, 0x96, 0xe8, 0x7f, 0x51, 0x60, 0xa7, 0x7a, 0xe6, 0x9b, 0xe9, 0x6b, 0x46,
+	0x91, 0x76, 0x78, 0x36, 0x27, 0x49, 0x7f, 0x55, 0xd0, 0x1f, 0xa0, 0xae, 0xb5, 0xdc, 0x8b, 0x89,
+	0xa1, 0xa7, 0x0a, 0x6c, 0x15, 0xce, 0x1f, 0x32, 0x9b, 0xf2, 0xce, 0x0f, 0x03, 0xcd, 0x5a, 0x5a,
+	0x2f, 0x11, 0xdf, 0x15, 0x88, 0xfb, 0xe8, 0x4d, 0xab, 0xf9, 0xe9, 0xd7, 0x0f, 0xa8, 0x77, 0xfb,
+	0xe6, 0xb3, 0x13, 0x5d, 0x79, 0x7e, 0xa2, 0x2b, 0xff, 0x9c, 0xe8, 0xca, 0x4f, 0xa7, 0xfa, 0xca,
+	0xf3, 0x53, 0x7d, 0xe5, 0xaf, 0x53, 0x7d, 0xe5, 0xeb, 0x76, 0xd1, 0xfb, 0x49, 0xc1, 0x5f, 0x3c,
+	0x75, 0x06, 0x2d, 0xf1, 0xd4, 0xbb, 0xf1, 0xff, 0x00, 0xd1, 0xf4, 0xe5, 0xb5, 0x1e, 0x0b, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// LastHealthcheckAck queries the last healthcheck update acknowledgement received through a registry channel.
+	LastHealthcheckAck(ctx context.Context, in *QueryLastHealthcheckAckRequest, opts ...grpc.CallOption) (*QueryLastHealthcheckAckResponse, error)
+	// HealthcheckState queries the local state of healthcheck updates sent through a registry channel.
+	HealthcheckState(ctx context.Context, in *QueryHealthcheckStateRequest, opts ...grpc.CallOption) (*QueryHealthcheckStateResponse, error)
+	// RegistryChannels queries the channels used to send healthcheck updates to registry chains.
+	RegistryChannels(ctx context.Context, in *QueryRegistryChannelsRequest, opts ...grpc.CallOption) (*QueryRegistryChannelsResponse, error)
+	// DeliveryLog queries the audit log of healthcheck update packets sent to the registry chain.
+	DeliveryLog(ctx context.Context, in *QueryDeliveryLogRequest, opts ...grpc.CallOption) (*QueryDeliveryLogResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.monitored.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastHealthcheckAck(ctx context.Context, in *QueryLastHealthcheckAckRequest, opts ...grpc.CallOption) (*QueryLastHealthcheckAckResponse, error) {
+	out := new(QueryLastHealthcheckAckResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.monitored.Query/LastHealthcheckAck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HealthcheckState(ctx context.Context, in *QueryHealthcheckStateRequest, opts ...grpc.CallOption) (*QueryHealthcheckStateResponse, error) {
+	out := new(QueryHealthcheckStateResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.monitored.Query/HealthcheckState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegistryChannels(ctx context.Context, in *QueryRegistryChannelsRequest, opts ...grpc.CallOption) (*QueryRegistryChannelsResponse, error) {
+	out := new(QueryRegistryChannelsResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.monitored.Query/RegistryChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeliveryLog(ctx context.Context, in *QueryDeliveryLogRequest, opts ...grpc.CallOption) (*QueryDeliveryLogResponse, error) {
+	out := new(QueryDeliveryLogResponse)
+	err := c.cc.Invoke(ctx, "/healthcheck.monitored.Query/DeliveryLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// LastHealthcheckAck queries the last healthcheck update acknowledgement received through a registry channel.
+	LastHealthcheckAck(context.Context, *QueryLastHealthcheckAckRequest) (*QueryLastHealthcheckAckResponse, error)
+	// HealthcheckState queries the local state of healthcheck updates sent through a registry channel.
+	HealthcheckState(context.Context, *QueryHealthcheckStateRequest) (*QueryHealthcheckStateResponse, error)
+	// RegistryChannels queries the channels used to send healthcheck updates to registry chains.
+	RegistryChannels(context.Context, *QueryRegistryChannelsRequest) (*QueryRegistryChannelsResponse, error)
+	// DeliveryLog queries the audit log of healthcheck update packets sent to the registry chain.
+	DeliveryLog(context.Context, *QueryDeliveryLogRequest) (*QueryDeliveryLogResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) LastHealthcheckAck(ctx context.Context, req *QueryLastHealthcheckAckRequest) (*QueryLastHealthcheckAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastHealthcheckAck not implemented")
+}
+func (*UnimplementedQueryServer) HealthcheckState(ctx context.Context, req *QueryHealthcheckStateRequest) (*QueryHealthcheckStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthcheckState not implemented")
+}
+func (*UnimplementedQueryServer) RegistryChannels(ctx context.Context, req *QueryRegistryChannelsRequest) (*QueryRegistryChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistryChannels not implemented")
+}
+func (*UnimplementedQueryServer) DeliveryLog(ctx context.Context, req *QueryDeliveryLogRequest) (*QueryDeliveryLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliveryLog not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.monitored.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastHealthcheckAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastHealthcheckAckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastHealthcheckAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.monitored.Query/LastHealthcheckAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastHealthcheckAck(ctx, req.(*QueryLastHealthcheckAckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HealthcheckState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHealthcheckStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HealthcheckState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.monitored.Query/HealthcheckState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HealthcheckState(ctx, req.(*QueryHealthcheckStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegistryChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegistryChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegistryChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.monitored.Query/RegistryChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegistryChannels(ctx, req.(*QueryRegistryChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeliveryLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeliveryLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeliveryLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcheck.monitored.Query/DeliveryLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeliveryLog(ctx, req.(*QueryDeliveryLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "healthcheck.monitored.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "LastHealthcheckAck",
+			Handler:    _Query_LastHealthcheckAck_Handler,
+		},
+		{
+			MethodName: "HealthcheckState",
+			Handler:    _Query_HealthcheckState_Handler,
+		},
+		{
+			MethodName: "RegistryChannels",
+			Handler:    _Query_RegistryChannels_Handler,
+		},
+		{
+			MethodName: "DeliveryLog",
+			Handler:    _Query_DeliveryLog_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "healthcheck/monitored/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastHealthcheckAckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastHealthcheckAckRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastHealthcheckAckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastHealthcheckAckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastHealthcheckAckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastHealthcheckAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HealthcheckAck.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHealthcheckStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthcheckStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthcheckStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHealthcheckStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthcheckStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthcheckStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RegistryStatus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.TimeoutInterval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeoutInterval))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.UpdateInterval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpdateInterval))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.SkippedUpdates != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SkippedUpdates))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BacklogSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BacklogSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PendingSequences) > 0 {
+		dAtA5 := make([]byte, len(m.PendingSequences)*10)
+		var j4 int
+		for _, num := range m.PendingSequences {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintQuery(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.LastDeliveryResult.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.NextUpdateHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextUpdateHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelState) > 0 {
+		i -= len(m.ChannelState)
+		copy(dAtA[i:], m.ChannelState)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelState)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RegistryChainChannelId) > 0 {
+		i -= len(m.RegistryChainChannelId)
+		copy(dAtA[i:], m.RegistryChainChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RegistryChainChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegistryChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegistryChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegistryChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegistryChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegistryChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegistryChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RegistryChannel) > 0 {
+		for iNdEx := len(m.RegistryChannel) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistryChannel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeliveryLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeliveryLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeliveryLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeliveryLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeliveryLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeliveryLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DeliveryRecord) > 0 {
+		for iNdEx := len(m.DeliveryRecord) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeliveryRecord[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLastHealthcheckAckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastHealthcheckAckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HealthcheckAck.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHealthcheckStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHealthcheckStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RegistryChainChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelState)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastUpdateHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdateHeight))
+	}
+	if m.NextUpdateHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextUpdateHeight))
+	}
+	l = m.LastDeliveryResult.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovQuery(uint64(m.ConsecutiveFailures))
+	}
+	if len(m.PendingSequences) > 0 {
+		l = 0
+		for _, e := range m.PendingSequences {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.BacklogSize != 0 {
+		n += 1 + sovQuery(uint64(m.BacklogSize))
+	}
+	if m.SkippedUpdates != 0 {
+		n += 1 + sovQuery(uint64(m.SkippedUpdates))
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.UpdateInterval != 0 {
+		n += 1 + sovQuery(uint64(m.UpdateInterval))
+	}
+	if m.TimeoutInterval != 0 {
+		n += 1 + sovQuery(uint64(m.TimeoutInterval))
+	}
+	l = m.RegistryStatus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRegistryChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegistryChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RegistryChannel) > 0 {
+		for _, e := range m.RegistryChannel {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeliveryLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeliveryLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeliveryRecord) > 0 {
+		for _, e := range m.DeliveryRecord {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastHealthcheckAckRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastHealthcheckAckRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastHealthcheckAckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastHealthcheckAckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastHealthcheckAckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastHealthcheckAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthcheckAck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HealthcheckAck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHealthcheckStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthcheckStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthcheckStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHealthcheckStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthcheckStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthcheckStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryChainChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistryChainChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
+			}
+			m.LastUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUpdateHeight", wireType)
+			}
+			m.NextUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextUpdateHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDeliveryResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastDeliveryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PendingSequences = append(m.PendingSequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingSequences) == 0 {
+					m.PendingSequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingSequences = append(m.PendingSequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSequences", wireType)
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogSize", wireType)
+			}
+			m.BacklogSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedUpdates", wireType)
+			}
+			m.SkippedUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInterval", wireType)
+			}
+			m.UpdateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutInterval", wireType)
+			}
+			m.TimeoutInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegistryStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegistryChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegistryChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegistryChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRegistryChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegistryChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegistryChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistryChannel = append(m.RegistryChannel, RegistryChannel{})
+			if err := m.RegistryChannel[len(m.RegistryChannel)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeliveryLogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -341,12 +2549,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeliveryLogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeliveryLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -368,7 +2612,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeliveryLogResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -391,15 +2635,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeliveryLogResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeliveryLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryRecord", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -426,7 +2670,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DeliveryRecord = append(m.DeliveryRecord, DeliveryRecord{})
+			if err := m.DeliveryRecord[len(m.DeliveryRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
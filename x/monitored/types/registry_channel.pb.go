@@ -0,0 +1,1048 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcheck/monitored/registry_channel.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "healthcheck/x/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RegistryChannel is an open channel used to send healthcheck updates to a registry chain
+type RegistryChannel struct {
+	ChannelId string                  `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Metadata  types.HandshakeMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *RegistryChannel) Reset()         { *m = RegistryChannel{} }
+func (m *RegistryChannel) String() string { return proto.CompactTextString(m) }
+func (*RegistryChannel) ProtoMessage()    {}
+func (*RegistryChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_756e6c5ead683abc, []int{0}
+}
+func (m *RegistryChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistryChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistryChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistryChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistryChannel.Merge(m, src)
+}
+func (m *RegistryChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistryChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistryChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistryChannel proto.InternalMessageInfo
+
+func (m *RegistryChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RegistryChannel) GetMetadata() types.HandshakeMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return types.HandshakeMetadata{}
+}
+
+// ChannelIntervals overrides the update and timeout intervals negotiated on a registry channel
+type ChannelIntervals struct {
+	ChannelId       string `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	UpdateInterval  uint64 `protobuf:"varint,2,opt,name=updateInterval,proto3" json:"updateInterval,omitempty"`
+	TimeoutInterval uint64 `protobuf:"varint,3,opt,name=timeoutInterval,proto3" json:"timeoutInterval,omitempty"`
+}
+
+func (m *ChannelIntervals) Reset()         { *m = ChannelIntervals{} }
+func (m *ChannelIntervals) String() string { return proto.CompactTextString(m) }
+func (*ChannelIntervals) ProtoMessage()    {}
+func (*ChannelIntervals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_756e6c5ead683abc, []int{1}
+}
+func (m *ChannelIntervals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelIntervals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelIntervals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelIntervals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelIntervals.Merge(m, src)
+}
+func (m *ChannelIntervals) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelIntervals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelIntervals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelIntervals proto.InternalMessageInfo
+
+func (m *ChannelIntervals) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelIntervals) GetUpdateInterval() uint64 {
+	if m != nil {
+		return m.UpdateInterval
+	}
+	return 0
+}
+
+func (m *ChannelIntervals) GetTimeoutInterval() uint64 {
+	if m != nil {
+		return m.TimeoutInterval
+	}
+	return 0
+}
+
+// RegistryStatus is the view of the monitored chain on the liveness of a registry chain
+type RegistryStatus struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Status    uint64 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// monitored chain block height at which the last evidence of the registry chain liveness was seen
+	LastEvidenceHeight uint64 `protobuf:"varint,3,opt,name=lastEvidenceHeight,proto3" json:"lastEvidenceHeight,omitempty"`
+	EvidenceSource     uint64 `protobuf:"varint,4,opt,name=evidenceSource,proto3" json:"evidenceSource,omitempty"`
+	// registry chain block height reported in the last acknowledgement
+	RegistryBlockHeight uint64 `protobuf:"varint,5,opt,name=registryBlockHeight,proto3" json:"registryBlockHeight,omitempty"`
+	// latest height and consensus state timestamp of the light client tracking the registry chain
+	ClientLatestHeight   uint64 `protobuf:"varint,6,opt,name=clientLatestHeight,proto3" json:"clientLatestHeight,omitempty"`
+	ClientTimestamp      uint64 `protobuf:"varint,7,opt,name=clientTimestamp,proto3" json:"clientTimestamp,omitempty"`
+	ClientRevisionNumber uint64 `protobuf:"varint,8,opt,name=clientRevisionNumber,proto3" json:"clientRevisionNumber,omitempty"`
+}
+
+func (m *RegistryStatus) Reset()         { *m = RegistryStatus{} }
+func (m *RegistryStatus) String() string { return proto.CompactTextString(m) }
+func (*RegistryStatus) ProtoMessage()    {}
+func (*RegistryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_756e6c5ead683abc, []int{2}
+}
+func (m *RegistryStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistryStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistryStatus.Merge(m, src)
+}
+func (m *RegistryStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistryStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistryStatus proto.InternalMessageInfo
+
+func (m *RegistryStatus) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RegistryStatus) GetStatus() uint64 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *RegistryStatus) GetLastEvidenceHeight() uint64 {
+	if m != nil {
+		return m.LastEvidenceHeight
+	}
+	return 0
+}
+
+func (m *RegistryStatus) GetEvidenceSource() uint64 {
+	if m != nil {
+		return m.EvidenceSource
+	}
+	return 0
+}
+
+func (m *RegistryStatus) GetRegistryBlockHeight() uint64 {
+	if m != nil {
+		return m.RegistryBlockHeight
+	}
+	return 0
+}
+
+func (m *RegistryStatus) GetClientLatestHeight() uint64 {
+	if m != nil {
+		return m.ClientLatestHeight
+	}
+	return 0
+}
+
+func (m *RegistryStatus) GetClientTimestamp() uint64 {
+	if m != nil {
+		return m.ClientTimestamp
+	}
+	return 0
+}
+
+func (m *RegistryStatus) GetClientRevisionNumber() uint64 {
+	if m != nil {
+		return m.ClientRevisionNumber
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RegistryChannel)(nil), "healthcheck.monitored.RegistryChannel")
+	proto.RegisterType((*ChannelIntervals)(nil), "healthcheck.monitored.ChannelIntervals")
+	proto.RegisterType((*RegistryStatus)(nil), "healthcheck.monitored.RegistryStatus")
+}
+
+func init() {
+	proto.RegisterFile("healthcheck/monitored/registry_channel.proto", fileDescriptor_756e6c5ead683abc)
+}
+
+var fileDescriptor_756e6c5ead683abc = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x93, 0xb5, 0xd6, 0xdd, 0x11, 0x76, 0x65, 0x5c, 0x25, 0x2c, 0x1a, 0x97, 0x22, 0x52,
+	0x44, 0x12, 0x59, 0x0f, 0xde, 0x2b, 0xca, 0x2e, 0xa8, 0x87, 0xac, 0x27, 0x2f, 0x65, 0x9a, 0x3c,
+	0x92, 0xa1, 0xc9, 0x4c, 0xc8, 0xbc, 0x54, 0x7b, 0xf5, 0x13, 0xf8, 0xb1, 0x7a, 0xec, 0xd1, 0x53,
+	0x91, 0xf6, 0x8b, 0x48, 0x66, 0x26, 0x35, 0xd6, 0x42, 0x6f, 0xed, 0xff, 0xfd, 0xde, 0xfb, 0xff,
+	0xdf, 0xf0, 0x42, 0x5e, 0x65, 0xc0, 0x72, 0xcc, 0xe2, 0x0c, 0xe2, 0x69, 0x58, 0x48, 0xc1, 0x51,
+	0x56, 0x90, 0x84, 0x15, 0xa4, 0x5c, 0x61, 0x35, 0x1f, 0xc7, 0x19, 0x13, 0x02, 0xf2, 0xa0, 0xac,
+	0x24, 0x4a, 0xfa, 0xa8, 0x43, 0x07, 0x5b, 0xfa, 0xe2, 0x3c, 0x95, 0xa9, 0xd4, 0x44, 0xd8, 0xfc,
+	0x32, 0xf0, 0xc5, 0xcb, 0xee, 0x68, 0x9c, 0x97, 0xa0, 0xc2, 0x8c, 0x89, 0x44, 0x65, 0x6c, 0x0a,
+	0xe3, 0x02, 0x90, 0x25, 0x0c, 0x99, 0x61, 0x07, 0xdf, 0xc8, 0x59, 0x64, 0x2d, 0xdf, 0x19, 0x47,
+	0xfa, 0x84, 0x9c, 0x58, 0xf3, 0x9b, 0xc4, 0x73, 0x2f, 0xdd, 0xe1, 0x49, 0xf4, 0x57, 0xa0, 0x1f,
+	0xc8, 0x71, 0x3b, 0xc2, 0x3b, 0xba, 0x74, 0x87, 0xf7, 0xaf, 0x9e, 0x07, 0xdd, 0x70, 0xda, 0x2f,
+	0xb8, 0x6e, 0xfd, 0x3e, 0x59, 0x76, 0xd4, 0x5b, 0xac, 0x9e, 0x39, 0xd1, 0xb6, 0x77, 0xf0, 0xc3,
+	0x25, 0x0f, 0xac, 0xe3, 0x8d, 0x40, 0xa8, 0x66, 0x2c, 0x57, 0x07, 0xac, 0x5f, 0x90, 0xd3, 0xba,
+	0x4c, 0x18, 0x42, 0xdb, 0xa0, 0x03, 0xf4, 0xa2, 0x1d, 0x95, 0x0e, 0xc9, 0x19, 0xf2, 0x02, 0x64,
+	0x8d, 0x5b, 0xf0, 0x8e, 0x06, 0x77, 0xe5, 0xc1, 0xea, 0x88, 0x9c, 0xb6, 0xeb, 0xdf, 0x22, 0xc3,
+	0xfa, 0x50, 0x84, 0xc7, 0xa4, 0xaf, 0x34, 0x67, 0xad, 0xed, 0x3f, 0x1a, 0x10, 0x9a, 0x33, 0x85,
+	0xef, 0x67, 0x3c, 0x01, 0x11, 0xc3, 0x35, 0xf0, 0x34, 0x43, 0xeb, 0xba, 0xa7, 0xd2, 0xac, 0x02,
+	0x56, 0xb9, 0x95, 0x75, 0x15, 0x83, 0xd7, 0x33, 0xab, 0xfc, 0xab, 0xd2, 0xd7, 0xe4, 0x61, 0x7b,
+	0x11, 0xa3, 0x5c, 0xc6, 0x53, 0x3b, 0xf8, 0xae, 0x86, 0xf7, 0x95, 0x9a, 0x24, 0x71, 0xce, 0x41,
+	0xe0, 0x47, 0x86, 0xa0, 0xd0, 0x36, 0xf4, 0x4d, 0x92, 0xff, 0x2b, 0xcd, 0x63, 0x19, 0xf5, 0x0b,
+	0x2f, 0x40, 0x21, 0x2b, 0x4a, 0xef, 0x9e, 0x79, 0xac, 0x1d, 0x99, 0x5e, 0x91, 0x73, 0x23, 0x45,
+	0x30, 0xe3, 0x8a, 0x4b, 0xf1, 0xb9, 0x2e, 0x26, 0x50, 0x79, 0xc7, 0x1a, 0xdf, 0x5b, 0x1b, 0xbd,
+	0x5d, 0xac, 0x7d, 0x77, 0xb9, 0xf6, 0xdd, 0xdf, 0x6b, 0xdf, 0xfd, 0xb9, 0xf1, 0x9d, 0xe5, 0xc6,
+	0x77, 0x7e, 0x6d, 0x7c, 0xe7, 0xeb, 0xd3, 0xee, 0x91, 0x7e, 0xef, 0x7c, 0x01, 0xfa, 0x80, 0x26,
+	0x7d, 0x7d, 0x9e, 0x6f, 0xfe, 0x0c, 0x00, 0x17, 0xeb, 0xed, 0x82, 0x27, 0x03, 0x00, 0x00,
+}
+
+func (m *RegistryChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistryChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistryChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelIntervals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelIntervals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelIntervals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutInterval != 0 {
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(m.TimeoutInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UpdateInterval != 0 {
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(m.UpdateInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistryStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistryStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClientRevisionNumber != 0 {
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(m.ClientRevisionNumber))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ClientTimestamp != 0 {
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(m.ClientTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ClientLatestHeight != 0 {
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(m.ClientLatestHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RegistryBlockHeight != 0 {
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(m.RegistryBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EvidenceSource != 0 {
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(m.EvidenceSource))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastEvidenceHeight != 0 {
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(m.LastEvidenceHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRegistryChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRegistryChannel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRegistryChannel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RegistryChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRegistryChannel(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovRegistryChannel(uint64(l))
+	return n
+}
+
+func (m *ChannelIntervals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRegistryChannel(uint64(l))
+	}
+	if m.UpdateInterval != 0 {
+		n += 1 + sovRegistryChannel(uint64(m.UpdateInterval))
+	}
+	if m.TimeoutInterval != 0 {
+		n += 1 + sovRegistryChannel(uint64(m.TimeoutInterval))
+	}
+	return n
+}
+
+func (m *RegistryStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRegistryChannel(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRegistryChannel(uint64(m.Status))
+	}
+	if m.LastEvidenceHeight != 0 {
+		n += 1 + sovRegistryChannel(uint64(m.LastEvidenceHeight))
+	}
+	if m.EvidenceSource != 0 {
+		n += 1 + sovRegistryChannel(uint64(m.EvidenceSource))
+	}
+	if m.RegistryBlockHeight != 0 {
+		n += 1 + sovRegistryChannel(uint64(m.RegistryBlockHeight))
+	}
+	if m.ClientLatestHeight != 0 {
+		n += 1 + sovRegistryChannel(uint64(m.ClientLatestHeight))
+	}
+	if m.ClientTimestamp != 0 {
+		n += 1 + sovRegistryChannel(uint64(m.ClientTimestamp))
+	}
+	if m.ClientRevisionNumber != 0 {
+		n += 1 + sovRegistryChannel(uint64(m.ClientRevisionNumber))
+	}
+	return n
+}
+
+func sovRegistryChannel(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRegistryChannel(x uint64) (n int) {
+	return sovRegistryChannel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RegistryChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistryChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistryChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistryChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistryChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistryChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRegistryChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistryChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistryChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistryChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelIntervals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistryChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelIntervals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelIntervals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistryChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistryChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInterval", wireType)
+			}
+			m.UpdateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutInterval", wireType)
+			}
+			m.TimeoutInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistryChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistryChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistryStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistryChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistryStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistryChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistryChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEvidenceHeight", wireType)
+			}
+			m.LastEvidenceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEvidenceHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceSource", wireType)
+			}
+			m.EvidenceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceSource |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryBlockHeight", wireType)
+			}
+			m.RegistryBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistryBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatestHeight", wireType)
+			}
+			m.ClientLatestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientLatestHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTimestamp", wireType)
+			}
+			m.ClientTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRevisionNumber", wireType)
+			}
+			m.ClientRevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientRevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistryChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistryChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRegistryChannel(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRegistryChannel
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistryChannel
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRegistryChannel
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRegistryChannel
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRegistryChannel
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRegistryChannel        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRegistryChannel          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRegistryChannel = fmt.Errorf("proto: unexpected end of group")
+)
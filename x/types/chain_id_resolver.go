@@ -0,0 +1,84 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v6/modules/light-clients/06-solomachine/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+	localhosttypes "github.com/cosmos/ibc-go/v6/modules/light-clients/09-localhost/types"
+)
+
+// ChainIDResolver resolves the chain ID of the counterparty chain from the state of the light client
+// tracking it. Resolvers are registered in the keepers per client type.
+type ChainIDResolver interface {
+	ResolveChainID(clientState ibcexported.ClientState) (string, error)
+}
+
+// ChainIDResolvers are the chain ID resolvers registered per light client type
+type ChainIDResolvers map[string]ChainIDResolver
+
+// DefaultChainIDResolvers returns the resolvers of the light client types supported out of the box
+func DefaultChainIDResolvers() ChainIDResolvers {
+	return ChainIDResolvers{
+		ibcexported.Tendermint:  TendermintChainIDResolver{},
+		ibcexported.Solomachine: SoloMachineChainIDResolver{},
+		ibcexported.Localhost:   LocalhostChainIDResolver{},
+	}
+}
+
+// ResolveChainID resolves the chain ID of the counterparty chain with the resolver registered for the type
+// of its light client
+func (r ChainIDResolvers) ResolveChainID(clientState ibcexported.ClientState) (string, error) {
+	resolver, found := r[clientState.ClientType()]
+	if !found {
+		return "", sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "no chain ID resolver registered for client type %s", clientState.ClientType())
+	}
+
+	return resolver.ResolveChainID(clientState)
+}
+
+// TendermintChainIDResolver resolves chain IDs of chains tracked by Tendermint light clients
+type TendermintChainIDResolver struct{}
+
+func (TendermintChainIDResolver) ResolveChainID(clientState ibcexported.ClientState) (string, error) {
+	tendermintClient, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return "", invalidClientTypeError(ibcexported.Tendermint, clientState)
+	}
+
+	return tendermintClient.ChainId, nil
+}
+
+// SoloMachineChainIDResolver resolves chain IDs of solo machines. Solo machines don't have a chain ID,
+// so the diversifier of their consensus state is used to identify them.
+type SoloMachineChainIDResolver struct{}
+
+func (SoloMachineChainIDResolver) ResolveChainID(clientState ibcexported.ClientState) (string, error) {
+	soloMachineClient, ok := clientState.(*solomachinetypes.ClientState)
+	if !ok {
+		return "", invalidClientTypeError(ibcexported.Solomachine, clientState)
+	}
+
+	if soloMachineClient.ConsensusState == nil || soloMachineClient.ConsensusState.Diversifier == "" {
+		return "", sdkerrors.Wrap(clienttypes.ErrInvalidClient, "solo machine client has no diversifier to identify the chain")
+	}
+
+	return soloMachineClient.ConsensusState.Diversifier, nil
+}
+
+// LocalhostChainIDResolver resolves the chain ID of a localhost client, which is the chain itself
+type LocalhostChainIDResolver struct{}
+
+func (LocalhostChainIDResolver) ResolveChainID(clientState ibcexported.ClientState) (string, error) {
+	localhostClient, ok := clientState.(*localhosttypes.ClientState)
+	if !ok {
+		return "", invalidClientTypeError(ibcexported.Localhost, clientState)
+	}
+
+	return localhostClient.ChainId, nil
+}
+
+func invalidClientTypeError(expected string, clientState ibcexported.ClientState) error {
+	return sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "invalid client type. expected: %s, got %s", expected, clientState.ClientType())
+}
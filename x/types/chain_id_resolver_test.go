@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v6/modules/light-clients/06-solomachine/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+	localhosttypes "github.com/cosmos/ibc-go/v6/modules/light-clients/09-localhost/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultChainIDResolvers(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		clientState ibcexported.ClientState
+		chainID     string
+		err         error
+	}{
+		{
+			desc:        "Tendermint",
+			clientState: &ibctmtypes.ClientState{ChainId: "foo-1"},
+			chainID:     "foo-1",
+		},
+		{
+			desc: "SoloMachine",
+			clientState: &solomachinetypes.ClientState{
+				ConsensusState: &solomachinetypes.ConsensusState{Diversifier: "solo"},
+			},
+			chainID: "solo",
+		},
+		{
+			desc:        "SoloMachineWithoutDiversifier",
+			clientState: &solomachinetypes.ClientState{},
+			err:         clienttypes.ErrInvalidClient,
+		},
+		{
+			desc:        "Localhost",
+			clientState: &localhosttypes.ClientState{ChainId: "bar-1"},
+			chainID:     "bar-1",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			resolver := DefaultChainIDResolvers()[tc.clientState.ClientType()]
+			require.NotNil(t, resolver)
+
+			chainID, err := resolver.ResolveChainID(tc.clientState)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.chainID, chainID)
+		})
+	}
+}
+
+func TestChainIDResolverClientTypeMismatch(t *testing.T) {
+	_, err := TendermintChainIDResolver{}.ResolveChainID(&localhosttypes.ClientState{ChainId: "bar-1"})
+	require.ErrorIs(t, err, clienttypes.ErrInvalidClientType)
+}
+
+func TestChainIDResolversUnregisteredClientType(t *testing.T) {
+	resolvers := DefaultChainIDResolvers()
+	delete(resolvers, ibcexported.Localhost)
+
+	_, err := resolvers.ResolveChainID(&localhosttypes.ClientState{ChainId: "bar-1"})
+	require.ErrorIs(t, err, clienttypes.ErrInvalidClientType)
+
+	chainID, err := resolvers.ResolveChainID(&ibctmtypes.ClientState{ChainId: "foo-1"})
+	require.NoError(t, err)
+	require.Equal(t, "foo-1", chainID)
+}
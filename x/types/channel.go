@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+// ValidateChannelOrdering checks that the healthcheck channel is either ORDERED or UNORDERED.
+// On UNORDERED channels a packet timeout doesn't close the channel, and packets that arrive
+// late are ignored by the registry chain.
+func ValidateChannelOrdering(order channeltypes.Order) error {
+	if order != channeltypes.ORDERED && order != channeltypes.UNORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s or %s channel, got %s ", channeltypes.ORDERED, channeltypes.UNORDERED, order)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BasisPoints is the number of basis points in a whole
+const BasisPoints uint64 = 10000
+
+// NewDecentralizationMetrics returns the decentralization metrics of a validator set with the given voting powers
+func NewDecentralizationMetrics(votingPowers []uint64, topN uint64) DecentralizationMetrics {
+	metrics := DecentralizationMetrics{
+		ValidatorCount: uint64(len(votingPowers)),
+		TopN:           topN,
+	}
+
+	sorted := make([]uint64, len(votingPowers))
+	copy(sorted, votingPowers)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+
+	totalPower := sdk.ZeroInt()
+	for _, power := range sorted {
+		totalPower = totalPower.Add(sdk.NewIntFromUint64(power))
+	}
+
+	if totalPower.IsZero() {
+		return metrics
+	}
+
+	cumulativePower := sdk.ZeroInt()
+	topNPower := sdk.ZeroInt()
+	for i, power := range sorted {
+		cumulativePower = cumulativePower.Add(sdk.NewIntFromUint64(power))
+		if uint64(i) < topN {
+			topNPower = cumulativePower
+		}
+
+		// validators controlling more than 1/3 of the voting power can halt the chain
+		if metrics.NakamotoCoefficient == 0 && cumulativePower.MulRaw(3).GT(totalPower) {
+			metrics.NakamotoCoefficient = uint64(i + 1)
+		}
+	}
+
+	metrics.TopNConcentration = topNPower.Mul(sdk.NewIntFromUint64(BasisPoints)).Quo(totalPower).Uint64()
+
+	return metrics
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecentralizationMetrics(t *testing.T) {
+	for _, tc := range []struct {
+		desc         string
+		votingPowers []uint64
+		topN         uint64
+		metrics      DecentralizationMetrics
+	}{
+		{
+			desc:    "NoValidators",
+			topN:    2,
+			metrics: DecentralizationMetrics{TopN: 2},
+		},
+		{
+			desc:         "SingleValidator",
+			votingPowers: []uint64{10},
+			topN:         2,
+			metrics: DecentralizationMetrics{
+				ValidatorCount:      1,
+				NakamotoCoefficient: 1,
+				TopN:                2,
+				TopNConcentration:   10000,
+			},
+		},
+		{
+			desc:         "Unsorted",
+			votingPowers: []uint64{10, 30, 20, 40},
+			topN:         2,
+			metrics: DecentralizationMetrics{
+				ValidatorCount:      4,
+				NakamotoCoefficient: 1,
+				TopN:                2,
+				TopNConcentration:   7000,
+			},
+		},
+		{
+			desc:         "EqualPower",
+			votingPowers: []uint64{1, 1, 1, 1, 1, 1},
+			topN:         3,
+			metrics: DecentralizationMetrics{
+				ValidatorCount:      6,
+				NakamotoCoefficient: 3,
+				TopN:                3,
+				TopNConcentration:   5000,
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.metrics, NewDecentralizationMetrics(tc.votingPowers, tc.topN))
+		})
+	}
+}
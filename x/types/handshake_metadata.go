@@ -0,0 +1,126 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// MetadataVersion is the version of the JSON encoded handshake metadata. Channels negotiated
+	// with the legacy Version use proto encoded metadata without features.
+	MetadataVersion = "2"
+
+	// FeatureStructuredAck means that healthcheck updates are acknowledged with HealthcheckAck
+	FeatureStructuredAck = "structured_ack"
+
+	// FeatureIntervalChange means that the monitored chain can renegotiate the intervals with IntervalChangeRequest
+	FeatureIntervalChange = "interval_change"
+
+	// FeatureProbe means that the monitored chain echoes the ProbeChallenge nonces in its healthcheck updates
+	FeatureProbe = "probe"
+
+	// FeatureExtendedPayload means that healthcheck updates carry the fields added after the legacy Version,
+	// on top of the block height and timestamp
+	FeatureExtendedPayload = "extended_payload"
+)
+
+// SupportedFeatures returns the features supported by this version of the healthcheck modules
+func SupportedFeatures() []string {
+	return []string{
+		FeatureStructuredAck,
+		FeatureIntervalChange,
+		FeatureProbe,
+		FeatureExtendedPayload,
+	}
+}
+
+// NewHandshakeMetadata returns the JSON encoded handshake metadata with the given intervals and features
+func NewHandshakeMetadata(updateInterval, timeoutInterval uint64, features []string) HandshakeMetadata {
+	return HandshakeMetadata{
+		Version:         MetadataVersion,
+		UpdateInterval:  updateInterval,
+		TimeoutInterval: timeoutInterval,
+		Features:        features,
+	}
+}
+
+// IsLegacy returns true for the metadata of channels negotiated with the legacy Version
+func (m HandshakeMetadata) IsLegacy() bool {
+	return m.Version == Version
+}
+
+// HasFeature returns true if the feature was negotiated
+func (m HandshakeMetadata) HasFeature(feature string) bool {
+	return HasFeature(m.Features, feature)
+}
+
+// Encode returns the channel version string carrying the metadata. Legacy metadata is proto encoded.
+func (m HandshakeMetadata) Encode() (string, error) {
+	var (
+		bz  []byte
+		err error
+	)
+	if m.IsLegacy() {
+		bz, err = m.Marshal()
+	} else {
+		bz, err = json.Marshal(m)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// DecodeHandshakeMetadata decodes the metadata from the channel version string. Both the JSON encoded
+// metadata and the proto encoded legacy metadata are accepted.
+func DecodeHandshakeMetadata(version string) (HandshakeMetadata, error) {
+	var metadata HandshakeMetadata
+
+	if strings.HasPrefix(version, "{") {
+		if err := json.Unmarshal([]byte(version), &metadata); err != nil {
+			return metadata, err
+		}
+
+		if metadata.Version != MetadataVersion {
+			return metadata, fmt.Errorf("unsupported metadata version: got %s, expected %s", metadata.Version, MetadataVersion)
+		}
+
+		return metadata, nil
+	}
+
+	if err := metadata.Unmarshal([]byte(version)); err != nil {
+		return metadata, err
+	}
+
+	if !metadata.IsLegacy() {
+		return metadata, fmt.Errorf("unsupported metadata version: got %s, expected %s", metadata.Version, Version)
+	}
+
+	return metadata, nil
+}
+
+// IntersectFeatures returns the features present in both lists, in the order of the first one
+func IntersectFeatures(features, otherFeatures []string) []string {
+	intersection := []string{}
+	for _, feature := range features {
+		if HasFeature(otherFeatures, feature) && !HasFeature(intersection, feature) {
+			intersection = append(intersection, feature)
+		}
+	}
+
+	return intersection
+}
+
+// HasFeature returns true if the feature is in the list
+func HasFeature(features []string, feature string) bool {
+	for _, f := range features {
+		if f == feature {
+			return true
+		}
+	}
+
+	return false
+}
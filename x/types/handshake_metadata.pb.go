@@ -23,9 +23,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type HandshakeMetadata struct {
-	Version         string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	UpdateInterval  uint64 `protobuf:"varint,2,opt,name=updateInterval,proto3" json:"updateInterval,omitempty"`
-	TimeoutInterval uint64 `protobuf:"varint,3,opt,name=timeoutInterval,proto3" json:"timeoutInterval,omitempty"`
+	Version         string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	UpdateInterval  uint64   `protobuf:"varint,2,opt,name=updateInterval,proto3" json:"updateInterval,omitempty"`
+	TimeoutInterval uint64   `protobuf:"varint,3,opt,name=timeoutInterval,proto3" json:"timeoutInterval,omitempty"`
+	Features        []string `protobuf:"bytes,4,rep,name=features,proto3" json:"features,omitempty"`
 }
 
 func (m *HandshakeMetadata) Reset()         { *m = HandshakeMetadata{} }
@@ -82,6 +83,13 @@ func (m *HandshakeMetadata) GetTimeoutInterval() uint64 {
 	return 0
 }
 
+func (m *HandshakeMetadata) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*HandshakeMetadata)(nil), "healthcheck.types.HandshakeMetadata")
 }
@@ -91,19 +99,21 @@ func init() {
 }
 
 var fileDescriptor_7d1794567e46a998 = []byte{
-	// 190 bytes of a gzipped FileDescriptorProto
+	// 209 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x48, 0x4d, 0xcc,
 	0x29, 0xc9, 0x48, 0xce, 0x48, 0x4d, 0xce, 0xd6, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0x48,
 	0xcc, 0x4b, 0x29, 0xce, 0x48, 0xcc, 0x4e, 0x8d, 0xcf, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49,
-	0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x44, 0x52, 0xab, 0x07, 0x56, 0xab, 0x54, 0xcf,
-	0x25, 0xe8, 0x01, 0x53, 0xee, 0x0b, 0x55, 0x2d, 0x24, 0xc1, 0xc5, 0x5e, 0x96, 0x5a, 0x54, 0x9c,
-	0x99, 0x9f, 0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe3, 0x0a, 0xa9, 0x71, 0xf1, 0x95,
-	0x16, 0xa4, 0x24, 0x96, 0xa4, 0x7a, 0xe6, 0x95, 0xa4, 0x16, 0x95, 0x25, 0xe6, 0x48, 0x30, 0x29,
-	0x30, 0x6a, 0xb0, 0x04, 0xa1, 0x89, 0x0a, 0x69, 0x70, 0xf1, 0x97, 0x64, 0xe6, 0xa6, 0xe6, 0x97,
-	0x96, 0xc0, 0x15, 0x32, 0x83, 0x15, 0xa2, 0x0b, 0x3b, 0xe9, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x30, 0xb2, 0xcf, 0x2a, 0x20, 0x7e, 0x4b, 0x62, 0x03, 0xfb, 0xc4,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x79, 0x58, 0x73, 0x07, 0xf7, 0x00, 0x00, 0x00,
+	0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x44, 0x52, 0xab, 0x07, 0x56, 0xab, 0x34, 0x9b,
+	0x91, 0x4b, 0xd0, 0x03, 0xa6, 0xde, 0x17, 0xaa, 0x5c, 0x48, 0x82, 0x8b, 0xbd, 0x2c, 0xb5, 0xa8,
+	0x38, 0x33, 0x3f, 0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc6, 0x15, 0x52, 0xe3, 0xe2,
+	0x2b, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0xf5, 0xcc, 0x2b, 0x49, 0x2d, 0x2a, 0x4b, 0xcc, 0x91, 0x60,
+	0x52, 0x60, 0xd4, 0x60, 0x09, 0x42, 0x13, 0x15, 0xd2, 0xe0, 0xe2, 0x2f, 0xc9, 0xcc, 0x4d, 0xcd,
+	0x2f, 0x2d, 0x81, 0x2b, 0x64, 0x06, 0x2b, 0x44, 0x17, 0x16, 0x92, 0xe2, 0xe2, 0x48, 0x4b, 0x4d,
+	0x2c, 0x29, 0x2d, 0x4a, 0x2d, 0x96, 0x60, 0x51, 0x60, 0xd6, 0xe0, 0x0c, 0x82, 0xf3, 0x9d, 0x74,
+	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
+	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x18, 0xd9, 0xdb, 0x15, 0x10,
+	0x8f, 0x27, 0xb1, 0x81, 0xbd, 0x69, 0x0c, 0x18, 0x00, 0x77, 0xc7, 0x7f, 0x16, 0x14, 0x01, 0x00,
+	0x00,
 }
 
 func (m *HandshakeMetadata) Marshal() (dAtA []byte, err error) {
@@ -126,6 +136,15 @@ func (m *HandshakeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintHandshakeMetadata(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.TimeoutInterval != 0 {
 		i = encodeVarintHandshakeMetadata(dAtA, i, uint64(m.TimeoutInterval))
 		i--
@@ -173,6 +192,12 @@ func (m *HandshakeMetadata) Size() (n int) {
 	if m.TimeoutInterval != 0 {
 		n += 1 + sovHandshakeMetadata(uint64(m.TimeoutInterval))
 	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovHandshakeMetadata(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -281,6 +306,38 @@ func (m *HandshakeMetadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandshakeMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshakeMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandshakeMetadata(dAtA[iNdEx:])
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandshakeMetadataEncoding(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		metadata HandshakeMetadata
+	}{
+		{
+			desc:     "Legacy",
+			metadata: HandshakeMetadata{Version: Version, UpdateInterval: 10, TimeoutInterval: 20},
+		},
+		{
+			desc:     "JSON",
+			metadata: NewHandshakeMetadata(10, 20, SupportedFeatures()),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			version, err := tc.metadata.Encode()
+			require.NoError(t, err)
+
+			metadata, err := DecodeHandshakeMetadata(version)
+			require.NoError(t, err)
+			require.Equal(t, tc.metadata, metadata)
+		})
+	}
+}
+
+func TestDecodeHandshakeMetadataUnsupportedVersion(t *testing.T) {
+	_, err := DecodeHandshakeMetadata(`{"version":"3"}`)
+	require.Error(t, err)
+
+	metadata := HandshakeMetadata{Version: "3"}
+	bz, err := metadata.Marshal()
+	require.NoError(t, err)
+	_, err = DecodeHandshakeMetadata(string(bz))
+	require.Error(t, err)
+}
+
+func TestIntersectFeatures(t *testing.T) {
+	require.Equal(t, []string{"b", "a"}, IntersectFeatures([]string{"b", "c", "a", "b"}, []string{"a", "b"}))
+	require.Empty(t, IntersectFeatures(nil, SupportedFeatures()))
+}
@@ -7,3 +7,17 @@ const (
 
 	MonitoredPortID = "monitored"
 )
+
+// HeartbeatReason defines why the monitored chain sent a healthcheck update
+type HeartbeatReason uint64
+
+const (
+	// ScheduledHeartbeat is used for the updates sent in the fixed interval
+	ScheduledHeartbeat HeartbeatReason = iota
+	// ParamsChange means that the healthcheck params of the monitored chain were changed
+	ParamsChange
+	// UpgradeScheduled means that an upgrade plan was scheduled on the monitored chain
+	UpgradeScheduled
+	// ValidatorSetChange means that the voting power of the validator set changed significantly
+	ValidatorSetChange
+)
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -25,6 +26,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type HealthcheckPacketData struct {
 	// Types that are valid to be assigned to Packet:
 	//	*HealthcheckPacketData_Data
+	//	*HealthcheckPacketData_IntervalChangeRequest
+	//	*HealthcheckPacketData_ProbeChallenge
 	Packet isHealthcheckPacketData_Packet `protobuf_oneof:"packet"`
 }
 
@@ -70,8 +73,16 @@ type isHealthcheckPacketData_Packet interface {
 type HealthcheckPacketData_Data struct {
 	Data *HealthcheckUpdateData `protobuf:"bytes,1,opt,name=data,proto3,oneof" json:"data,omitempty"`
 }
+type HealthcheckPacketData_IntervalChangeRequest struct {
+	IntervalChangeRequest *IntervalChangeRequest `protobuf:"bytes,2,opt,name=intervalChangeRequest,proto3,oneof" json:"intervalChangeRequest,omitempty"`
+}
+type HealthcheckPacketData_ProbeChallenge struct {
+	ProbeChallenge *ProbeChallenge `protobuf:"bytes,3,opt,name=probeChallenge,proto3,oneof" json:"probeChallenge,omitempty"`
+}
 
-func (*HealthcheckPacketData_Data) isHealthcheckPacketData_Packet() {}
+func (*HealthcheckPacketData_Data) isHealthcheckPacketData_Packet()                  {}
+func (*HealthcheckPacketData_IntervalChangeRequest) isHealthcheckPacketData_Packet() {}
+func (*HealthcheckPacketData_ProbeChallenge) isHealthcheckPacketData_Packet()        {}
 
 func (m *HealthcheckPacketData) GetPacket() isHealthcheckPacketData_Packet {
 	if m != nil {
@@ -87,16 +98,48 @@ func (m *HealthcheckPacketData) GetData() *HealthcheckUpdateData {
 	return nil
 }
 
+func (m *HealthcheckPacketData) GetIntervalChangeRequest() *IntervalChangeRequest {
+	if x, ok := m.GetPacket().(*HealthcheckPacketData_IntervalChangeRequest); ok {
+		return x.IntervalChangeRequest
+	}
+	return nil
+}
+
+func (m *HealthcheckPacketData) GetProbeChallenge() *ProbeChallenge {
+	if x, ok := m.GetPacket().(*HealthcheckPacketData_ProbeChallenge); ok {
+		return x.ProbeChallenge
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*HealthcheckPacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*HealthcheckPacketData_Data)(nil),
+		(*HealthcheckPacketData_IntervalChangeRequest)(nil),
+		(*HealthcheckPacketData_ProbeChallenge)(nil),
 	}
 }
 
 type HealthcheckUpdateData struct {
 	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Block     uint64 `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	// voting power of the validators that signed the last commit
+	SignedVotingPower uint64 `protobuf:"varint,3,opt,name=signedVotingPower,proto3" json:"signedVotingPower,omitempty"`
+	// voting power of the active validator set
+	TotalVotingPower uint64 `protobuf:"varint,4,opt,name=totalVotingPower,proto3" json:"totalVotingPower,omitempty"`
+	// number of the active validators
+	ValidatorCount uint64 `protobuf:"varint,5,opt,name=validatorCount,proto3" json:"validatorCount,omitempty"`
+	// reported every few updates only
+	Decentralization *DecentralizationMetrics `protobuf:"bytes,6,opt,name=decentralization,proto3" json:"decentralization,omitempty"`
+	// indicators contributed by the health reporters of the monitored chain
+	Indicators []Indicator `protobuf:"bytes,7,rep,name=indicators,proto3" json:"indicators"`
+	// reported every few updates only
+	Connectivity *ConnectivitySummary `protobuf:"bytes,8,opt,name=connectivity,proto3" json:"connectivity,omitempty"`
+	// HeartbeatReason the update was sent for, updates sent out of the fixed interval have a non-zero reason
+	Reason uint64 `protobuf:"varint,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	// nonce of the last probe challenge received from the registry chain, zero if there is none to answer
+	ProbeNonce uint64 `protobuf:"varint,10,opt,name=probeNonce,proto3" json:"probeNonce,omitempty"`
 }
 
 func (m *HealthcheckUpdateData) Reset()         { *m = HealthcheckUpdateData{} }
@@ -146,173 +189,2564 @@ func (m *HealthcheckUpdateData) GetBlock() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*HealthcheckPacketData)(nil), "healthcheck.types.HealthcheckPacketData")
-	proto.RegisterType((*HealthcheckUpdateData)(nil), "healthcheck.types.HealthcheckUpdateData")
+func (m *HealthcheckUpdateData) GetSignedVotingPower() uint64 {
+	if m != nil {
+		return m.SignedVotingPower
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("healthcheck/types/packet.proto", fileDescriptor_802e33ee4c416c7d) }
+func (m *HealthcheckUpdateData) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
 
-var fileDescriptor_802e33ee4c416c7d = []byte{
-	// 198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x48, 0x4d, 0xcc,
-	0x29, 0xc9, 0x48, 0xce, 0x48, 0x4d, 0xce, 0xd6, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2f, 0x48,
-	0x4c, 0xce, 0x4e, 0x2d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x44, 0x92, 0xd7, 0x03,
-	0xcb, 0x2b, 0x25, 0x72, 0x89, 0x7a, 0x20, 0x04, 0x03, 0xc0, 0xaa, 0x5d, 0x12, 0x4b, 0x12, 0x85,
-	0xec, 0xb8, 0x58, 0x52, 0x12, 0x4b, 0x12, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x34, 0xf4,
-	0x30, 0xb4, 0xea, 0x21, 0xe9, 0x0b, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0x05, 0xe9, 0xf3, 0x60, 0x08,
-	0x02, 0xeb, 0x73, 0xe2, 0xe0, 0x62, 0x83, 0xd8, 0xad, 0xe4, 0x8d, 0x62, 0x05, 0x42, 0xa9, 0x90,
-	0x0c, 0x17, 0x67, 0x49, 0x66, 0x6e, 0x6a, 0x71, 0x49, 0x62, 0x6e, 0x01, 0xd8, 0x1e, 0x96, 0x20,
-	0x84, 0x80, 0x90, 0x08, 0x17, 0x6b, 0x52, 0x4e, 0x7e, 0x72, 0xb6, 0x04, 0x13, 0x58, 0x06, 0xc2,
-	0x71, 0xd2, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x61, 0x64, 0xcf,
-	0x57, 0x40, 0xbc, 0x9f, 0xc4, 0x06, 0xf6, 0xb8, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x7a,
-	0x93, 0xcc, 0x1a, 0x01, 0x00, 0x00,
+func (m *HealthcheckUpdateData) GetValidatorCount() uint64 {
+	if m != nil {
+		return m.ValidatorCount
+	}
+	return 0
 }
 
-func (m *HealthcheckPacketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HealthcheckUpdateData) GetDecentralization() *DecentralizationMetrics {
+	if m != nil {
+		return m.Decentralization
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HealthcheckPacketData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HealthcheckUpdateData) GetIndicators() []Indicator {
+	if m != nil {
+		return m.Indicators
+	}
+	return nil
 }
 
-func (m *HealthcheckPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Packet != nil {
-		{
-			size := m.Packet.Size()
-			i -= size
-			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *HealthcheckUpdateData) GetConnectivity() *ConnectivitySummary {
+	if m != nil {
+		return m.Connectivity
+	}
+	return nil
+}
+
+func (m *HealthcheckUpdateData) GetReason() uint64 {
+	if m != nil {
+		return m.Reason
+	}
+	return 0
+}
+
+func (m *HealthcheckUpdateData) GetProbeNonce() uint64 {
+	if m != nil {
+		return m.ProbeNonce
+	}
+	return 0
+}
+
+// ConnectivitySummary describes the IBC channels of the monitored chain
+type ConnectivitySummary struct {
+	Channels []ChannelSummary `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
+}
+
+func (m *ConnectivitySummary) Reset()         { *m = ConnectivitySummary{} }
+func (m *ConnectivitySummary) String() string { return proto.CompactTextString(m) }
+func (*ConnectivitySummary) ProtoMessage()    {}
+func (*ConnectivitySummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802e33ee4c416c7d, []int{2}
+}
+func (m *ConnectivitySummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectivitySummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectivitySummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConnectivitySummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectivitySummary.Merge(m, src)
+}
+func (m *ConnectivitySummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectivitySummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectivitySummary.DiscardUnknown(m)
 }
 
-func (m *HealthcheckPacketData_Data) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ConnectivitySummary proto.InternalMessageInfo
+
+func (m *ConnectivitySummary) GetChannels() []ChannelSummary {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
 }
 
-func (m *HealthcheckPacketData_Data) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+// ChannelSummary describes an IBC channel of the monitored chain and the light client it's built on
+type ChannelSummary struct {
+	PortId              string `protobuf:"bytes,1,opt,name=portId,proto3" json:"portId,omitempty"`
+	ChannelId           string `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	CounterpartyChainId string `protobuf:"bytes,3,opt,name=counterpartyChainId,proto3" json:"counterpartyChainId,omitempty"`
+	// state of the channel, e.g. STATE_OPEN
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// status of the light client tracking the counterparty chain, e.g. Active, Expired or Frozen
+	ClientStatus string `protobuf:"bytes,5,opt,name=clientStatus,proto3" json:"clientStatus,omitempty"`
+	// number of the sent packets that weren't acknowledged or timed out yet, counted up to a limit
+	PendingPackets uint64 `protobuf:"varint,6,opt,name=pendingPackets,proto3" json:"pendingPackets,omitempty"`
+}
+
+func (m *ChannelSummary) Reset()         { *m = ChannelSummary{} }
+func (m *ChannelSummary) String() string { return proto.CompactTextString(m) }
+func (*ChannelSummary) ProtoMessage()    {}
+func (*ChannelSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802e33ee4c416c7d, []int{3}
+}
+func (m *ChannelSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelSummary.Merge(m, src)
+}
+func (m *ChannelSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelSummary proto.InternalMessageInfo
+
+func (m *ChannelSummary) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelSummary) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelSummary) GetCounterpartyChainId() string {
+	if m != nil {
+		return m.CounterpartyChainId
+	}
+	return ""
+}
+
+func (m *ChannelSummary) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ChannelSummary) GetClientStatus() string {
+	if m != nil {
+		return m.ClientStatus
+	}
+	return ""
+}
+
+func (m *ChannelSummary) GetPendingPackets() uint64 {
+	if m != nil {
+		return m.PendingPackets
+	}
+	return 0
+}
+
+// Indicator is a typed key/value health indicator contributed by a health reporter
+type Indicator struct {
+	// name of the health reporter that contributed the indicator
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Key      string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//	*Indicator_StringValue
+	//	*Indicator_IntValue
+	//	*Indicator_UintValue
+	//	*Indicator_BoolValue
+	Value isIndicator_Value `protobuf_oneof:"value"`
+}
+
+func (m *Indicator) Reset()         { *m = Indicator{} }
+func (m *Indicator) String() string { return proto.CompactTextString(m) }
+func (*Indicator) ProtoMessage()    {}
+func (*Indicator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802e33ee4c416c7d, []int{4}
+}
+func (m *Indicator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Indicator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Indicator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Indicator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Indicator.Merge(m, src)
+}
+func (m *Indicator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Indicator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Indicator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Indicator proto.InternalMessageInfo
+
+type isIndicator_Value interface {
+	isIndicator_Value()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Indicator_StringValue struct {
+	StringValue string `protobuf:"bytes,3,opt,name=stringValue,proto3,oneof" json:"stringValue,omitempty"`
+}
+type Indicator_IntValue struct {
+	IntValue int64 `protobuf:"varint,4,opt,name=intValue,proto3,oneof" json:"intValue,omitempty"`
+}
+type Indicator_UintValue struct {
+	UintValue uint64 `protobuf:"varint,5,opt,name=uintValue,proto3,oneof" json:"uintValue,omitempty"`
+}
+type Indicator_BoolValue struct {
+	BoolValue bool `protobuf:"varint,6,opt,name=boolValue,proto3,oneof" json:"boolValue,omitempty"`
+}
+
+func (*Indicator_StringValue) isIndicator_Value() {}
+func (*Indicator_IntValue) isIndicator_Value()    {}
+func (*Indicator_UintValue) isIndicator_Value()   {}
+func (*Indicator_BoolValue) isIndicator_Value()   {}
+
+func (m *Indicator) GetValue() isIndicator_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Indicator) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *Indicator) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Indicator) GetStringValue() string {
+	if x, ok := m.GetValue().(*Indicator_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (m *Indicator) GetIntValue() int64 {
+	if x, ok := m.GetValue().(*Indicator_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (m *Indicator) GetUintValue() uint64 {
+	if x, ok := m.GetValue().(*Indicator_UintValue); ok {
+		return x.UintValue
+	}
+	return 0
+}
+
+func (m *Indicator) GetBoolValue() bool {
+	if x, ok := m.GetValue().(*Indicator_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Indicator) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Indicator_StringValue)(nil),
+		(*Indicator_IntValue)(nil),
+		(*Indicator_UintValue)(nil),
+		(*Indicator_BoolValue)(nil),
+	}
+}
+
+// DecentralizationMetrics describes the distribution of voting power in the active validator set
+type DecentralizationMetrics struct {
+	ValidatorCount uint64 `protobuf:"varint,1,opt,name=validatorCount,proto3" json:"validatorCount,omitempty"`
+	// minimum number of validators that together control more than 1/3 of the voting power
+	NakamotoCoefficient uint64 `protobuf:"varint,2,opt,name=nakamotoCoefficient,proto3" json:"nakamotoCoefficient,omitempty"`
+	// number of the largest validators whose share of voting power is reported
+	TopN uint64 `protobuf:"varint,3,opt,name=topN,proto3" json:"topN,omitempty"`
+	// share of voting power (in basis points) controlled by the topN largest validators
+	TopNConcentration uint64 `protobuf:"varint,4,opt,name=topNConcentration,proto3" json:"topNConcentration,omitempty"`
+}
+
+func (m *DecentralizationMetrics) Reset()         { *m = DecentralizationMetrics{} }
+func (m *DecentralizationMetrics) String() string { return proto.CompactTextString(m) }
+func (*DecentralizationMetrics) ProtoMessage()    {}
+func (*DecentralizationMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802e33ee4c416c7d, []int{5}
+}
+func (m *DecentralizationMetrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecentralizationMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecentralizationMetrics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecentralizationMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecentralizationMetrics.Merge(m, src)
+}
+func (m *DecentralizationMetrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecentralizationMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecentralizationMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecentralizationMetrics proto.InternalMessageInfo
+
+func (m *DecentralizationMetrics) GetValidatorCount() uint64 {
+	if m != nil {
+		return m.ValidatorCount
+	}
+	return 0
+}
+
+func (m *DecentralizationMetrics) GetNakamotoCoefficient() uint64 {
+	if m != nil {
+		return m.NakamotoCoefficient
+	}
+	return 0
+}
+
+func (m *DecentralizationMetrics) GetTopN() uint64 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+func (m *DecentralizationMetrics) GetTopNConcentration() uint64 {
+	if m != nil {
+		return m.TopNConcentration
+	}
+	return 0
+}
+
+// IntervalChangeRequest asks the registry chain to change the intervals negotiated during the channel handshake
+type IntervalChangeRequest struct {
+	UpdateInterval  uint64 `protobuf:"varint,1,opt,name=updateInterval,proto3" json:"updateInterval,omitempty"`
+	TimeoutInterval uint64 `protobuf:"varint,2,opt,name=timeoutInterval,proto3" json:"timeoutInterval,omitempty"`
+}
+
+func (m *IntervalChangeRequest) Reset()         { *m = IntervalChangeRequest{} }
+func (m *IntervalChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*IntervalChangeRequest) ProtoMessage()    {}
+func (*IntervalChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802e33ee4c416c7d, []int{6}
+}
+func (m *IntervalChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntervalChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntervalChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntervalChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntervalChangeRequest.Merge(m, src)
+}
+func (m *IntervalChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntervalChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntervalChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntervalChangeRequest proto.InternalMessageInfo
+
+func (m *IntervalChangeRequest) GetUpdateInterval() uint64 {
+	if m != nil {
+		return m.UpdateInterval
+	}
+	return 0
+}
+
+func (m *IntervalChangeRequest) GetTimeoutInterval() uint64 {
+	if m != nil {
+		return m.TimeoutInterval
+	}
+	return 0
+}
+
+// ProbeChallenge is sent by the registry chain to check that packets are relayed to the monitored chain as well.
+// The monitored chain echoes the nonce in its next healthcheck update.
+type ProbeChallenge struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *ProbeChallenge) Reset()         { *m = ProbeChallenge{} }
+func (m *ProbeChallenge) String() string { return proto.CompactTextString(m) }
+func (*ProbeChallenge) ProtoMessage()    {}
+func (*ProbeChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802e33ee4c416c7d, []int{7}
+}
+func (m *ProbeChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProbeChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProbeChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProbeChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeChallenge.Merge(m, src)
+}
+func (m *ProbeChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProbeChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeChallenge proto.InternalMessageInfo
+
+func (m *ProbeChallenge) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// HealthcheckAck is the result of a successful healthcheck update acknowledgement.
+// It carries the view of the registry chain on the monitored chain.
+type HealthcheckAck struct {
+	RegistryBlockHeight uint64 `protobuf:"varint,1,opt,name=registryBlockHeight,proto3" json:"registryBlockHeight,omitempty"`
+	Status              uint64 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	NextUpdateDeadline  uint64 `protobuf:"varint,3,opt,name=nextUpdateDeadline,proto3" json:"nextUpdateDeadline,omitempty"`
+	UpdateInterval      uint64 `protobuf:"varint,4,opt,name=updateInterval,proto3" json:"updateInterval,omitempty"`
+	TimeoutInterval     uint64 `protobuf:"varint,5,opt,name=timeoutInterval,proto3" json:"timeoutInterval,omitempty"`
+}
+
+func (m *HealthcheckAck) Reset()         { *m = HealthcheckAck{} }
+func (m *HealthcheckAck) String() string { return proto.CompactTextString(m) }
+func (*HealthcheckAck) ProtoMessage()    {}
+func (*HealthcheckAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802e33ee4c416c7d, []int{8}
+}
+func (m *HealthcheckAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthcheckAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HealthcheckAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HealthcheckAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthcheckAck.Merge(m, src)
+}
+func (m *HealthcheckAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthcheckAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthcheckAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthcheckAck proto.InternalMessageInfo
+
+func (m *HealthcheckAck) GetRegistryBlockHeight() uint64 {
+	if m != nil {
+		return m.RegistryBlockHeight
+	}
+	return 0
+}
+
+func (m *HealthcheckAck) GetStatus() uint64 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *HealthcheckAck) GetNextUpdateDeadline() uint64 {
+	if m != nil {
+		return m.NextUpdateDeadline
+	}
+	return 0
+}
+
+func (m *HealthcheckAck) GetUpdateInterval() uint64 {
+	if m != nil {
+		return m.UpdateInterval
+	}
+	return 0
+}
+
+func (m *HealthcheckAck) GetTimeoutInterval() uint64 {
+	if m != nil {
+		return m.TimeoutInterval
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*HealthcheckPacketData)(nil), "healthcheck.types.HealthcheckPacketData")
+	proto.RegisterType((*HealthcheckUpdateData)(nil), "healthcheck.types.HealthcheckUpdateData")
+	proto.RegisterType((*ConnectivitySummary)(nil), "healthcheck.types.ConnectivitySummary")
+	proto.RegisterType((*ChannelSummary)(nil), "healthcheck.types.ChannelSummary")
+	proto.RegisterType((*Indicator)(nil), "healthcheck.types.Indicator")
+	proto.RegisterType((*DecentralizationMetrics)(nil), "healthcheck.types.DecentralizationMetrics")
+	proto.RegisterType((*IntervalChangeRequest)(nil), "healthcheck.types.IntervalChangeRequest")
+	proto.RegisterType((*ProbeChallenge)(nil), "healthcheck.types.ProbeChallenge")
+	proto.RegisterType((*HealthcheckAck)(nil), "healthcheck.types.HealthcheckAck")
+}
+
+func init() { proto.RegisterFile("healthcheck/types/packet.proto", fileDescriptor_802e33ee4c416c7d) }
+
+var fileDescriptor_802e33ee4c416c7d = []byte{
+	// 847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x25, 0x23, 0xda, 0x11, 0xc7, 0x81, 0xeb, 0xac, 0xe3, 0x96, 0x08, 0x0c, 0xd6, 0xe5, 0xc1,
+	0x30, 0x82, 0x56, 0x0e, 0xd2, 0x7b, 0x81, 0x8a, 0x39, 0xc8, 0x2d, 0x1a, 0x18, 0x1b, 0xd4, 0x87,
+	0x9c, 0xba, 0x26, 0x27, 0xd4, 0x42, 0xd4, 0x2e, 0x4b, 0x0e, 0xdd, 0xa8, 0x5f, 0x91, 0x9f, 0xe9,
+	0x1f, 0xf4, 0x90, 0x63, 0x8e, 0x45, 0x51, 0x14, 0x85, 0x7d, 0xee, 0x3f, 0x14, 0xbb, 0xa4, 0x65,
+	0x4a, 0xa2, 0x81, 0x9e, 0xb4, 0x3b, 0xef, 0xcd, 0x68, 0x66, 0xf6, 0xcd, 0x10, 0xc2, 0x29, 0x8a,
+	0x9c, 0xa6, 0xc9, 0x14, 0x93, 0xd9, 0x29, 0x2d, 0x0a, 0xac, 0x4e, 0x0b, 0x91, 0xcc, 0x90, 0x46,
+	0x45, 0xa9, 0x49, 0xb3, 0xc7, 0x1d, 0x7c, 0x64, 0xf1, 0xa7, 0x4f, 0x32, 0x9d, 0x69, 0x8b, 0x9e,
+	0x9a, 0x53, 0x43, 0x8c, 0xde, 0x3f, 0x80, 0x83, 0xc9, 0x1d, 0xf7, 0xdc, 0x06, 0x79, 0x29, 0x48,
+	0xb0, 0x6f, 0xc0, 0x4b, 0x05, 0x89, 0xc0, 0x3d, 0x72, 0x4f, 0x76, 0x5e, 0x9c, 0x8c, 0x36, 0x22,
+	0x8e, 0x3a, 0x7e, 0x3f, 0x16, 0xa9, 0x20, 0x34, 0x7e, 0x13, 0x87, 0x5b, 0x3f, 0xf6, 0x13, 0x1c,
+	0x48, 0x45, 0x58, 0x5e, 0x89, 0x3c, 0x9e, 0x0a, 0x95, 0x21, 0xc7, 0x9f, 0x6b, 0xac, 0x28, 0x78,
+	0x70, 0x6f, 0xc0, 0xb3, 0x3e, 0xfe, 0xc4, 0xe1, 0xfd, 0x81, 0xd8, 0xf7, 0xb0, 0x5b, 0x94, 0xfa,
+	0x12, 0xe3, 0xa9, 0xc8, 0x73, 0x54, 0x19, 0x06, 0x03, 0x1b, 0xfa, 0x8b, 0x9e, 0xd0, 0xe7, 0x2b,
+	0xc4, 0x89, 0xc3, 0xd7, 0x5c, 0xc7, 0x43, 0xd8, 0x6e, 0x3a, 0x18, 0xfd, 0x3b, 0x80, 0x83, 0xde,
+	0xd2, 0xd8, 0x21, 0xf8, 0x24, 0xe7, 0x58, 0x91, 0x98, 0x17, 0xb6, 0x2f, 0x1e, 0xbf, 0x33, 0xb0,
+	0x27, 0xb0, 0x75, 0x99, 0xeb, 0x64, 0x66, 0x0b, 0xf4, 0x78, 0x73, 0x61, 0x5f, 0xc2, 0xe3, 0x4a,
+	0x66, 0x0a, 0xd3, 0x0b, 0x4d, 0x52, 0x65, 0xe7, 0xfa, 0x17, 0x2c, 0x6d, 0x9e, 0x1e, 0xdf, 0x04,
+	0xd8, 0x33, 0xd8, 0x23, 0x4d, 0x22, 0xef, 0x92, 0x3d, 0x4b, 0xde, 0xb0, 0xb3, 0x63, 0xd8, 0xbd,
+	0x12, 0xb9, 0x4c, 0x05, 0xe9, 0x32, 0xd6, 0xb5, 0xa2, 0x60, 0xcb, 0x32, 0xd7, 0xac, 0xec, 0x02,
+	0xf6, 0x52, 0x4c, 0x50, 0x51, 0x29, 0x72, 0xf9, 0xab, 0x20, 0xa9, 0x55, 0xb0, 0x6d, 0x1b, 0xf5,
+	0xac, 0xa7, 0x51, 0x2f, 0xd7, 0xa8, 0x3f, 0x20, 0x95, 0x32, 0xa9, 0xf8, 0x46, 0x0c, 0x36, 0x06,
+	0x90, 0x2a, 0x95, 0x89, 0xf9, 0xa7, 0x2a, 0x78, 0x78, 0x34, 0x38, 0xd9, 0x79, 0x71, 0xd8, 0xfb,
+	0xaa, 0x2d, 0x69, 0xec, 0x7d, 0xf8, 0xfb, 0x73, 0x87, 0x77, 0xbc, 0xd8, 0x77, 0xf0, 0x28, 0xd1,
+	0x4a, 0x61, 0x42, 0xf2, 0x4a, 0xd2, 0x22, 0x18, 0xda, 0xbc, 0x8e, 0x7b, 0xa2, 0xc4, 0x1d, 0xda,
+	0xeb, 0x7a, 0x3e, 0x17, 0xe5, 0x82, 0xaf, 0xf8, 0xb2, 0x4f, 0x61, 0xbb, 0x44, 0x51, 0x69, 0x15,
+	0xf8, 0xb6, 0x0f, 0xed, 0x8d, 0x85, 0x00, 0xf6, 0xad, 0x5f, 0x69, 0x95, 0x60, 0x00, 0x16, 0xeb,
+	0x58, 0xa2, 0x37, 0xb0, 0xdf, 0x13, 0x9c, 0xc5, 0x30, 0x4c, 0xa6, 0x42, 0x29, 0xcc, 0xab, 0xc0,
+	0x3d, 0x1a, 0xdc, 0xa3, 0xab, 0xb8, 0xa1, 0xb4, 0x4e, 0x6d, 0x85, 0x4b, 0xc7, 0xe8, 0x4f, 0x17,
+	0x76, 0x57, 0x29, 0x26, 0xcd, 0x42, 0x97, 0x74, 0x96, 0x5a, 0x05, 0xf9, 0xbc, 0xbd, 0x19, 0x71,
+	0xb5, 0x6e, 0x67, 0xa9, 0x95, 0x90, 0xcf, 0xef, 0x0c, 0xec, 0x39, 0xec, 0x27, 0xe6, 0x35, 0xb1,
+	0x2c, 0x44, 0x49, 0x8b, 0x78, 0x2a, 0xa4, 0x3a, 0x4b, 0xad, 0x90, 0x7c, 0xde, 0x07, 0x19, 0x39,
+	0x56, 0x24, 0x08, 0xad, 0x7e, 0x7c, 0xde, 0x5c, 0x58, 0x04, 0x8f, 0x92, 0x5c, 0xa2, 0xa2, 0xd7,
+	0x24, 0xa8, 0xae, 0xac, 0x64, 0x7c, 0xbe, 0x62, 0x33, 0xc2, 0x2a, 0x50, 0xa5, 0x46, 0x68, 0x76,
+	0x22, 0x2a, 0x2b, 0x17, 0x8f, 0xaf, 0x59, 0xa3, 0xdf, 0x5d, 0xf0, 0x97, 0x8f, 0xcb, 0x9e, 0xc2,
+	0xb0, 0x44, 0x53, 0x0b, 0x96, 0x6d, 0x65, 0xcb, 0x3b, 0xdb, 0x83, 0xc1, 0x0c, 0x17, 0x6d, 0x55,
+	0xe6, 0xc8, 0x22, 0xd8, 0xa9, 0xa8, 0x94, 0x2a, 0xbb, 0x10, 0x79, 0xdd, 0x0c, 0xae, 0x3f, 0x71,
+	0x78, 0xd7, 0xc8, 0x0e, 0x61, 0x28, 0x15, 0x35, 0x04, 0x53, 0xc4, 0x60, 0xe2, 0xf0, 0xa5, 0x85,
+	0x85, 0xe0, 0xd7, 0x4b, 0xd8, 0x2a, 0x7f, 0xe2, 0x70, 0xbf, 0xee, 0xe2, 0x97, 0x5a, 0xe7, 0x0d,
+	0x6e, 0x0a, 0x18, 0x1a, 0x7c, 0x69, 0x1a, 0x3f, 0x84, 0xad, 0x2b, 0x73, 0x88, 0x7e, 0x73, 0xe1,
+	0xb3, 0x7b, 0x54, 0xdf, 0x33, 0x63, 0x6e, 0xef, 0x8c, 0x3d, 0x87, 0x7d, 0x25, 0x66, 0x62, 0xae,
+	0x49, 0xc7, 0x1a, 0xdf, 0xbe, 0x95, 0x89, 0xe9, 0x67, 0xbb, 0x09, 0xfa, 0x20, 0xc6, 0xc0, 0x23,
+	0x5d, 0xbc, 0x6a, 0x57, 0x81, 0x3d, 0x9b, 0x5d, 0x61, 0x7e, 0x63, 0xad, 0x9a, 0x6c, 0xec, 0xa8,
+	0x36, 0xe3, 0xbf, 0x09, 0x44, 0x12, 0x0e, 0x7a, 0x17, 0xa6, 0x49, 0xba, 0xb6, 0x4b, 0xeb, 0x16,
+	0xbe, 0x4d, 0x7a, 0xd5, 0xca, 0x4e, 0xe0, 0x13, 0xb3, 0xbd, 0x74, 0x4d, 0x4b, 0x62, 0x93, 0xf0,
+	0xba, 0x39, 0x3a, 0x86, 0xdd, 0xd5, 0x05, 0x6a, 0xd4, 0xa5, 0xec, 0x3c, 0x35, 0xa1, 0x9b, 0x4b,
+	0xf4, 0x97, 0x0b, 0xbb, 0x9d, 0xd5, 0xf9, 0x6d, 0x32, 0x33, 0x9d, 0x29, 0x31, 0x93, 0x15, 0x95,
+	0x8b, 0xb1, 0x59, 0x88, 0x13, 0x94, 0xd9, 0xf4, 0xb6, 0x8d, 0x7d, 0x90, 0x19, 0x90, 0xaa, 0x11,
+	0x67, 0x93, 0x4d, 0x7b, 0x63, 0x23, 0x60, 0x0a, 0xdf, 0x51, 0xbb, 0x8f, 0x51, 0xa4, 0xb9, 0x54,
+	0xd8, 0xf6, 0xaf, 0x07, 0xe9, 0x69, 0x83, 0xf7, 0x7f, 0xdb, 0xb0, 0xd5, 0xdb, 0x86, 0xf1, 0x57,
+	0x1f, 0xae, 0x43, 0xf7, 0xe3, 0x75, 0xe8, 0xfe, 0x73, 0x1d, 0xba, 0xef, 0x6f, 0x42, 0xe7, 0xe3,
+	0x4d, 0xe8, 0xfc, 0x71, 0x13, 0x3a, 0x6f, 0xf6, 0xbb, 0xdf, 0xe3, 0x77, 0xcd, 0x17, 0xf9, 0x72,
+	0xdb, 0x7e, 0x62, 0xbf, 0xfe, 0x6f, 0x00, 0xee, 0x5e, 0xef, 0x68, 0xad, 0x07, 0x00, 0x00,
+}
+
+func (m *HealthcheckPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthcheckPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthcheckPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HealthcheckPacketData_Data) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthcheckPacketData_Data) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HealthcheckPacketData_IntervalChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthcheckPacketData_IntervalChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IntervalChangeRequest != nil {
+		{
+			size, err := m.IntervalChangeRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HealthcheckPacketData_ProbeChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthcheckPacketData_ProbeChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ProbeChallenge != nil {
+		{
+			size, err := m.ProbeChallenge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HealthcheckUpdateData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthcheckUpdateData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthcheckUpdateData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProbeNonce != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ProbeNonce))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Reason != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Connectivity != nil {
+		{
+			size, err := m.Connectivity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Indicators) > 0 {
+		for iNdEx := len(m.Indicators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indicators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Decentralization != nil {
+		{
+			size, err := m.Decentralization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ValidatorCount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ValidatorCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SignedVotingPower != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.SignedVotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Block != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectivitySummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectivitySummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectivitySummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingPackets != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.PendingPackets))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ClientStatus) > 0 {
+		i -= len(m.ClientStatus)
+		copy(dAtA[i:], m.ClientStatus)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ClientStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CounterpartyChainId) > 0 {
+		i -= len(m.CounterpartyChainId)
+		copy(dAtA[i:], m.CounterpartyChainId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.CounterpartyChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Indicator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Indicator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Indicator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Indicator_StringValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Indicator_StringValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.StringValue)
+	copy(dAtA[i:], m.StringValue)
+	i = encodeVarintPacket(dAtA, i, uint64(len(m.StringValue)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *Indicator_IntValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Indicator_IntValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintPacket(dAtA, i, uint64(m.IntValue))
+	i--
+	dAtA[i] = 0x20
+	return len(dAtA) - i, nil
+}
+func (m *Indicator_UintValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Indicator_UintValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintPacket(dAtA, i, uint64(m.UintValue))
+	i--
+	dAtA[i] = 0x28
+	return len(dAtA) - i, nil
+}
+func (m *Indicator_BoolValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Indicator_BoolValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.BoolValue {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	return len(dAtA) - i, nil
+}
+func (m *DecentralizationMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecentralizationMetrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecentralizationMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopNConcentration != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.TopNConcentration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopN != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.TopN))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NakamotoCoefficient != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.NakamotoCoefficient))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorCount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ValidatorCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntervalChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntervalChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntervalChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutInterval != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.TimeoutInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UpdateInterval != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.UpdateInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProbeChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProbeChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProbeChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HealthcheckAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthcheckAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthcheckAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutInterval != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.TimeoutInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UpdateInterval != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.UpdateInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextUpdateDeadline != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.NextUpdateDeadline))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RegistryBlockHeight != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.RegistryBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HealthcheckPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *HealthcheckPacketData_Data) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *HealthcheckPacketData_IntervalChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IntervalChangeRequest != nil {
+		l = m.IntervalChangeRequest.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *HealthcheckPacketData_ProbeChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProbeChallenge != nil {
+		l = m.ProbeChallenge.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *HealthcheckUpdateData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovPacket(uint64(m.Timestamp))
+	}
+	if m.Block != 0 {
+		n += 1 + sovPacket(uint64(m.Block))
+	}
+	if m.SignedVotingPower != 0 {
+		n += 1 + sovPacket(uint64(m.SignedVotingPower))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovPacket(uint64(m.TotalVotingPower))
+	}
+	if m.ValidatorCount != 0 {
+		n += 1 + sovPacket(uint64(m.ValidatorCount))
+	}
+	if m.Decentralization != nil {
+		l = m.Decentralization.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Indicators) > 0 {
+		for _, e := range m.Indicators {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.Connectivity != nil {
+		l = m.Connectivity.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovPacket(uint64(m.Reason))
+	}
+	if m.ProbeNonce != 0 {
+		n += 1 + sovPacket(uint64(m.ProbeNonce))
+	}
+	return n
+}
+
+func (m *ConnectivitySummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.CounterpartyChainId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ClientStatus)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.PendingPackets != 0 {
+		n += 1 + sovPacket(uint64(m.PendingPackets))
+	}
+	return n
+}
+
+func (m *Indicator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	return n
+}
+
+func (m *Indicator_StringValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StringValue)
+	n += 1 + l + sovPacket(uint64(l))
+	return n
+}
+func (m *Indicator_IntValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovPacket(uint64(m.IntValue))
+	return n
+}
+func (m *Indicator_UintValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovPacket(uint64(m.UintValue))
+	return n
+}
+func (m *Indicator_BoolValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *DecentralizationMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorCount != 0 {
+		n += 1 + sovPacket(uint64(m.ValidatorCount))
+	}
+	if m.NakamotoCoefficient != 0 {
+		n += 1 + sovPacket(uint64(m.NakamotoCoefficient))
+	}
+	if m.TopN != 0 {
+		n += 1 + sovPacket(uint64(m.TopN))
+	}
+	if m.TopNConcentration != 0 {
+		n += 1 + sovPacket(uint64(m.TopNConcentration))
+	}
+	return n
+}
+
+func (m *IntervalChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateInterval != 0 {
+		n += 1 + sovPacket(uint64(m.UpdateInterval))
+	}
+	if m.TimeoutInterval != 0 {
+		n += 1 + sovPacket(uint64(m.TimeoutInterval))
+	}
+	return n
+}
+
+func (m *ProbeChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovPacket(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *HealthcheckAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RegistryBlockHeight != 0 {
+		n += 1 + sovPacket(uint64(m.RegistryBlockHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPacket(uint64(m.Status))
+	}
+	if m.NextUpdateDeadline != 0 {
+		n += 1 + sovPacket(uint64(m.NextUpdateDeadline))
+	}
+	if m.UpdateInterval != 0 {
+		n += 1 + sovPacket(uint64(m.UpdateInterval))
+	}
+	if m.TimeoutInterval != 0 {
+		n += 1 + sovPacket(uint64(m.TimeoutInterval))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HealthcheckPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthcheckPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthcheckPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HealthcheckUpdateData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &HealthcheckPacketData_Data{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalChangeRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IntervalChangeRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &HealthcheckPacketData_IntervalChangeRequest{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbeChallenge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ProbeChallenge{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &HealthcheckPacketData_ProbeChallenge{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthcheckUpdateData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthcheckUpdateData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthcheckUpdateData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedVotingPower", wireType)
+			}
+			m.SignedVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+			}
+			m.ValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decentralization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Decentralization == nil {
+				m.Decentralization = &DecentralizationMetrics{}
+			}
+			if err := m.Decentralization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indicators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indicators = append(m.Indicators, Indicator{})
+			if err := m.Indicators[len(m.Indicators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connectivity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Connectivity == nil {
+				m.Connectivity = &ConnectivitySummary{}
+			}
+			if err := m.Connectivity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbeNonce", wireType)
+			}
+			m.ProbeNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProbeNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectivitySummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectivitySummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectivitySummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, ChannelSummary{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPackets", wireType)
+			}
+			m.PendingPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Indicator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Indicator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Indicator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &Indicator_StringValue{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntValue", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &Indicator_IntValue{v}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UintValue", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &Indicator_UintValue{v}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Value = &Indicator_BoolValue{b}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecentralizationMetrics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecentralizationMetrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecentralizationMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+			}
+			m.ValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NakamotoCoefficient", wireType)
+			}
+			m.NakamotoCoefficient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NakamotoCoefficient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
+			}
+			m.TopN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopN |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopNConcentration", wireType)
+			}
+			m.TopNConcentration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopNConcentration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintPacket(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-func (m *HealthcheckUpdateData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *HealthcheckUpdateData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *HealthcheckUpdateData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Block != 0 {
-		i = encodeVarintPacket(dAtA, i, uint64(m.Block))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintPacket(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPacket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *HealthcheckPacketData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Packet != nil {
-		n += m.Packet.Size()
 	}
-	return n
-}
 
-func (m *HealthcheckPacketData_Data) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovPacket(uint64(l))
-	}
-	return n
-}
-func (m *HealthcheckUpdateData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovPacket(uint64(m.Timestamp))
-	}
-	if m.Block != 0 {
-		n += 1 + sovPacket(uint64(m.Block))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovPacket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPacket(x uint64) (n int) {
-	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *HealthcheckPacketData) Unmarshal(dAtA []byte) error {
+func (m *IntervalChangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -335,17 +2769,17 @@ func (m *HealthcheckPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthcheckPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: IntervalChangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthcheckPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IntervalChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInterval", wireType)
 			}
-			var msglen int
+			m.UpdateInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -355,27 +2789,99 @@ func (m *HealthcheckPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UpdateInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPacket
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutInterval", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.TimeoutInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPacket
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HealthcheckUpdateData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProbeChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProbeChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProbeChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Packet = &HealthcheckPacketData_Data{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -397,7 +2903,7 @@ func (m *HealthcheckPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthcheckUpdateData) Unmarshal(dAtA []byte) error {
+func (m *HealthcheckAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -420,17 +2926,17 @@ func (m *HealthcheckUpdateData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthcheckUpdateData: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthcheckAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthcheckUpdateData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthcheckAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryBlockHeight", wireType)
 			}
-			m.Timestamp = 0
+			m.RegistryBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -440,16 +2946,16 @@ func (m *HealthcheckUpdateData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				m.RegistryBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Block = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -459,7 +2965,64 @@ func (m *HealthcheckUpdateData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= uint64(b&0x7F) << shift
+				m.Status |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUpdateDeadline", wireType)
+			}
+			m.NextUpdateDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextUpdateDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInterval", wireType)
+			}
+			m.UpdateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutInterval", wireType)
+			}
+			m.TimeoutInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}